@@ -0,0 +1,328 @@
+package adcom1
+
+//go:generate ffjson $GOFILE
+
+import "github.com/bsm/openrtb"
+
+// This object is the root of a structure that defines an instance of
+// advertising media. Exactly one of the display, video or audio subtypes must
+// be present.
+type Ad struct {
+	ID      string                      `json:"id"`                // ID of the creative, unique within the scope of the vendor
+	ADomain []string                    `json:"adomain,omitempty"` // Advertiser domains
+	Bundle  []string                    `json:"bundle,omitempty"`  // Application bundles of the advertiser
+	IURL    string                      `json:"iurl,omitempty"`    // URL without cache-busting to an image representative of the ad
+	Cat     []string                    `json:"cat,omitempty"`     // Content categories describing the ad
+	CatTax  openrtb.CategoryTaxonomy    `json:"cattax,omitempty"`  // Taxonomy in use for cat
+	Lang    string                      `json:"lang,omitempty"`    // Language of the creative using ISO-639-1-alpha-2
+	Attr    []openrtb.CreativeAttribute `json:"attr,omitempty"`    // Attributes describing the ad
+	Secure  int                         `json:"secure,omitempty"`  // Indicates if the creative is secure, where 0 = no, 1 = yes
+	MRating openrtb.QAGMediaRating      `json:"mrating,omitempty"` // Media rating per IQG guidelines
+	Init    int64                       `json:"init,omitempty"`    // Timestamp of the original instantiation of this ad in Unix format
+	LastMod int64                       `json:"lastmod,omitempty"` // Timestamp of most recent modification to this ad in Unix format
+	Display *Display                    `json:"display,omitempty"` // Display media
+	Video   *Video                      `json:"video,omitempty"`   // Video media
+	Audio   *Audio                      `json:"audio,omitempty"`   // Audio media
+	Audit   *Audit                      `json:"audit,omitempty"`   // Audit status
+	Ext     openrtb.Extension           `json:"ext,omitempty"`
+}
+
+func (a *Ad) Reset() {
+	a.ID = ""
+	if a.ADomain != nil {
+		a.ADomain = a.ADomain[:0]
+	}
+	if a.Bundle != nil {
+		a.Bundle = a.Bundle[:0]
+	}
+	a.IURL = ""
+	if a.Cat != nil {
+		a.Cat = a.Cat[:0]
+	}
+	a.CatTax = 0
+	a.Lang = ""
+	if a.Attr != nil {
+		a.Attr = a.Attr[:0]
+	}
+	a.Secure = 0
+	a.MRating = 0
+	a.Init = 0
+	a.LastMod = 0
+	a.Display = nil
+	a.Video = nil
+	a.Audio = nil
+	a.Audit = nil
+	a.Ext = nil
+}
+
+// This object provides additional detail about an ad specifically for display
+// media. Exactly one of adm, curl, banner or native should be present.
+type Display struct {
+	MIME   string                 `json:"mime,omitempty"`   // MIME type of the ad
+	API    []openrtb.APIFramework `json:"api,omitempty"`    // API frameworks required by the ad
+	CType  DisplayCreativeType    `json:"ctype,omitempty"`  // Subtype of the display creative
+	W      int                    `json:"w,omitempty"`      // Absolute width of the creative
+	H      int                    `json:"h,omitempty"`      // Absolute height of the creative
+	WRatio int                    `json:"wratio,omitempty"` // Relative width when expressing size as a ratio
+	HRatio int                    `json:"hratio,omitempty"` // Relative height when expressing size as a ratio
+	Priv   string                 `json:"priv,omitempty"`   // URL of the privacy policy page for this ad
+	AdM    string                 `json:"adm,omitempty"`    // General display markup
+	CURL   string                 `json:"curl,omitempty"`   // URL returning the markup
+	Banner *Banner                `json:"banner,omitempty"` // Structured banner image object
+	Native *Native                `json:"native,omitempty"` // Structured native object
+	Event  []Event                `json:"event,omitempty"`  // Tracking events
+	Ext    openrtb.Extension      `json:"ext,omitempty"`
+}
+
+func (d *Display) Reset() {
+	d.MIME = ""
+	if d.API != nil {
+		d.API = d.API[:0]
+	}
+	d.CType = 0
+	d.W = 0
+	d.H = 0
+	d.WRatio = 0
+	d.HRatio = 0
+	d.Priv = ""
+	d.AdM = ""
+	d.CURL = ""
+	d.Banner = nil
+	d.Native = nil
+	if d.Event != nil {
+		for i := 0; i < len(d.Event); i++ {
+			(&d.Event[i]).Reset()
+		}
+		d.Event = d.Event[:0]
+	}
+	d.Ext = nil
+}
+
+// This object describes a banner ad composed of an image and a click link.
+type Banner struct {
+	Img  string            `json:"img"`            // URL of the image
+	Link *LinkAsset        `json:"link,omitempty"` // Destination link
+	Ext  openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (b *Banner) Reset() {
+	b.Img = ""
+	b.Link = nil
+	b.Ext = nil
+}
+
+// This object is the container for the assets of a structured native ad.
+type Native struct {
+	Link  *LinkAsset        `json:"link,omitempty"`  // Default destination link
+	Asset []Asset           `json:"asset,omitempty"` // Assets of the native ad
+	Ext   openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (n *Native) Reset() {
+	n.Link = nil
+	if n.Asset != nil {
+		for i := 0; i < len(n.Asset); i++ {
+			(&n.Asset[i]).Reset()
+		}
+		n.Asset = n.Asset[:0]
+	}
+	n.Ext = nil
+}
+
+// This object is a container for a single asset of a native ad. Exactly one of
+// title, image, video or data must be present.
+type Asset struct {
+	ID    int               `json:"id,omitempty"`    // Asset ID, as specified by the placement
+	Req   int               `json:"req,omitempty"`   // Indicates if the asset is required, where 0 = no, 1 = yes
+	Title *TitleAsset       `json:"title,omitempty"` // Title asset
+	Image *ImageAsset       `json:"image,omitempty"` // Image asset
+	Video *VideoAsset       `json:"video,omitempty"` // Video asset
+	Data  *DataAsset        `json:"data,omitempty"`  // Data asset
+	Link  *LinkAsset        `json:"link,omitempty"`  // Destination link of the asset
+	Ext   openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (a *Asset) Reset() {
+	a.ID = 0
+	a.Req = 0
+	a.Title = nil
+	a.Image = nil
+	a.Video = nil
+	a.Data = nil
+	a.Link = nil
+	a.Ext = nil
+}
+
+// This object describes a link asset.
+type LinkAsset struct {
+	URL   string            `json:"url"`             // Landing URL of the clickable link
+	URLFB string            `json:"urlfb,omitempty"` // Fallback URL for deep-link
+	Trkr  []string          `json:"trkr,omitempty"`  // Click tracker URLs
+	Ext   openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (l *LinkAsset) Reset() {
+	l.URL = ""
+	l.URLFB = ""
+	if l.Trkr != nil {
+		l.Trkr = l.Trkr[:0]
+	}
+	l.Ext = nil
+}
+
+// This object describes a title asset.
+type TitleAsset struct {
+	Text string            `json:"text"`          // The text of the title
+	Len  int               `json:"len,omitempty"` // Length of the title
+	Ext  openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (t *TitleAsset) Reset() {
+	t.Text = ""
+	t.Len = 0
+	t.Ext = nil
+}
+
+// This object describes an image asset.
+type ImageAsset struct {
+	URL  string               `json:"url"`            // URL of the image
+	W    int                  `json:"w,omitempty"`    // Width of the image
+	H    int                  `json:"h,omitempty"`    // Height of the image
+	Type NativeImageAssetType `json:"type,omitempty"` // Type of the image
+	Ext  openrtb.Extension    `json:"ext,omitempty"`
+}
+
+func (i *ImageAsset) Reset() {
+	i.URL = ""
+	i.W = 0
+	i.H = 0
+	i.Type = 0
+	i.Ext = nil
+}
+
+// This object describes a video asset.
+type VideoAsset struct {
+	AdM  string            `json:"adm,omitempty"`  // Video markup (e.g. VAST document)
+	CURL string            `json:"curl,omitempty"` // URL returning the video markup
+	Ext  openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (v *VideoAsset) Reset() {
+	v.AdM = ""
+	v.CURL = ""
+	v.Ext = nil
+}
+
+// This object describes a data asset.
+type DataAsset struct {
+	Value string              `json:"value"`          // Formatted string of data to be displayed
+	Len   int                 `json:"len,omitempty"`  // Length of the value
+	Type  NativeDataAssetType `json:"type,omitempty"` // Type of the data asset
+	Ext   openrtb.Extension   `json:"ext,omitempty"`
+}
+
+func (d *DataAsset) Reset() {
+	d.Value = ""
+	d.Len = 0
+	d.Type = 0
+	d.Ext = nil
+}
+
+// This object specifies a type of ad tracking event and the method to notify
+// interested parties upon occurrence of the event.
+type Event struct {
+	Type   EventType              `json:"type"`            // Type of the ad tracking event
+	Method EventTrackingMethod    `json:"method"`          // Tracking method
+	API    []openrtb.APIFramework `json:"api,omitempty"`   // API frameworks required by the tracker
+	URL    string                 `json:"url,omitempty"`   // URL of the tracker
+	CData  openrtb.Extension      `json:"cdata,omitempty"` // Custom key-value data for the tracker
+	Ext    openrtb.Extension      `json:"ext,omitempty"`
+}
+
+func (e *Event) Reset() {
+	e.Type = 0
+	e.Method = 0
+	if e.API != nil {
+		e.API = e.API[:0]
+	}
+	e.URL = ""
+	if e.CData != nil {
+		e.CData = e.CData[:0]
+	}
+	e.Ext = nil
+}
+
+// This object provides additional detail about an ad specifically for video
+// media. Exactly one of adm or curl should be present.
+type Video struct {
+	MIME  []string               `json:"mime,omitempty"`  // MIME types of the ad
+	API   []openrtb.APIFramework `json:"api,omitempty"`   // API frameworks required by the ad
+	CType openrtb.Protocol       `json:"ctype,omitempty"` // Subtype of the video creative
+	Dur   int                    `json:"dur,omitempty"`   // Duration of the video creative in seconds
+	AdM   string                 `json:"adm,omitempty"`   // Video markup (e.g. VAST document)
+	CURL  string                 `json:"curl,omitempty"`  // URL returning the markup
+	Ext   openrtb.Extension      `json:"ext,omitempty"`
+}
+
+func (v *Video) Reset() {
+	if v.MIME != nil {
+		v.MIME = v.MIME[:0]
+	}
+	if v.API != nil {
+		v.API = v.API[:0]
+	}
+	v.CType = 0
+	v.Dur = 0
+	v.AdM = ""
+	v.CURL = ""
+	v.Ext = nil
+}
+
+// This object provides additional detail about an ad specifically for audio
+// media. Exactly one of adm or curl should be present.
+type Audio struct {
+	MIME  []string               `json:"mime,omitempty"`  // MIME types of the ad
+	API   []openrtb.APIFramework `json:"api,omitempty"`   // API frameworks required by the ad
+	CType openrtb.Protocol       `json:"ctype,omitempty"` // Subtype of the audio creative
+	Dur   int                    `json:"dur,omitempty"`   // Duration of the audio creative in seconds
+	AdM   string                 `json:"adm,omitempty"`   // Audio markup (e.g. DAAST document)
+	CURL  string                 `json:"curl,omitempty"`  // URL returning the markup
+	Ext   openrtb.Extension      `json:"ext,omitempty"`
+}
+
+func (a *Audio) Reset() {
+	if a.MIME != nil {
+		a.MIME = a.MIME[:0]
+	}
+	if a.API != nil {
+		a.API = a.API[:0]
+	}
+	a.CType = 0
+	a.Dur = 0
+	a.AdM = ""
+	a.CURL = ""
+	a.Ext = nil
+}
+
+// This object carries the auditing status of an ad.
+type Audit struct {
+	Status   AuditStatus       `json:"status,omitempty"`   // Audit status
+	Feedback []string          `json:"feedback,omitempty"` // Reasons for the current audit status
+	Init     int64             `json:"init,omitempty"`     // Timestamp of the original instantiation of this audit in Unix format
+	LastMod  int64             `json:"lastmod,omitempty"`  // Timestamp of most recent modification to this audit in Unix format
+	Corr     openrtb.Extension `json:"corr,omitempty"`     // Corrections to the ad made by the auditor
+	Ext      openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (a *Audit) Reset() {
+	a.Status = 0
+	if a.Feedback != nil {
+		a.Feedback = a.Feedback[:0]
+	}
+	a.Init = 0
+	a.LastMod = 0
+	if a.Corr != nil {
+		a.Corr = a.Corr[:0]
+	}
+	a.Ext = nil
+}
@@ -0,0 +1,134 @@
+/*
+Package adcom1 implements the AdCOM 1.0 domain objects used by OpenRTB 3.0:
+placements, contexts (site, app, device, user, regs) and ads.
+
+Enumerations which are identical to the ones of OpenRTB 2.x are shared with
+the openrtb package, only AdCOM specific lists are declared here.
+*/
+package adcom1
+
+// Display placement types
+type DisplayPlacementType int
+
+const (
+	DisplayPlacementInFeed       DisplayPlacementType = 1 // In the feed of content
+	DisplayPlacementSidebar      DisplayPlacementType = 2 // In the atomic unit of the content or on the side
+	DisplayPlacementInterstitial DisplayPlacementType = 3 // Interstitial, overlay or full screen
+	DisplayPlacementFloating     DisplayPlacementType = 4 // Floating or sticky placement
+)
+
+// Display context types
+type DisplayContextType int
+
+const (
+	DisplayContextContent       DisplayContextType = 1  // Content-centric context (e.g. newsfeed, article, gallery)
+	DisplayContextSocial        DisplayContextType = 2  // Social-centric context (e.g. social network feed, email, chat)
+	DisplayContextProduct       DisplayContextType = 3  // Product context (e.g. product listings, details, reviews)
+	DisplayContextGeneral       DisplayContextType = 10 // General or mixed content
+	DisplayContextArticle       DisplayContextType = 11 // Primarily article content
+	DisplayContextVideo         DisplayContextType = 12 // Primarily video content
+	DisplayContextAudio         DisplayContextType = 13 // Primarily audio content
+	DisplayContextImage         DisplayContextType = 14 // Primarily image content
+	DisplayContextUserGenerated DisplayContextType = 15 // User-generated content
+	DisplayContextSocialGeneral DisplayContextType = 20 // General social content
+	DisplayContextEmail         DisplayContextType = 21 // Primarily email content
+	DisplayContextChat          DisplayContextType = 22 // Primarily chat/IM content
+	DisplayContextSelling       DisplayContextType = 30 // Content focused on selling products
+	DisplayContextAppStore      DisplayContextType = 31 // Application store/marketplace
+	DisplayContextReviews       DisplayContextType = 32 // Product reviews site primarily
+)
+
+// Display creative subtypes
+type DisplayCreativeType int
+
+const (
+	DisplayCreativeHTML    DisplayCreativeType = 1 // HTML
+	DisplayCreativeAMPHTML DisplayCreativeType = 2 // AMPHTML
+	DisplayCreativeImage   DisplayCreativeType = 3 // Structured image object
+	DisplayCreativeNative  DisplayCreativeType = 4 // Structured native object
+)
+
+// Click types
+type ClickType int
+
+const (
+	ClickTypeNone     ClickType = 0 // Non-clickable
+	ClickTypeUnknown  ClickType = 1 // Clickable, details unknown
+	ClickTypeEmbedded ClickType = 2 // Clickable, opens in an embedded browser/webview
+	ClickTypeNative   ClickType = 3 // Clickable, opens in the native browser
+)
+
+// Size units
+type SizeUnit int
+
+const (
+	SizeUnitDIPS        SizeUnit = 1 // Device independent pixels
+	SizeUnitInches      SizeUnit = 2 // Inches
+	SizeUnitCentimeters SizeUnit = 3 // Centimeters
+)
+
+// Playback cessation modes
+type PlaybackCessationMode int
+
+const (
+	PlaybackEndCompletion    PlaybackCessationMode = 1 // On video completion or when terminated by user
+	PlaybackEndViewport      PlaybackCessationMode = 2 // On leaving viewport or when terminated by user
+	PlaybackEndViewportFloat PlaybackCessationMode = 3 // On leaving viewport continues as a floating player until completion or termination
+)
+
+// Event types
+type EventType int
+
+const (
+	EventTypeLoaded         EventType = 1 // Delivered as a part of the creative markup
+	EventTypeImpression     EventType = 2 // Ad impression per IAB/MRC guidelines
+	EventTypeViewableMRC50  EventType = 3 // Visible impression using MRC definition at 50% in view for 1 second
+	EventTypeViewableMRC100 EventType = 4 // 100% in view for 1 second
+	EventTypeViewableVideo  EventType = 5 // Visible impression for video using MRC definition at 50% in view for 2 seconds
+)
+
+// Event tracking methods
+type EventTrackingMethod int
+
+const (
+	EventTrackingImage      EventTrackingMethod = 1 // Image-pixel tracking
+	EventTrackingJavaScript EventTrackingMethod = 2 // JavaScript-based tracking
+)
+
+// Native image asset types
+type NativeImageAssetType int
+
+const (
+	NativeImageIcon NativeImageAssetType = 1 // Icon image
+	NativeImageMain NativeImageAssetType = 3 // Large image preview for the ad
+)
+
+// Native data asset types
+type NativeDataAssetType int
+
+const (
+	NativeDataSponsored  NativeDataAssetType = 1  // Sponsored By message
+	NativeDataDesc       NativeDataAssetType = 2  // Descriptive text associated with the product or service
+	NativeDataRating     NativeDataAssetType = 3  // Rating of the product being offered
+	NativeDataLikes      NativeDataAssetType = 4  // Number of social ratings or "likes"
+	NativeDataDownloads  NativeDataAssetType = 5  // Number of downloads/installs
+	NativeDataPrice      NativeDataAssetType = 6  // Price for product/app/in-app purchase
+	NativeDataSalePrice  NativeDataAssetType = 7  // Sale price that can be used together with price
+	NativeDataPhone      NativeDataAssetType = 8  // Phone number
+	NativeDataAddress    NativeDataAssetType = 9  // Address
+	NativeDataDesc2      NativeDataAssetType = 10 // Additional descriptive text
+	NativeDataDisplayURL NativeDataAssetType = 11 // Display URL for the text ad
+	NativeDataCTAText    NativeDataAssetType = 12 // Text describing a call to action button
+)
+
+// Auditing status codes
+type AuditStatus int
+
+const (
+	AuditPending     AuditStatus = 1 // Pending audit
+	AuditPreApproved AuditStatus = 2 // Pre-approved
+	AuditApproved    AuditStatus = 3 // Approved
+	AuditDenied      AuditStatus = 4 // Denied
+	AuditChanged     AuditStatus = 5 // Changed, resubmitted
+	AuditExpired     AuditStatus = 6 // Expired
+)
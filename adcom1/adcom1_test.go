@@ -0,0 +1,90 @@
+package adcom1
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bsm/openrtb"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Placement", func() {
+	var subject *Placement
+
+	BeforeEach(func() {
+		Expect(fixture("placement", &subject)).To(Succeed())
+	})
+
+	It("should parse correctly", func() {
+		Expect(subject.TagID).To(Equal("abc"))
+		Expect(subject.Display).To(Equal(&DisplayPlacement{
+			Pos:        openrtb.AdPosAboveFold,
+			PType:      DisplayPlacementInFeed,
+			Context:    DisplayContextArticle,
+			MIME:       []string{"image/jpeg", "text/html"},
+			API:        []openrtb.APIFramework{openrtb.APIFrameworkMRAID1, openrtb.APIFrameworkMRAID2},
+			W:          300,
+			H:          250,
+			Unit:       SizeUnitDIPS,
+			DisplayFmt: []DisplayFormat{{W: 300, H: 250, ExpDir: []openrtb.ExpandableDirection{openrtb.ExpDirRight, openrtb.ExpDirDown}}},
+			NativeFmt: &NativeFormat{Asset: []AssetFormat{
+				{ID: 1, Req: 1, Title: &TitleAssetFormat{Len: 90}},
+				{ID: 2, Img: &ImageAssetFormat{Type: NativeImageMain, WMin: 300, HMin: 150}},
+				{ID: 3, Data: &DataAssetFormat{Type: NativeDataDesc, Len: 140}},
+			}},
+			Event: []EventSpec{{Type: EventTypeImpression, Method: []EventTrackingMethod{EventTrackingImage, EventTrackingJavaScript}}},
+		}))
+		Expect(subject.Video.CType).To(Equal([]openrtb.Protocol{openrtb.VideoProtoVAST3, openrtb.VideoProtoVAST3Wrapper}))
+		Expect(subject.Video.Comp).To(Equal([]Companion{{ID: "c1", VCM: 1, Display: &DisplayPlacement{W: 300, H: 250}}}))
+	})
+
+	It("should reset", func() {
+		subject.Reset()
+		Expect(subject.TagID).To(BeEmpty())
+		Expect(subject.Display).To(BeNil())
+		Expect(subject.Video).To(BeNil())
+	})
+})
+
+var _ = Describe("Ad", func() {
+	It("should parse correctly", func() {
+		var subject *Ad
+		Expect(fixture("ad", &subject)).To(Succeed())
+		Expect(subject.ID).To(Equal("557391"))
+		Expect(subject.Attr).To(Equal([]openrtb.CreativeAttribute{openrtb.CreativeAttributeInBannerVideoAdAutoPlay}))
+		Expect(subject.Display.Event).To(Equal([]Event{
+			{Type: EventTypeImpression, Method: EventTrackingImage, URL: "https://x.com/imp", CData: openrtb.Extension(`{ "k": "v" }`)},
+		}))
+		Expect(subject.Audit).To(Equal(&Audit{Status: AuditApproved, Feedback: []string{"ok"}, LastMod: 1528221935}))
+	})
+})
+
+var _ = Describe("OperatingSystem", func() {
+	It("should parse names", func() {
+		Expect(ParseOperatingSystem("iOS")).To(Equal(OSiOS))
+		Expect(ParseOperatingSystem("android")).To(Equal(OSAndroid))
+		Expect(ParseOperatingSystem("Mac OS X")).To(Equal(OSMacOS))
+		Expect(ParseOperatingSystem("unknown")).To(BeZero())
+		Expect(OSMacOS.String()).To(Equal("OS X"))
+	})
+})
+
+// --------------------------------------------------------------------
+
+func TestSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "openrtb/adcom1")
+}
+
+func fixture(fname string, v interface{}) error {
+	f, err := os.Open(filepath.Join("testdata", fname+".json"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return json.NewDecoder(f).Decode(v)
+}
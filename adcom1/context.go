@@ -0,0 +1,526 @@
+package adcom1
+
+//go:generate ffjson $GOFILE
+
+import (
+	"strings"
+
+	"github.com/bsm/openrtb"
+)
+
+// Operating systems
+type OperatingSystem int
+
+const (
+	OS3DS        OperatingSystem = 1
+	OSAndroid    OperatingSystem = 2
+	OSAppleTV    OperatingSystem = 3
+	OSAsha       OperatingSystem = 4
+	OSBada       OperatingSystem = 5
+	OSBlackBerry OperatingSystem = 6
+	OSBREW       OperatingSystem = 7
+	OSChromeOS   OperatingSystem = 8
+	OSDarwin     OperatingSystem = 9
+	OSFireOS     OperatingSystem = 10
+	OSFirefoxOS  OperatingSystem = 11
+	OSHelenOS    OperatingSystem = 12
+	OSiOS        OperatingSystem = 13
+	OSLinux      OperatingSystem = 14
+	OSMacOS      OperatingSystem = 15
+	OSMeeGo      OperatingSystem = 16
+	OSMorphOS    OperatingSystem = 17
+	OSNetBSD     OperatingSystem = 18
+	OSNucleus    OperatingSystem = 19
+	OSPSVita     OperatingSystem = 20
+	OSPS3        OperatingSystem = 21
+	OSPS4        OperatingSystem = 22
+	OSPSP        OperatingSystem = 23
+	OSSymbian    OperatingSystem = 24
+	OSTizen      OperatingSystem = 25
+	OSWatchOS    OperatingSystem = 26
+	OSWebOS      OperatingSystem = 27
+	OSWindows    OperatingSystem = 28
+)
+
+var osNames = map[OperatingSystem]string{
+	OS3DS:        "3DS",
+	OSAndroid:    "Android",
+	OSAppleTV:    "tvOS",
+	OSAsha:       "Asha",
+	OSBada:       "Bada",
+	OSBlackBerry: "BlackBerry",
+	OSBREW:       "BREW",
+	OSChromeOS:   "ChromeOS",
+	OSDarwin:     "Darwin",
+	OSFireOS:     "FireOS",
+	OSFirefoxOS:  "FirefoxOS",
+	OSHelenOS:    "HelenOS",
+	OSiOS:        "iOS",
+	OSLinux:      "Linux",
+	OSMacOS:      "OS X",
+	OSMeeGo:      "MeeGo",
+	OSMorphOS:    "MorphOS",
+	OSNetBSD:     "NetBSD",
+	OSNucleus:    "NucleusPLUS",
+	OSPSVita:     "PSVita",
+	OSPS3:        "PS3",
+	OSPS4:        "PS4",
+	OSPSP:        "PSP",
+	OSSymbian:    "Symbian",
+	OSTizen:      "Tizen",
+	OSWatchOS:    "watchOS",
+	OSWebOS:      "webOS",
+	OSWindows:    "Windows",
+}
+
+var osAliases = map[string]OperatingSystem{
+	"macos":         OSMacOS,
+	"mac os":        OSMacOS,
+	"mac os x":      OSMacOS,
+	"ipados":        OSiOS,
+	"apple tv":      OSAppleTV,
+	"rim":           OSBlackBerry,
+	"fire os":       OSFireOS,
+	"chrome os":     OSChromeOS,
+	"windows phone": OSWindows,
+}
+
+// String returns the common name of the operating system, as used by the
+// device.os field of OpenRTB 2.x.
+func (os OperatingSystem) String() string {
+	return osNames[os]
+}
+
+// ParseOperatingSystem looks up an operating system by its common name (case
+// insensitive). Returns 0 if unknown.
+func ParseOperatingSystem(name string) OperatingSystem {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if os, ok := osAliases[name]; ok {
+		return os
+	}
+	for os, s := range osNames {
+		if strings.ToLower(s) == name {
+			return os
+		}
+	}
+	return 0
+}
+
+// This object is the common base of the site and app distribution channels.
+type DistributionChannel struct {
+	ID         string                   `json:"id,omitempty"`         // Vendor-specific unique identifier of the distribution channel
+	Name       string                   `json:"name,omitempty"`       // Displayable name of the distribution channel
+	Pub        *Publisher               `json:"pub,omitempty"`        // Details about the publisher
+	Content    *Content                 `json:"content,omitempty"`    // Details about the content
+	Domain     string                   `json:"domain,omitempty"`     // Domain of the distribution channel
+	Cat        []string                 `json:"cat,omitempty"`        // Categories describing the distribution channel
+	SectCat    []string                 `json:"sectcat,omitempty"`    // Categories describing the current section
+	PageCat    []string                 `json:"pagecat,omitempty"`    // Categories describing the current page or view
+	CatTax     openrtb.CategoryTaxonomy `json:"cattax,omitempty"`     // Taxonomy in use for cat, sectcat and pagecat
+	PrivPolicy *int                     `json:"privpolicy,omitempty"` // Indicates if the channel has a privacy policy, where 0 = no, 1 = yes
+	Keywords   string                   `json:"keywords,omitempty"`   // Comma separated list of keywords
+	Ext        openrtb.Extension        `json:"ext,omitempty"`
+}
+
+func (d *DistributionChannel) Reset() {
+	d.ID = ""
+	d.Name = ""
+	d.Pub = nil
+	d.Content = nil
+	d.Domain = ""
+	if d.Cat != nil {
+		d.Cat = d.Cat[:0]
+	}
+	if d.SectCat != nil {
+		d.SectCat = d.SectCat[:0]
+	}
+	if d.PageCat != nil {
+		d.PageCat = d.PageCat[:0]
+	}
+	d.CatTax = 0
+	d.PrivPolicy = nil
+	d.Keywords = ""
+	d.Ext = nil
+}
+
+// This object describes a website distribution channel.
+type Site struct {
+	DistributionChannel
+	Page   string `json:"page,omitempty"`   // URL of the page
+	Ref    string `json:"ref,omitempty"`    // Referrer URL
+	Search string `json:"search,omitempty"` // Search string that caused navigation
+	Mobile int    `json:"mobile,omitempty"` // Indicates if the site is mobile optimised, where 0 = no, 1 = yes
+	AMP    int    `json:"amp,omitempty"`    // Indicates if the page is built with AMP HTML, where 0 = no, 1 = yes
+}
+
+func (s *Site) Reset() {
+	s.ID = ""
+	s.Name = ""
+	s.Pub = nil
+	s.Content = nil
+	s.Domain = ""
+	if s.Cat != nil {
+		s.Cat = s.Cat[:0]
+	}
+	if s.SectCat != nil {
+		s.SectCat = s.SectCat[:0]
+	}
+	if s.PageCat != nil {
+		s.PageCat = s.PageCat[:0]
+	}
+	s.CatTax = 0
+	s.PrivPolicy = nil
+	s.Keywords = ""
+	s.Ext = nil
+	s.Page = ""
+	s.Ref = ""
+	s.Search = ""
+	s.Mobile = 0
+	s.AMP = 0
+}
+
+// This object describes an application distribution channel.
+type App struct {
+	DistributionChannel
+	Bundle   string `json:"bundle,omitempty"`   // Platform-specific application identifier
+	StoreID  string `json:"storeid,omitempty"`  // App store ID of the application
+	StoreURL string `json:"storeurl,omitempty"` // App store URL of the application
+	Ver      string `json:"ver,omitempty"`      // Application version
+	Paid     int    `json:"paid,omitempty"`     // Indicates if the application is paid, where 0 = free, 1 = paid
+}
+
+func (a *App) Reset() {
+	a.ID = ""
+	a.Name = ""
+	a.Pub = nil
+	a.Content = nil
+	a.Domain = ""
+	if a.Cat != nil {
+		a.Cat = a.Cat[:0]
+	}
+	if a.SectCat != nil {
+		a.SectCat = a.SectCat[:0]
+	}
+	if a.PageCat != nil {
+		a.PageCat = a.PageCat[:0]
+	}
+	a.CatTax = 0
+	a.PrivPolicy = nil
+	a.Keywords = ""
+	a.Ext = nil
+	a.Bundle = ""
+	a.StoreID = ""
+	a.StoreURL = ""
+	a.Ver = ""
+	a.Paid = 0
+}
+
+// This object describes the publisher of the distribution channel.
+type Publisher struct {
+	ID     string                   `json:"id,omitempty"`     // Vendor-specific unique publisher identifier
+	Name   string                   `json:"name,omitempty"`   // Displayable name of the publisher
+	Domain string                   `json:"domain,omitempty"` // Highest level domain of the publisher
+	Cat    []string                 `json:"cat,omitempty"`    // Categories describing the publisher
+	CatTax openrtb.CategoryTaxonomy `json:"cattax,omitempty"` // Taxonomy in use for cat
+	Ext    openrtb.Extension        `json:"ext,omitempty"`
+}
+
+func (p *Publisher) Reset() {
+	p.ID = ""
+	p.Name = ""
+	p.Domain = ""
+	if p.Cat != nil {
+		p.Cat = p.Cat[:0]
+	}
+	p.CatTax = 0
+	p.Ext = nil
+}
+
+// This object describes the producer of the content.
+type Producer struct {
+	ID     string                   `json:"id,omitempty"`     // Vendor-specific unique producer identifier
+	Name   string                   `json:"name,omitempty"`   // Displayable name of the producer
+	Domain string                   `json:"domain,omitempty"` // Highest level domain of the producer
+	Cat    []string                 `json:"cat,omitempty"`    // Categories describing the producer
+	CatTax openrtb.CategoryTaxonomy `json:"cattax,omitempty"` // Taxonomy in use for cat
+	Ext    openrtb.Extension        `json:"ext,omitempty"`
+}
+
+func (p *Producer) Reset() {
+	p.ID = ""
+	p.Name = ""
+	p.Domain = ""
+	if p.Cat != nil {
+		p.Cat = p.Cat[:0]
+	}
+	p.CatTax = 0
+	p.Ext = nil
+}
+
+// This object describes the content in which an ad will appear.
+type Content struct {
+	ID       string                    `json:"id,omitempty"`       // ID uniquely identifying the content
+	Episode  int                       `json:"episode,omitempty"`  // Episode number
+	Title    string                    `json:"title,omitempty"`    // Content title
+	Series   string                    `json:"series,omitempty"`   // Content series
+	Season   string                    `json:"season,omitempty"`   // Content season
+	Artist   string                    `json:"artist,omitempty"`   // Artist credited with the content
+	Genre    string                    `json:"genre,omitempty"`    // Genre that best describes the content
+	Album    string                    `json:"album,omitempty"`    // Album to which the content belongs
+	ISRC     string                    `json:"isrc,omitempty"`     // International Standard Recording Code conforming to ISO-3901
+	URL      string                    `json:"url,omitempty"`      // URL of the content
+	Cat      []string                  `json:"cat,omitempty"`      // Categories describing the content
+	CatTax   openrtb.CategoryTaxonomy  `json:"cattax,omitempty"`   // Taxonomy in use for cat
+	ProdQ    openrtb.ProductionQuality `json:"prodq,omitempty"`    // Production quality
+	Context  openrtb.ContentContext    `json:"context,omitempty"`  // Type of content
+	Rating   string                    `json:"rating,omitempty"`   // Content rating (e.g., MPAA)
+	URating  string                    `json:"urating,omitempty"`  // User rating of the content
+	MRating  openrtb.QAGMediaRating    `json:"mrating,omitempty"`  // Media rating per IQG guidelines
+	Keywords string                    `json:"keywords,omitempty"` // Comma separated list of keywords describing the content
+	Live     int                       `json:"live,omitempty"`     // Indicates if the content is live, where 0 = no, 1 = yes
+	SrcRel   int                       `json:"srcrel,omitempty"`   // Source relationship, where 0 = indirect, 1 = direct
+	Len      int                       `json:"len,omitempty"`      // Length of content in seconds
+	Lang     string                    `json:"lang,omitempty"`     // Content language using ISO-639-1-alpha-2
+	Embed    int                       `json:"embed,omitempty"`    // Indicates if the content is embeddable, where 0 = no, 1 = yes
+	Producer *Producer                 `json:"producer,omitempty"` // Details about the content producer
+	Data     []Data                    `json:"data,omitempty"`     // Additional data about the content
+	Ext      openrtb.Extension         `json:"ext,omitempty"`
+}
+
+func (c *Content) Reset() {
+	c.ID = ""
+	c.Episode = 0
+	c.Title = ""
+	c.Series = ""
+	c.Season = ""
+	c.Artist = ""
+	c.Genre = ""
+	c.Album = ""
+	c.ISRC = ""
+	c.URL = ""
+	if c.Cat != nil {
+		c.Cat = c.Cat[:0]
+	}
+	c.CatTax = 0
+	c.ProdQ = 0
+	c.Context = 0
+	c.Rating = ""
+	c.URating = ""
+	c.MRating = 0
+	c.Keywords = ""
+	c.Live = 0
+	c.SrcRel = 0
+	c.Len = 0
+	c.Lang = ""
+	c.Embed = 0
+	c.Producer = nil
+	if c.Data != nil {
+		for i := 0; i < len(c.Data); i++ {
+			(&c.Data[i]).Reset()
+		}
+		c.Data = c.Data[:0]
+	}
+	c.Ext = nil
+}
+
+// This object contains information about the human user of the device.
+type User struct {
+	ID       string            `json:"id,omitempty"`       // Vendor-specific ID for the user
+	BuyerUID string            `json:"buyeruid,omitempty"` // Buyer-specific ID for the user as mapped by an exchange for the buyer
+	YOB      int               `json:"yob,omitempty"`      // Year of birth as a 4-digit integer
+	Gender   string            `json:"gender,omitempty"`   // Gender ("M": male, "F" female, "O" Other)
+	Keywords string            `json:"keywords,omitempty"` // Comma separated list of keywords, interests, or intent
+	Consent  string            `json:"consent,omitempty"`  // GDPR consent string if applicable
+	Geo      *Geo              `json:"geo,omitempty"`      // Location of the user's home base
+	Data     []Data            `json:"data,omitempty"`     // Additional user data
+	Ext      openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (u *User) Reset() {
+	u.ID = ""
+	u.BuyerUID = ""
+	u.YOB = 0
+	u.Gender = ""
+	u.Keywords = ""
+	u.Consent = ""
+	u.Geo = nil
+	if u.Data != nil {
+		for i := 0; i < len(u.Data); i++ {
+			(&u.Data[i]).Reset()
+		}
+		u.Data = u.Data[:0]
+	}
+	u.Ext = nil
+}
+
+// This object provides information pertaining to the device through which the
+// user is interacting.
+type Device struct {
+	Type      openrtb.DeviceType     `json:"type,omitempty"`      // The general type of device
+	UA        string                 `json:"ua,omitempty"`        // Browser user agent string
+	IFA       string                 `json:"ifa,omitempty"`       // ID sanctioned for advertiser use in the clear
+	DNT       int                    `json:"dnt,omitempty"`       // Standard "Do Not Track" flag
+	LMT       int                    `json:"lmt,omitempty"`       // "Limit Ad Tracking" signal
+	Make      string                 `json:"make,omitempty"`      // Device make
+	Model     string                 `json:"model,omitempty"`     // Device model
+	OS        OperatingSystem        `json:"os,omitempty"`        // Device operating system
+	OSV       string                 `json:"osv,omitempty"`       // Device operating system version
+	HWV       string                 `json:"hwv,omitempty"`       // Hardware version of the device
+	H         int                    `json:"h,omitempty"`         // Physical height of the screen in pixels
+	W         int                    `json:"w,omitempty"`         // Physical width of the screen in pixels
+	PPI       int                    `json:"ppi,omitempty"`       // Screen size as pixels per linear inch
+	PxRatio   float64                `json:"pxratio,omitempty"`   // The ratio of physical pixels to device independent pixels
+	JS        int                    `json:"js,omitempty"`        // Support for JavaScript, where 0 = no, 1 = yes
+	Lang      string                 `json:"lang,omitempty"`      // Browser language using ISO-639-1-alpha-2
+	IP        string                 `json:"ip,omitempty"`        // IPv4 address closest to device
+	IPv6      string                 `json:"ipv6,omitempty"`      // IP address closest to device as IPv6
+	XFF       string                 `json:"xff,omitempty"`       // The value of the X-Forwarded-For header
+	IPTr      int                    `json:"iptr,omitempty"`      // Indicates if the IP address is truncated, where 0 = no, 1 = yes
+	Carrier   string                 `json:"carrier,omitempty"`   // Carrier or ISP
+	MCCMNC    string                 `json:"mccmnc,omitempty"`    // Mobile carrier as the concatenated MCC-MNC code
+	MCCMNCSim string                 `json:"mccmncsim,omitempty"` // MCC and MNC of the SIM card
+	ConType   openrtb.ConnectionType `json:"contype,omitempty"`   // Network connection type
+	GeoFetch  int                    `json:"geofetch,omitempty"`  // Indicates if the geolocation API will be available to JavaScript code
+	Geo       *Geo                   `json:"geo,omitempty"`       // Location of the device
+	Ext       openrtb.Extension      `json:"ext,omitempty"`
+}
+
+func (d *Device) Reset() {
+	d.Type = 0
+	d.UA = ""
+	d.IFA = ""
+	d.DNT = 0
+	d.LMT = 0
+	d.Make = ""
+	d.Model = ""
+	d.OS = 0
+	d.OSV = ""
+	d.HWV = ""
+	d.H = 0
+	d.W = 0
+	d.PPI = 0
+	d.PxRatio = 0
+	d.JS = 0
+	d.Lang = ""
+	d.IP = ""
+	d.IPv6 = ""
+	d.XFF = ""
+	d.IPTr = 0
+	d.Carrier = ""
+	d.MCCMNC = ""
+	d.MCCMNCSim = ""
+	d.ConType = 0
+	d.GeoFetch = 0
+	d.Geo = nil
+	d.Ext = nil
+}
+
+// This object encapsulates various methods for specifying a geographic location.
+type Geo struct {
+	Type      openrtb.LocationType    `json:"type,omitempty"`      // Source of location data
+	Lat       float64                 `json:"lat,omitempty"`       // Latitude from -90.0 to +90.0
+	Lon       float64                 `json:"lon,omitempty"`       // Longitude from -180.0 to +180.0
+	Accur     int                     `json:"accur,omitempty"`     // Estimated location accuracy in meters
+	LastFix   int                     `json:"lastfix,omitempty"`   // Number of seconds since this geolocation fix was established
+	IPServ    openrtb.LocationService `json:"ipserv,omitempty"`    // Service or provider used to determine geolocation from IP address
+	Country   string                  `json:"country,omitempty"`   // Country using ISO-3166-1 Alpha-2
+	Region    string                  `json:"region,omitempty"`    // Region using ISO-3166-2
+	Metro     string                  `json:"metro,omitempty"`     // Regional marketing areas
+	City      string                  `json:"city,omitempty"`      // City using United Nations Code for Trade & Transport Locations
+	Zip       string                  `json:"zip,omitempty"`       // ZIP or postal code
+	UTCOffset int                     `json:"utcoffset,omitempty"` // Local time as the number +/- of minutes from UTC
+	Ext       openrtb.Extension       `json:"ext,omitempty"`
+}
+
+func (g *Geo) Reset() {
+	g.Type = 0
+	g.Lat = 0
+	g.Lon = 0
+	g.Accur = 0
+	g.LastFix = 0
+	g.IPServ = 0
+	g.Country = ""
+	g.Region = ""
+	g.Metro = ""
+	g.City = ""
+	g.Zip = ""
+	g.UTCOffset = 0
+	g.Ext = nil
+}
+
+// This object is used to convey additional data about a user or content.
+type Data struct {
+	ID      string            `json:"id,omitempty"`      // Vendor-specific ID for the data provider
+	Name    string            `json:"name,omitempty"`    // Vendor-specific displayable name for the data provider
+	Segment []Segment         `json:"segment,omitempty"` // Segments of data
+	Ext     openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (d *Data) Reset() {
+	d.ID = ""
+	d.Name = ""
+	if d.Segment != nil {
+		for i := 0; i < len(d.Segment); i++ {
+			(&d.Segment[i]).Reset()
+		}
+		d.Segment = d.Segment[:0]
+	}
+	d.Ext = nil
+}
+
+// This object is a key-value pair of data about a user or content.
+type Segment struct {
+	ID    string            `json:"id,omitempty"`    // ID of the data segment specific to the data provider
+	Name  string            `json:"name,omitempty"`  // Displayable name of the data segment
+	Value string            `json:"value,omitempty"` // String representation of the data segment value
+	Ext   openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (s *Segment) Reset() {
+	s.ID = ""
+	s.Name = ""
+	s.Value = ""
+	s.Ext = nil
+}
+
+// This object contains any legal, governmental, or industry regulations that
+// the sender deems applicable to the request.
+type Regs struct {
+	COPPA int               `json:"coppa,omitempty"` // Flag indicating if the request is subject to COPPA, where 0 = no, 1 = yes
+	GDPR  *int              `json:"gdpr,omitempty"`  // Flag indicating if the request is subject to GDPR, where 0 = no, 1 = yes
+	Ext   openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (r *Regs) Reset() {
+	r.COPPA = 0
+	r.GDPR = nil
+	r.Ext = nil
+}
+
+// This object allows lists of restrictions such as blocked categories,
+// advertisers or creative attributes.
+type Restrictions struct {
+	BCat   []string                    `json:"bcat,omitempty"`   // Block list of content categories
+	CatTax openrtb.CategoryTaxonomy    `json:"cattax,omitempty"` // Taxonomy in use for bcat
+	BAdv   []string                    `json:"badv,omitempty"`   // Block list of advertisers by their domains
+	BApp   []string                    `json:"bapp,omitempty"`   // Block list of applications by their bundles
+	BAttr  []openrtb.CreativeAttribute `json:"battr,omitempty"`  // Block list of creative attributes
+	Ext    openrtb.Extension           `json:"ext,omitempty"`
+}
+
+func (r *Restrictions) Reset() {
+	if r.BCat != nil {
+		r.BCat = r.BCat[:0]
+	}
+	r.CatTax = 0
+	if r.BAdv != nil {
+		r.BAdv = r.BAdv[:0]
+	}
+	if r.BApp != nil {
+		r.BApp = r.BApp[:0]
+	}
+	if r.BAttr != nil {
+		r.BAttr = r.BAttr[:0]
+	}
+	r.Ext = nil
+}
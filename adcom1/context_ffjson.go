@@ -0,0 +1,11051 @@
+// DO NOT EDIT!
+// Code generated by ffjson <https://github.com/pquerna/ffjson>
+// source: context.go
+// DO NOT EDIT!
+
+package adcom1
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/bsm/openrtb"
+	fflib "github.com/pquerna/ffjson/fflib/v1"
+)
+
+func (mj *App) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *App) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.Bundle) != 0 {
+		buf.WriteString(`"bundle":`)
+		fflib.WriteJsonString(buf, string(mj.Bundle))
+		buf.WriteByte(',')
+	}
+	if len(mj.StoreID) != 0 {
+		buf.WriteString(`"storeid":`)
+		fflib.WriteJsonString(buf, string(mj.StoreID))
+		buf.WriteByte(',')
+	}
+	if len(mj.StoreURL) != 0 {
+		buf.WriteString(`"storeurl":`)
+		fflib.WriteJsonString(buf, string(mj.StoreURL))
+		buf.WriteByte(',')
+	}
+	if len(mj.Ver) != 0 {
+		buf.WriteString(`"ver":`)
+		fflib.WriteJsonString(buf, string(mj.Ver))
+		buf.WriteByte(',')
+	}
+	if mj.Paid != 0 {
+		buf.WriteString(`"paid":`)
+		fflib.FormatBits2(buf, uint64(mj.Paid), 10, mj.Paid < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.ID) != 0 {
+		buf.WriteString(`"id":`)
+		fflib.WriteJsonString(buf, string(mj.ID))
+		buf.WriteByte(',')
+	}
+	if len(mj.Name) != 0 {
+		buf.WriteString(`"name":`)
+		fflib.WriteJsonString(buf, string(mj.Name))
+		buf.WriteByte(',')
+	}
+	if mj.Pub != nil {
+		if true {
+			buf.WriteString(`"pub":`)
+
+			{
+
+				err = mj.Pub.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if mj.Content != nil {
+		if true {
+			buf.WriteString(`"content":`)
+
+			{
+
+				err = mj.Content.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Domain) != 0 {
+		buf.WriteString(`"domain":`)
+		fflib.WriteJsonString(buf, string(mj.Domain))
+		buf.WriteByte(',')
+	}
+	if len(mj.Cat) != 0 {
+		buf.WriteString(`"cat":`)
+		if mj.Cat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Cat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.SectCat) != 0 {
+		buf.WriteString(`"sectcat":`)
+		if mj.SectCat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.SectCat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.PageCat) != 0 {
+		buf.WriteString(`"pagecat":`)
+		if mj.PageCat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.PageCat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.CatTax != 0 {
+		buf.WriteString(`"cattax":`)
+		fflib.FormatBits2(buf, uint64(mj.CatTax), 10, mj.CatTax < 0)
+		buf.WriteByte(',')
+	}
+	if mj.PrivPolicy != nil {
+		if true {
+			buf.WriteString(`"privpolicy":`)
+			fflib.FormatBits2(buf, uint64(*mj.PrivPolicy), 10, *mj.PrivPolicy < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Keywords) != 0 {
+		buf.WriteString(`"keywords":`)
+		fflib.WriteJsonString(buf, string(mj.Keywords))
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Appbase = iota
+	ffj_t_Appno_such_key
+
+	ffj_t_App_Bundle
+
+	ffj_t_App_StoreID
+
+	ffj_t_App_StoreURL
+
+	ffj_t_App_Ver
+
+	ffj_t_App_Paid
+
+	ffj_t_App_ID
+
+	ffj_t_App_Name
+
+	ffj_t_App_Pub
+
+	ffj_t_App_Content
+
+	ffj_t_App_Domain
+
+	ffj_t_App_Cat
+
+	ffj_t_App_SectCat
+
+	ffj_t_App_PageCat
+
+	ffj_t_App_CatTax
+
+	ffj_t_App_PrivPolicy
+
+	ffj_t_App_Keywords
+
+	ffj_t_App_Ext
+)
+
+var ffj_key_App_Bundle = []byte("bundle")
+
+var ffj_key_App_StoreID = []byte("storeid")
+
+var ffj_key_App_StoreURL = []byte("storeurl")
+
+var ffj_key_App_Ver = []byte("ver")
+
+var ffj_key_App_Paid = []byte("paid")
+
+var ffj_key_App_ID = []byte("id")
+
+var ffj_key_App_Name = []byte("name")
+
+var ffj_key_App_Pub = []byte("pub")
+
+var ffj_key_App_Content = []byte("content")
+
+var ffj_key_App_Domain = []byte("domain")
+
+var ffj_key_App_Cat = []byte("cat")
+
+var ffj_key_App_SectCat = []byte("sectcat")
+
+var ffj_key_App_PageCat = []byte("pagecat")
+
+var ffj_key_App_CatTax = []byte("cattax")
+
+var ffj_key_App_PrivPolicy = []byte("privpolicy")
+
+var ffj_key_App_Keywords = []byte("keywords")
+
+var ffj_key_App_Ext = []byte("ext")
+
+func (uj *App) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *App) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Appbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Appno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffj_key_App_Bundle, kn) {
+						currentKey = ffj_t_App_Bundle
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_App_Content, kn) {
+						currentKey = ffj_t_App_Content
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_App_Cat, kn) {
+						currentKey = ffj_t_App_Cat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_App_CatTax, kn) {
+						currentKey = ffj_t_App_CatTax
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_App_Domain, kn) {
+						currentKey = ffj_t_App_Domain
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_App_Ext, kn) {
+						currentKey = ffj_t_App_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_App_ID, kn) {
+						currentKey = ffj_t_App_ID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'k':
+
+					if bytes.Equal(ffj_key_App_Keywords, kn) {
+						currentKey = ffj_t_App_Keywords
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffj_key_App_Name, kn) {
+						currentKey = ffj_t_App_Name
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffj_key_App_Paid, kn) {
+						currentKey = ffj_t_App_Paid
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_App_Pub, kn) {
+						currentKey = ffj_t_App_Pub
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_App_PageCat, kn) {
+						currentKey = ffj_t_App_PageCat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_App_PrivPolicy, kn) {
+						currentKey = ffj_t_App_PrivPolicy
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffj_key_App_StoreID, kn) {
+						currentKey = ffj_t_App_StoreID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_App_StoreURL, kn) {
+						currentKey = ffj_t_App_StoreURL
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_App_SectCat, kn) {
+						currentKey = ffj_t_App_SectCat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'v':
+
+					if bytes.Equal(ffj_key_App_Ver, kn) {
+						currentKey = ffj_t_App_Ver
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_Ext, kn) {
+					currentKey = ffj_t_App_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_App_Keywords, kn) {
+					currentKey = ffj_t_App_Keywords
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_PrivPolicy, kn) {
+					currentKey = ffj_t_App_PrivPolicy
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_CatTax, kn) {
+					currentKey = ffj_t_App_CatTax
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_PageCat, kn) {
+					currentKey = ffj_t_App_PageCat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_App_SectCat, kn) {
+					currentKey = ffj_t_App_SectCat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_Cat, kn) {
+					currentKey = ffj_t_App_Cat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_Domain, kn) {
+					currentKey = ffj_t_App_Domain
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_Content, kn) {
+					currentKey = ffj_t_App_Content
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_Pub, kn) {
+					currentKey = ffj_t_App_Pub
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_Name, kn) {
+					currentKey = ffj_t_App_Name
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_ID, kn) {
+					currentKey = ffj_t_App_ID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_Paid, kn) {
+					currentKey = ffj_t_App_Paid
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_Ver, kn) {
+					currentKey = ffj_t_App_Ver
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_App_StoreURL, kn) {
+					currentKey = ffj_t_App_StoreURL
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_App_StoreID, kn) {
+					currentKey = ffj_t_App_StoreID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_App_Bundle, kn) {
+					currentKey = ffj_t_App_Bundle
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Appno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_App_Bundle:
+					goto handle_Bundle
+
+				case ffj_t_App_StoreID:
+					goto handle_StoreID
+
+				case ffj_t_App_StoreURL:
+					goto handle_StoreURL
+
+				case ffj_t_App_Ver:
+					goto handle_Ver
+
+				case ffj_t_App_Paid:
+					goto handle_Paid
+
+				case ffj_t_App_ID:
+					goto handle_ID
+
+				case ffj_t_App_Name:
+					goto handle_Name
+
+				case ffj_t_App_Pub:
+					goto handle_Pub
+
+				case ffj_t_App_Content:
+					goto handle_Content
+
+				case ffj_t_App_Domain:
+					goto handle_Domain
+
+				case ffj_t_App_Cat:
+					goto handle_Cat
+
+				case ffj_t_App_SectCat:
+					goto handle_SectCat
+
+				case ffj_t_App_PageCat:
+					goto handle_PageCat
+
+				case ffj_t_App_CatTax:
+					goto handle_CatTax
+
+				case ffj_t_App_PrivPolicy:
+					goto handle_PrivPolicy
+
+				case ffj_t_App_Keywords:
+					goto handle_Keywords
+
+				case ffj_t_App_Ext:
+					goto handle_Ext
+
+				case ffj_t_Appno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Bundle:
+
+	/* handler: uj.Bundle type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Bundle = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_StoreID:
+
+	/* handler: uj.StoreID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.StoreID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_StoreURL:
+
+	/* handler: uj.StoreURL type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.StoreURL = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ver:
+
+	/* handler: uj.Ver type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Ver = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Paid:
+
+	/* handler: uj.Paid type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Paid = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ID:
+
+	/* handler: uj.ID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.ID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Name:
+
+	/* handler: uj.Name type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Name = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Pub:
+
+	/* handler: uj.Pub type=adcom1.Publisher kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Pub = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Pub == nil {
+			uj.Pub = new(Publisher)
+		}
+
+		err = uj.Pub.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Content:
+
+	/* handler: uj.Content type=adcom1.Content kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Content = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Content == nil {
+			uj.Content = new(Content)
+		}
+
+		err = uj.Content.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Domain:
+
+	/* handler: uj.Domain type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Domain = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Cat:
+
+	/* handler: uj.Cat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Cat = nil
+		} else {
+
+			uj.Cat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Cat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Cat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__Cat = string(string(outBuf))
+
+					}
+				}
+
+				uj.Cat = append(uj.Cat, tmp_uj__Cat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SectCat:
+
+	/* handler: uj.SectCat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.SectCat = nil
+		} else {
+
+			uj.SectCat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__SectCat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__SectCat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__SectCat = string(string(outBuf))
+
+					}
+				}
+
+				uj.SectCat = append(uj.SectCat, tmp_uj__SectCat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PageCat:
+
+	/* handler: uj.PageCat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.PageCat = nil
+		} else {
+
+			uj.PageCat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__PageCat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__PageCat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__PageCat = string(string(outBuf))
+
+					}
+				}
+
+				uj.PageCat = append(uj.PageCat, tmp_uj__PageCat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CatTax:
+
+	/* handler: uj.CatTax type=openrtb.CategoryTaxonomy kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for CategoryTaxonomy", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.CatTax = openrtb.CategoryTaxonomy(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PrivPolicy:
+
+	/* handler: uj.PrivPolicy type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			uj.PrivPolicy = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int(tval)
+			uj.PrivPolicy = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Keywords:
+
+	/* handler: uj.Keywords type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Keywords = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *Content) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *Content) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.ID) != 0 {
+		buf.WriteString(`"id":`)
+		fflib.WriteJsonString(buf, string(mj.ID))
+		buf.WriteByte(',')
+	}
+	if mj.Episode != 0 {
+		buf.WriteString(`"episode":`)
+		fflib.FormatBits2(buf, uint64(mj.Episode), 10, mj.Episode < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Title) != 0 {
+		buf.WriteString(`"title":`)
+		fflib.WriteJsonString(buf, string(mj.Title))
+		buf.WriteByte(',')
+	}
+	if len(mj.Series) != 0 {
+		buf.WriteString(`"series":`)
+		fflib.WriteJsonString(buf, string(mj.Series))
+		buf.WriteByte(',')
+	}
+	if len(mj.Season) != 0 {
+		buf.WriteString(`"season":`)
+		fflib.WriteJsonString(buf, string(mj.Season))
+		buf.WriteByte(',')
+	}
+	if len(mj.Artist) != 0 {
+		buf.WriteString(`"artist":`)
+		fflib.WriteJsonString(buf, string(mj.Artist))
+		buf.WriteByte(',')
+	}
+	if len(mj.Genre) != 0 {
+		buf.WriteString(`"genre":`)
+		fflib.WriteJsonString(buf, string(mj.Genre))
+		buf.WriteByte(',')
+	}
+	if len(mj.Album) != 0 {
+		buf.WriteString(`"album":`)
+		fflib.WriteJsonString(buf, string(mj.Album))
+		buf.WriteByte(',')
+	}
+	if len(mj.ISRC) != 0 {
+		buf.WriteString(`"isrc":`)
+		fflib.WriteJsonString(buf, string(mj.ISRC))
+		buf.WriteByte(',')
+	}
+	if len(mj.URL) != 0 {
+		buf.WriteString(`"url":`)
+		fflib.WriteJsonString(buf, string(mj.URL))
+		buf.WriteByte(',')
+	}
+	if len(mj.Cat) != 0 {
+		buf.WriteString(`"cat":`)
+		if mj.Cat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Cat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.CatTax != 0 {
+		buf.WriteString(`"cattax":`)
+		fflib.FormatBits2(buf, uint64(mj.CatTax), 10, mj.CatTax < 0)
+		buf.WriteByte(',')
+	}
+	if mj.ProdQ != 0 {
+		buf.WriteString(`"prodq":`)
+		fflib.FormatBits2(buf, uint64(mj.ProdQ), 10, mj.ProdQ < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Context != 0 {
+		buf.WriteString(`"context":`)
+		fflib.FormatBits2(buf, uint64(mj.Context), 10, mj.Context < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Rating) != 0 {
+		buf.WriteString(`"rating":`)
+		fflib.WriteJsonString(buf, string(mj.Rating))
+		buf.WriteByte(',')
+	}
+	if len(mj.URating) != 0 {
+		buf.WriteString(`"urating":`)
+		fflib.WriteJsonString(buf, string(mj.URating))
+		buf.WriteByte(',')
+	}
+	if mj.MRating != 0 {
+		buf.WriteString(`"mrating":`)
+		fflib.FormatBits2(buf, uint64(mj.MRating), 10, mj.MRating < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Keywords) != 0 {
+		buf.WriteString(`"keywords":`)
+		fflib.WriteJsonString(buf, string(mj.Keywords))
+		buf.WriteByte(',')
+	}
+	if mj.Live != 0 {
+		buf.WriteString(`"live":`)
+		fflib.FormatBits2(buf, uint64(mj.Live), 10, mj.Live < 0)
+		buf.WriteByte(',')
+	}
+	if mj.SrcRel != 0 {
+		buf.WriteString(`"srcrel":`)
+		fflib.FormatBits2(buf, uint64(mj.SrcRel), 10, mj.SrcRel < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Len != 0 {
+		buf.WriteString(`"len":`)
+		fflib.FormatBits2(buf, uint64(mj.Len), 10, mj.Len < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Lang) != 0 {
+		buf.WriteString(`"lang":`)
+		fflib.WriteJsonString(buf, string(mj.Lang))
+		buf.WriteByte(',')
+	}
+	if mj.Embed != 0 {
+		buf.WriteString(`"embed":`)
+		fflib.FormatBits2(buf, uint64(mj.Embed), 10, mj.Embed < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Producer != nil {
+		if true {
+			buf.WriteString(`"producer":`)
+
+			{
+
+				err = mj.Producer.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Data) != 0 {
+		buf.WriteString(`"data":`)
+		if mj.Data != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Data {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					err = v.MarshalJSONBuf(buf)
+					if err != nil {
+						return err
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Contentbase = iota
+	ffj_t_Contentno_such_key
+
+	ffj_t_Content_ID
+
+	ffj_t_Content_Episode
+
+	ffj_t_Content_Title
+
+	ffj_t_Content_Series
+
+	ffj_t_Content_Season
+
+	ffj_t_Content_Artist
+
+	ffj_t_Content_Genre
+
+	ffj_t_Content_Album
+
+	ffj_t_Content_ISRC
+
+	ffj_t_Content_URL
+
+	ffj_t_Content_Cat
+
+	ffj_t_Content_CatTax
+
+	ffj_t_Content_ProdQ
+
+	ffj_t_Content_Context
+
+	ffj_t_Content_Rating
+
+	ffj_t_Content_URating
+
+	ffj_t_Content_MRating
+
+	ffj_t_Content_Keywords
+
+	ffj_t_Content_Live
+
+	ffj_t_Content_SrcRel
+
+	ffj_t_Content_Len
+
+	ffj_t_Content_Lang
+
+	ffj_t_Content_Embed
+
+	ffj_t_Content_Producer
+
+	ffj_t_Content_Data
+
+	ffj_t_Content_Ext
+)
+
+var ffj_key_Content_ID = []byte("id")
+
+var ffj_key_Content_Episode = []byte("episode")
+
+var ffj_key_Content_Title = []byte("title")
+
+var ffj_key_Content_Series = []byte("series")
+
+var ffj_key_Content_Season = []byte("season")
+
+var ffj_key_Content_Artist = []byte("artist")
+
+var ffj_key_Content_Genre = []byte("genre")
+
+var ffj_key_Content_Album = []byte("album")
+
+var ffj_key_Content_ISRC = []byte("isrc")
+
+var ffj_key_Content_URL = []byte("url")
+
+var ffj_key_Content_Cat = []byte("cat")
+
+var ffj_key_Content_CatTax = []byte("cattax")
+
+var ffj_key_Content_ProdQ = []byte("prodq")
+
+var ffj_key_Content_Context = []byte("context")
+
+var ffj_key_Content_Rating = []byte("rating")
+
+var ffj_key_Content_URating = []byte("urating")
+
+var ffj_key_Content_MRating = []byte("mrating")
+
+var ffj_key_Content_Keywords = []byte("keywords")
+
+var ffj_key_Content_Live = []byte("live")
+
+var ffj_key_Content_SrcRel = []byte("srcrel")
+
+var ffj_key_Content_Len = []byte("len")
+
+var ffj_key_Content_Lang = []byte("lang")
+
+var ffj_key_Content_Embed = []byte("embed")
+
+var ffj_key_Content_Producer = []byte("producer")
+
+var ffj_key_Content_Data = []byte("data")
+
+var ffj_key_Content_Ext = []byte("ext")
+
+func (uj *Content) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *Content) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Contentbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Contentno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffj_key_Content_Artist, kn) {
+						currentKey = ffj_t_Content_Artist
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Content_Album, kn) {
+						currentKey = ffj_t_Content_Album
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_Content_Cat, kn) {
+						currentKey = ffj_t_Content_Cat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Content_CatTax, kn) {
+						currentKey = ffj_t_Content_CatTax
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Content_Context, kn) {
+						currentKey = ffj_t_Content_Context
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_Content_Data, kn) {
+						currentKey = ffj_t_Content_Data
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_Content_Episode, kn) {
+						currentKey = ffj_t_Content_Episode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Content_Embed, kn) {
+						currentKey = ffj_t_Content_Embed
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Content_Ext, kn) {
+						currentKey = ffj_t_Content_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'g':
+
+					if bytes.Equal(ffj_key_Content_Genre, kn) {
+						currentKey = ffj_t_Content_Genre
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_Content_ID, kn) {
+						currentKey = ffj_t_Content_ID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Content_ISRC, kn) {
+						currentKey = ffj_t_Content_ISRC
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'k':
+
+					if bytes.Equal(ffj_key_Content_Keywords, kn) {
+						currentKey = ffj_t_Content_Keywords
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'l':
+
+					if bytes.Equal(ffj_key_Content_Live, kn) {
+						currentKey = ffj_t_Content_Live
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Content_Len, kn) {
+						currentKey = ffj_t_Content_Len
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Content_Lang, kn) {
+						currentKey = ffj_t_Content_Lang
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffj_key_Content_MRating, kn) {
+						currentKey = ffj_t_Content_MRating
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffj_key_Content_ProdQ, kn) {
+						currentKey = ffj_t_Content_ProdQ
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Content_Producer, kn) {
+						currentKey = ffj_t_Content_Producer
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'r':
+
+					if bytes.Equal(ffj_key_Content_Rating, kn) {
+						currentKey = ffj_t_Content_Rating
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffj_key_Content_Series, kn) {
+						currentKey = ffj_t_Content_Series
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Content_Season, kn) {
+						currentKey = ffj_t_Content_Season
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Content_SrcRel, kn) {
+						currentKey = ffj_t_Content_SrcRel
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffj_key_Content_Title, kn) {
+						currentKey = ffj_t_Content_Title
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'u':
+
+					if bytes.Equal(ffj_key_Content_URL, kn) {
+						currentKey = ffj_t_Content_URL
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Content_URating, kn) {
+						currentKey = ffj_t_Content_URating
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Ext, kn) {
+					currentKey = ffj_t_Content_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Data, kn) {
+					currentKey = ffj_t_Content_Data
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Producer, kn) {
+					currentKey = ffj_t_Content_Producer
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Embed, kn) {
+					currentKey = ffj_t_Content_Embed
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Lang, kn) {
+					currentKey = ffj_t_Content_Lang
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Len, kn) {
+					currentKey = ffj_t_Content_Len
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Content_SrcRel, kn) {
+					currentKey = ffj_t_Content_SrcRel
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Live, kn) {
+					currentKey = ffj_t_Content_Live
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Content_Keywords, kn) {
+					currentKey = ffj_t_Content_Keywords
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_MRating, kn) {
+					currentKey = ffj_t_Content_MRating
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_URating, kn) {
+					currentKey = ffj_t_Content_URating
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Rating, kn) {
+					currentKey = ffj_t_Content_Rating
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Context, kn) {
+					currentKey = ffj_t_Content_Context
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_ProdQ, kn) {
+					currentKey = ffj_t_Content_ProdQ
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_CatTax, kn) {
+					currentKey = ffj_t_Content_CatTax
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Cat, kn) {
+					currentKey = ffj_t_Content_Cat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_URL, kn) {
+					currentKey = ffj_t_Content_URL
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Content_ISRC, kn) {
+					currentKey = ffj_t_Content_ISRC
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Album, kn) {
+					currentKey = ffj_t_Content_Album
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Genre, kn) {
+					currentKey = ffj_t_Content_Genre
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Content_Artist, kn) {
+					currentKey = ffj_t_Content_Artist
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Content_Season, kn) {
+					currentKey = ffj_t_Content_Season
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Content_Series, kn) {
+					currentKey = ffj_t_Content_Series
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_Title, kn) {
+					currentKey = ffj_t_Content_Title
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Content_Episode, kn) {
+					currentKey = ffj_t_Content_Episode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Content_ID, kn) {
+					currentKey = ffj_t_Content_ID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Contentno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_Content_ID:
+					goto handle_ID
+
+				case ffj_t_Content_Episode:
+					goto handle_Episode
+
+				case ffj_t_Content_Title:
+					goto handle_Title
+
+				case ffj_t_Content_Series:
+					goto handle_Series
+
+				case ffj_t_Content_Season:
+					goto handle_Season
+
+				case ffj_t_Content_Artist:
+					goto handle_Artist
+
+				case ffj_t_Content_Genre:
+					goto handle_Genre
+
+				case ffj_t_Content_Album:
+					goto handle_Album
+
+				case ffj_t_Content_ISRC:
+					goto handle_ISRC
+
+				case ffj_t_Content_URL:
+					goto handle_URL
+
+				case ffj_t_Content_Cat:
+					goto handle_Cat
+
+				case ffj_t_Content_CatTax:
+					goto handle_CatTax
+
+				case ffj_t_Content_ProdQ:
+					goto handle_ProdQ
+
+				case ffj_t_Content_Context:
+					goto handle_Context
+
+				case ffj_t_Content_Rating:
+					goto handle_Rating
+
+				case ffj_t_Content_URating:
+					goto handle_URating
+
+				case ffj_t_Content_MRating:
+					goto handle_MRating
+
+				case ffj_t_Content_Keywords:
+					goto handle_Keywords
+
+				case ffj_t_Content_Live:
+					goto handle_Live
+
+				case ffj_t_Content_SrcRel:
+					goto handle_SrcRel
+
+				case ffj_t_Content_Len:
+					goto handle_Len
+
+				case ffj_t_Content_Lang:
+					goto handle_Lang
+
+				case ffj_t_Content_Embed:
+					goto handle_Embed
+
+				case ffj_t_Content_Producer:
+					goto handle_Producer
+
+				case ffj_t_Content_Data:
+					goto handle_Data
+
+				case ffj_t_Content_Ext:
+					goto handle_Ext
+
+				case ffj_t_Contentno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_ID:
+
+	/* handler: uj.ID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.ID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Episode:
+
+	/* handler: uj.Episode type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Episode = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Title:
+
+	/* handler: uj.Title type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Title = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Series:
+
+	/* handler: uj.Series type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Series = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Season:
+
+	/* handler: uj.Season type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Season = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Artist:
+
+	/* handler: uj.Artist type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Artist = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Genre:
+
+	/* handler: uj.Genre type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Genre = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Album:
+
+	/* handler: uj.Album type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Album = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ISRC:
+
+	/* handler: uj.ISRC type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.ISRC = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_URL:
+
+	/* handler: uj.URL type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.URL = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Cat:
+
+	/* handler: uj.Cat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Cat = nil
+		} else {
+
+			uj.Cat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Cat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Cat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__Cat = string(string(outBuf))
+
+					}
+				}
+
+				uj.Cat = append(uj.Cat, tmp_uj__Cat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CatTax:
+
+	/* handler: uj.CatTax type=openrtb.CategoryTaxonomy kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for CategoryTaxonomy", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.CatTax = openrtb.CategoryTaxonomy(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ProdQ:
+
+	/* handler: uj.ProdQ type=openrtb.ProductionQuality kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ProductionQuality", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.ProdQ = openrtb.ProductionQuality(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Context:
+
+	/* handler: uj.Context type=openrtb.ContentContext kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ContentContext", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Context = openrtb.ContentContext(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Rating:
+
+	/* handler: uj.Rating type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Rating = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_URating:
+
+	/* handler: uj.URating type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.URating = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MRating:
+
+	/* handler: uj.MRating type=openrtb.QAGMediaRating kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for QAGMediaRating", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MRating = openrtb.QAGMediaRating(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Keywords:
+
+	/* handler: uj.Keywords type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Keywords = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Live:
+
+	/* handler: uj.Live type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Live = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SrcRel:
+
+	/* handler: uj.SrcRel type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.SrcRel = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Len:
+
+	/* handler: uj.Len type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Len = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Lang:
+
+	/* handler: uj.Lang type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Lang = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Embed:
+
+	/* handler: uj.Embed type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Embed = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Producer:
+
+	/* handler: uj.Producer type=adcom1.Producer kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Producer = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Producer == nil {
+			uj.Producer = new(Producer)
+		}
+
+		err = uj.Producer.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Data:
+
+	/* handler: uj.Data type=[]adcom1.Data kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Data = nil
+		} else {
+
+			uj.Data = []Data{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Data Data
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Data type=adcom1.Data kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						state = fflib.FFParse_after_value
+						goto mainparse
+					}
+
+					err = tmp_uj__Data.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+					if err != nil {
+						return err
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				uj.Data = append(uj.Data, tmp_uj__Data)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *Data) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *Data) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.ID) != 0 {
+		buf.WriteString(`"id":`)
+		fflib.WriteJsonString(buf, string(mj.ID))
+		buf.WriteByte(',')
+	}
+	if len(mj.Name) != 0 {
+		buf.WriteString(`"name":`)
+		fflib.WriteJsonString(buf, string(mj.Name))
+		buf.WriteByte(',')
+	}
+	if len(mj.Segment) != 0 {
+		buf.WriteString(`"segment":`)
+		if mj.Segment != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Segment {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					err = v.MarshalJSONBuf(buf)
+					if err != nil {
+						return err
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Database = iota
+	ffj_t_Datano_such_key
+
+	ffj_t_Data_ID
+
+	ffj_t_Data_Name
+
+	ffj_t_Data_Segment
+
+	ffj_t_Data_Ext
+)
+
+var ffj_key_Data_ID = []byte("id")
+
+var ffj_key_Data_Name = []byte("name")
+
+var ffj_key_Data_Segment = []byte("segment")
+
+var ffj_key_Data_Ext = []byte("ext")
+
+func (uj *Data) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *Data) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Database
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Datano_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_Data_Ext, kn) {
+						currentKey = ffj_t_Data_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_Data_ID, kn) {
+						currentKey = ffj_t_Data_ID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffj_key_Data_Name, kn) {
+						currentKey = ffj_t_Data_Name
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffj_key_Data_Segment, kn) {
+						currentKey = ffj_t_Data_Segment
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Data_Ext, kn) {
+					currentKey = ffj_t_Data_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Data_Segment, kn) {
+					currentKey = ffj_t_Data_Segment
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Data_Name, kn) {
+					currentKey = ffj_t_Data_Name
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Data_ID, kn) {
+					currentKey = ffj_t_Data_ID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Datano_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_Data_ID:
+					goto handle_ID
+
+				case ffj_t_Data_Name:
+					goto handle_Name
+
+				case ffj_t_Data_Segment:
+					goto handle_Segment
+
+				case ffj_t_Data_Ext:
+					goto handle_Ext
+
+				case ffj_t_Datano_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_ID:
+
+	/* handler: uj.ID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.ID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Name:
+
+	/* handler: uj.Name type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Name = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Segment:
+
+	/* handler: uj.Segment type=[]adcom1.Segment kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Segment = nil
+		} else {
+
+			uj.Segment = []Segment{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Segment Segment
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Segment type=adcom1.Segment kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						state = fflib.FFParse_after_value
+						goto mainparse
+					}
+
+					err = tmp_uj__Segment.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+					if err != nil {
+						return err
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				uj.Segment = append(uj.Segment, tmp_uj__Segment)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *Device) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *Device) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if mj.Type != 0 {
+		buf.WriteString(`"type":`)
+		fflib.FormatBits2(buf, uint64(mj.Type), 10, mj.Type < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.UA) != 0 {
+		buf.WriteString(`"ua":`)
+		fflib.WriteJsonString(buf, string(mj.UA))
+		buf.WriteByte(',')
+	}
+	if len(mj.IFA) != 0 {
+		buf.WriteString(`"ifa":`)
+		fflib.WriteJsonString(buf, string(mj.IFA))
+		buf.WriteByte(',')
+	}
+	if mj.DNT != 0 {
+		buf.WriteString(`"dnt":`)
+		fflib.FormatBits2(buf, uint64(mj.DNT), 10, mj.DNT < 0)
+		buf.WriteByte(',')
+	}
+	if mj.LMT != 0 {
+		buf.WriteString(`"lmt":`)
+		fflib.FormatBits2(buf, uint64(mj.LMT), 10, mj.LMT < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Make) != 0 {
+		buf.WriteString(`"make":`)
+		fflib.WriteJsonString(buf, string(mj.Make))
+		buf.WriteByte(',')
+	}
+	if len(mj.Model) != 0 {
+		buf.WriteString(`"model":`)
+		fflib.WriteJsonString(buf, string(mj.Model))
+		buf.WriteByte(',')
+	}
+	if mj.OS != 0 {
+		buf.WriteString(`"os":`)
+		fflib.FormatBits2(buf, uint64(mj.OS), 10, mj.OS < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.OSV) != 0 {
+		buf.WriteString(`"osv":`)
+		fflib.WriteJsonString(buf, string(mj.OSV))
+		buf.WriteByte(',')
+	}
+	if len(mj.HWV) != 0 {
+		buf.WriteString(`"hwv":`)
+		fflib.WriteJsonString(buf, string(mj.HWV))
+		buf.WriteByte(',')
+	}
+	if mj.H != 0 {
+		buf.WriteString(`"h":`)
+		fflib.FormatBits2(buf, uint64(mj.H), 10, mj.H < 0)
+		buf.WriteByte(',')
+	}
+	if mj.W != 0 {
+		buf.WriteString(`"w":`)
+		fflib.FormatBits2(buf, uint64(mj.W), 10, mj.W < 0)
+		buf.WriteByte(',')
+	}
+	if mj.PPI != 0 {
+		buf.WriteString(`"ppi":`)
+		fflib.FormatBits2(buf, uint64(mj.PPI), 10, mj.PPI < 0)
+		buf.WriteByte(',')
+	}
+	if mj.PxRatio != 0 {
+		buf.WriteString(`"pxratio":`)
+		fflib.AppendFloat(buf, float64(mj.PxRatio), 'g', -1, 64)
+		buf.WriteByte(',')
+	}
+	if mj.JS != 0 {
+		buf.WriteString(`"js":`)
+		fflib.FormatBits2(buf, uint64(mj.JS), 10, mj.JS < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Lang) != 0 {
+		buf.WriteString(`"lang":`)
+		fflib.WriteJsonString(buf, string(mj.Lang))
+		buf.WriteByte(',')
+	}
+	if len(mj.IP) != 0 {
+		buf.WriteString(`"ip":`)
+		fflib.WriteJsonString(buf, string(mj.IP))
+		buf.WriteByte(',')
+	}
+	if len(mj.IPv6) != 0 {
+		buf.WriteString(`"ipv6":`)
+		fflib.WriteJsonString(buf, string(mj.IPv6))
+		buf.WriteByte(',')
+	}
+	if len(mj.XFF) != 0 {
+		buf.WriteString(`"xff":`)
+		fflib.WriteJsonString(buf, string(mj.XFF))
+		buf.WriteByte(',')
+	}
+	if mj.IPTr != 0 {
+		buf.WriteString(`"iptr":`)
+		fflib.FormatBits2(buf, uint64(mj.IPTr), 10, mj.IPTr < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Carrier) != 0 {
+		buf.WriteString(`"carrier":`)
+		fflib.WriteJsonString(buf, string(mj.Carrier))
+		buf.WriteByte(',')
+	}
+	if len(mj.MCCMNC) != 0 {
+		buf.WriteString(`"mccmnc":`)
+		fflib.WriteJsonString(buf, string(mj.MCCMNC))
+		buf.WriteByte(',')
+	}
+	if len(mj.MCCMNCSim) != 0 {
+		buf.WriteString(`"mccmncsim":`)
+		fflib.WriteJsonString(buf, string(mj.MCCMNCSim))
+		buf.WriteByte(',')
+	}
+	if mj.ConType != 0 {
+		buf.WriteString(`"contype":`)
+		fflib.FormatBits2(buf, uint64(mj.ConType), 10, mj.ConType < 0)
+		buf.WriteByte(',')
+	}
+	if mj.GeoFetch != 0 {
+		buf.WriteString(`"geofetch":`)
+		fflib.FormatBits2(buf, uint64(mj.GeoFetch), 10, mj.GeoFetch < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Geo != nil {
+		if true {
+			buf.WriteString(`"geo":`)
+
+			{
+
+				err = mj.Geo.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Devicebase = iota
+	ffj_t_Deviceno_such_key
+
+	ffj_t_Device_Type
+
+	ffj_t_Device_UA
+
+	ffj_t_Device_IFA
+
+	ffj_t_Device_DNT
+
+	ffj_t_Device_LMT
+
+	ffj_t_Device_Make
+
+	ffj_t_Device_Model
+
+	ffj_t_Device_OS
+
+	ffj_t_Device_OSV
+
+	ffj_t_Device_HWV
+
+	ffj_t_Device_H
+
+	ffj_t_Device_W
+
+	ffj_t_Device_PPI
+
+	ffj_t_Device_PxRatio
+
+	ffj_t_Device_JS
+
+	ffj_t_Device_Lang
+
+	ffj_t_Device_IP
+
+	ffj_t_Device_IPv6
+
+	ffj_t_Device_XFF
+
+	ffj_t_Device_IPTr
+
+	ffj_t_Device_Carrier
+
+	ffj_t_Device_MCCMNC
+
+	ffj_t_Device_MCCMNCSim
+
+	ffj_t_Device_ConType
+
+	ffj_t_Device_GeoFetch
+
+	ffj_t_Device_Geo
+
+	ffj_t_Device_Ext
+)
+
+var ffj_key_Device_Type = []byte("type")
+
+var ffj_key_Device_UA = []byte("ua")
+
+var ffj_key_Device_IFA = []byte("ifa")
+
+var ffj_key_Device_DNT = []byte("dnt")
+
+var ffj_key_Device_LMT = []byte("lmt")
+
+var ffj_key_Device_Make = []byte("make")
+
+var ffj_key_Device_Model = []byte("model")
+
+var ffj_key_Device_OS = []byte("os")
+
+var ffj_key_Device_OSV = []byte("osv")
+
+var ffj_key_Device_HWV = []byte("hwv")
+
+var ffj_key_Device_H = []byte("h")
+
+var ffj_key_Device_W = []byte("w")
+
+var ffj_key_Device_PPI = []byte("ppi")
+
+var ffj_key_Device_PxRatio = []byte("pxratio")
+
+var ffj_key_Device_JS = []byte("js")
+
+var ffj_key_Device_Lang = []byte("lang")
+
+var ffj_key_Device_IP = []byte("ip")
+
+var ffj_key_Device_IPv6 = []byte("ipv6")
+
+var ffj_key_Device_XFF = []byte("xff")
+
+var ffj_key_Device_IPTr = []byte("iptr")
+
+var ffj_key_Device_Carrier = []byte("carrier")
+
+var ffj_key_Device_MCCMNC = []byte("mccmnc")
+
+var ffj_key_Device_MCCMNCSim = []byte("mccmncsim")
+
+var ffj_key_Device_ConType = []byte("contype")
+
+var ffj_key_Device_GeoFetch = []byte("geofetch")
+
+var ffj_key_Device_Geo = []byte("geo")
+
+var ffj_key_Device_Ext = []byte("ext")
+
+func (uj *Device) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *Device) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Devicebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Deviceno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_Device_Carrier, kn) {
+						currentKey = ffj_t_Device_Carrier
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Device_ConType, kn) {
+						currentKey = ffj_t_Device_ConType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_Device_DNT, kn) {
+						currentKey = ffj_t_Device_DNT
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_Device_Ext, kn) {
+						currentKey = ffj_t_Device_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'g':
+
+					if bytes.Equal(ffj_key_Device_GeoFetch, kn) {
+						currentKey = ffj_t_Device_GeoFetch
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Device_Geo, kn) {
+						currentKey = ffj_t_Device_Geo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'h':
+
+					if bytes.Equal(ffj_key_Device_HWV, kn) {
+						currentKey = ffj_t_Device_HWV
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Device_H, kn) {
+						currentKey = ffj_t_Device_H
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_Device_IFA, kn) {
+						currentKey = ffj_t_Device_IFA
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Device_IP, kn) {
+						currentKey = ffj_t_Device_IP
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Device_IPv6, kn) {
+						currentKey = ffj_t_Device_IPv6
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Device_IPTr, kn) {
+						currentKey = ffj_t_Device_IPTr
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'j':
+
+					if bytes.Equal(ffj_key_Device_JS, kn) {
+						currentKey = ffj_t_Device_JS
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'l':
+
+					if bytes.Equal(ffj_key_Device_LMT, kn) {
+						currentKey = ffj_t_Device_LMT
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Device_Lang, kn) {
+						currentKey = ffj_t_Device_Lang
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffj_key_Device_Make, kn) {
+						currentKey = ffj_t_Device_Make
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Device_Model, kn) {
+						currentKey = ffj_t_Device_Model
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Device_MCCMNC, kn) {
+						currentKey = ffj_t_Device_MCCMNC
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Device_MCCMNCSim, kn) {
+						currentKey = ffj_t_Device_MCCMNCSim
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffj_key_Device_OS, kn) {
+						currentKey = ffj_t_Device_OS
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Device_OSV, kn) {
+						currentKey = ffj_t_Device_OSV
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffj_key_Device_PPI, kn) {
+						currentKey = ffj_t_Device_PPI
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Device_PxRatio, kn) {
+						currentKey = ffj_t_Device_PxRatio
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffj_key_Device_Type, kn) {
+						currentKey = ffj_t_Device_Type
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'u':
+
+					if bytes.Equal(ffj_key_Device_UA, kn) {
+						currentKey = ffj_t_Device_UA
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'w':
+
+					if bytes.Equal(ffj_key_Device_W, kn) {
+						currentKey = ffj_t_Device_W
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'x':
+
+					if bytes.Equal(ffj_key_Device_XFF, kn) {
+						currentKey = ffj_t_Device_XFF
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_Ext, kn) {
+					currentKey = ffj_t_Device_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_Geo, kn) {
+					currentKey = ffj_t_Device_Geo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_GeoFetch, kn) {
+					currentKey = ffj_t_Device_GeoFetch
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_ConType, kn) {
+					currentKey = ffj_t_Device_ConType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Device_MCCMNCSim, kn) {
+					currentKey = ffj_t_Device_MCCMNCSim
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_MCCMNC, kn) {
+					currentKey = ffj_t_Device_MCCMNC
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_Carrier, kn) {
+					currentKey = ffj_t_Device_Carrier
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_IPTr, kn) {
+					currentKey = ffj_t_Device_IPTr
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_XFF, kn) {
+					currentKey = ffj_t_Device_XFF
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffj_key_Device_IPv6, kn) {
+					currentKey = ffj_t_Device_IPv6
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_IP, kn) {
+					currentKey = ffj_t_Device_IP
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_Lang, kn) {
+					currentKey = ffj_t_Device_Lang
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Device_JS, kn) {
+					currentKey = ffj_t_Device_JS
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_PxRatio, kn) {
+					currentKey = ffj_t_Device_PxRatio
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_PPI, kn) {
+					currentKey = ffj_t_Device_PPI
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_W, kn) {
+					currentKey = ffj_t_Device_W
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_H, kn) {
+					currentKey = ffj_t_Device_H
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_HWV, kn) {
+					currentKey = ffj_t_Device_HWV
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Device_OSV, kn) {
+					currentKey = ffj_t_Device_OSV
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Device_OS, kn) {
+					currentKey = ffj_t_Device_OS
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_Model, kn) {
+					currentKey = ffj_t_Device_Model
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Device_Make, kn) {
+					currentKey = ffj_t_Device_Make
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_LMT, kn) {
+					currentKey = ffj_t_Device_LMT
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_DNT, kn) {
+					currentKey = ffj_t_Device_DNT
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_IFA, kn) {
+					currentKey = ffj_t_Device_IFA
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_UA, kn) {
+					currentKey = ffj_t_Device_UA
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Device_Type, kn) {
+					currentKey = ffj_t_Device_Type
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Deviceno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_Device_Type:
+					goto handle_Type
+
+				case ffj_t_Device_UA:
+					goto handle_UA
+
+				case ffj_t_Device_IFA:
+					goto handle_IFA
+
+				case ffj_t_Device_DNT:
+					goto handle_DNT
+
+				case ffj_t_Device_LMT:
+					goto handle_LMT
+
+				case ffj_t_Device_Make:
+					goto handle_Make
+
+				case ffj_t_Device_Model:
+					goto handle_Model
+
+				case ffj_t_Device_OS:
+					goto handle_OS
+
+				case ffj_t_Device_OSV:
+					goto handle_OSV
+
+				case ffj_t_Device_HWV:
+					goto handle_HWV
+
+				case ffj_t_Device_H:
+					goto handle_H
+
+				case ffj_t_Device_W:
+					goto handle_W
+
+				case ffj_t_Device_PPI:
+					goto handle_PPI
+
+				case ffj_t_Device_PxRatio:
+					goto handle_PxRatio
+
+				case ffj_t_Device_JS:
+					goto handle_JS
+
+				case ffj_t_Device_Lang:
+					goto handle_Lang
+
+				case ffj_t_Device_IP:
+					goto handle_IP
+
+				case ffj_t_Device_IPv6:
+					goto handle_IPv6
+
+				case ffj_t_Device_XFF:
+					goto handle_XFF
+
+				case ffj_t_Device_IPTr:
+					goto handle_IPTr
+
+				case ffj_t_Device_Carrier:
+					goto handle_Carrier
+
+				case ffj_t_Device_MCCMNC:
+					goto handle_MCCMNC
+
+				case ffj_t_Device_MCCMNCSim:
+					goto handle_MCCMNCSim
+
+				case ffj_t_Device_ConType:
+					goto handle_ConType
+
+				case ffj_t_Device_GeoFetch:
+					goto handle_GeoFetch
+
+				case ffj_t_Device_Geo:
+					goto handle_Geo
+
+				case ffj_t_Device_Ext:
+					goto handle_Ext
+
+				case ffj_t_Deviceno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Type:
+
+	/* handler: uj.Type type=openrtb.DeviceType kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for DeviceType", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Type = openrtb.DeviceType(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_UA:
+
+	/* handler: uj.UA type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.UA = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_IFA:
+
+	/* handler: uj.IFA type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.IFA = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_DNT:
+
+	/* handler: uj.DNT type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.DNT = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_LMT:
+
+	/* handler: uj.LMT type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.LMT = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Make:
+
+	/* handler: uj.Make type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Make = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Model:
+
+	/* handler: uj.Model type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Model = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_OS:
+
+	/* handler: uj.OS type=adcom1.OperatingSystem kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for OperatingSystem", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.OS = OperatingSystem(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_OSV:
+
+	/* handler: uj.OSV type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.OSV = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_HWV:
+
+	/* handler: uj.HWV type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.HWV = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_H:
+
+	/* handler: uj.H type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.H = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_W:
+
+	/* handler: uj.W type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.W = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PPI:
+
+	/* handler: uj.PPI type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.PPI = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PxRatio:
+
+	/* handler: uj.PxRatio type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.PxRatio = float64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_JS:
+
+	/* handler: uj.JS type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.JS = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Lang:
+
+	/* handler: uj.Lang type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Lang = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_IP:
+
+	/* handler: uj.IP type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.IP = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_IPv6:
+
+	/* handler: uj.IPv6 type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.IPv6 = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_XFF:
+
+	/* handler: uj.XFF type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.XFF = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_IPTr:
+
+	/* handler: uj.IPTr type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.IPTr = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Carrier:
+
+	/* handler: uj.Carrier type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Carrier = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MCCMNC:
+
+	/* handler: uj.MCCMNC type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.MCCMNC = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MCCMNCSim:
+
+	/* handler: uj.MCCMNCSim type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.MCCMNCSim = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ConType:
+
+	/* handler: uj.ConType type=openrtb.ConnectionType kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ConnectionType", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.ConType = openrtb.ConnectionType(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_GeoFetch:
+
+	/* handler: uj.GeoFetch type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.GeoFetch = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Geo:
+
+	/* handler: uj.Geo type=adcom1.Geo kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Geo = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Geo == nil {
+			uj.Geo = new(Geo)
+		}
+
+		err = uj.Geo.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *DistributionChannel) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *DistributionChannel) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.ID) != 0 {
+		buf.WriteString(`"id":`)
+		fflib.WriteJsonString(buf, string(mj.ID))
+		buf.WriteByte(',')
+	}
+	if len(mj.Name) != 0 {
+		buf.WriteString(`"name":`)
+		fflib.WriteJsonString(buf, string(mj.Name))
+		buf.WriteByte(',')
+	}
+	if mj.Pub != nil {
+		if true {
+			buf.WriteString(`"pub":`)
+
+			{
+
+				err = mj.Pub.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if mj.Content != nil {
+		if true {
+			buf.WriteString(`"content":`)
+
+			{
+
+				err = mj.Content.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Domain) != 0 {
+		buf.WriteString(`"domain":`)
+		fflib.WriteJsonString(buf, string(mj.Domain))
+		buf.WriteByte(',')
+	}
+	if len(mj.Cat) != 0 {
+		buf.WriteString(`"cat":`)
+		if mj.Cat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Cat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.SectCat) != 0 {
+		buf.WriteString(`"sectcat":`)
+		if mj.SectCat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.SectCat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.PageCat) != 0 {
+		buf.WriteString(`"pagecat":`)
+		if mj.PageCat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.PageCat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.CatTax != 0 {
+		buf.WriteString(`"cattax":`)
+		fflib.FormatBits2(buf, uint64(mj.CatTax), 10, mj.CatTax < 0)
+		buf.WriteByte(',')
+	}
+	if mj.PrivPolicy != nil {
+		if true {
+			buf.WriteString(`"privpolicy":`)
+			fflib.FormatBits2(buf, uint64(*mj.PrivPolicy), 10, *mj.PrivPolicy < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Keywords) != 0 {
+		buf.WriteString(`"keywords":`)
+		fflib.WriteJsonString(buf, string(mj.Keywords))
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_DistributionChannelbase = iota
+	ffj_t_DistributionChannelno_such_key
+
+	ffj_t_DistributionChannel_ID
+
+	ffj_t_DistributionChannel_Name
+
+	ffj_t_DistributionChannel_Pub
+
+	ffj_t_DistributionChannel_Content
+
+	ffj_t_DistributionChannel_Domain
+
+	ffj_t_DistributionChannel_Cat
+
+	ffj_t_DistributionChannel_SectCat
+
+	ffj_t_DistributionChannel_PageCat
+
+	ffj_t_DistributionChannel_CatTax
+
+	ffj_t_DistributionChannel_PrivPolicy
+
+	ffj_t_DistributionChannel_Keywords
+
+	ffj_t_DistributionChannel_Ext
+)
+
+var ffj_key_DistributionChannel_ID = []byte("id")
+
+var ffj_key_DistributionChannel_Name = []byte("name")
+
+var ffj_key_DistributionChannel_Pub = []byte("pub")
+
+var ffj_key_DistributionChannel_Content = []byte("content")
+
+var ffj_key_DistributionChannel_Domain = []byte("domain")
+
+var ffj_key_DistributionChannel_Cat = []byte("cat")
+
+var ffj_key_DistributionChannel_SectCat = []byte("sectcat")
+
+var ffj_key_DistributionChannel_PageCat = []byte("pagecat")
+
+var ffj_key_DistributionChannel_CatTax = []byte("cattax")
+
+var ffj_key_DistributionChannel_PrivPolicy = []byte("privpolicy")
+
+var ffj_key_DistributionChannel_Keywords = []byte("keywords")
+
+var ffj_key_DistributionChannel_Ext = []byte("ext")
+
+func (uj *DistributionChannel) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *DistributionChannel) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_DistributionChannelbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_DistributionChannelno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_DistributionChannel_Content, kn) {
+						currentKey = ffj_t_DistributionChannel_Content
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DistributionChannel_Cat, kn) {
+						currentKey = ffj_t_DistributionChannel_Cat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DistributionChannel_CatTax, kn) {
+						currentKey = ffj_t_DistributionChannel_CatTax
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_DistributionChannel_Domain, kn) {
+						currentKey = ffj_t_DistributionChannel_Domain
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_DistributionChannel_Ext, kn) {
+						currentKey = ffj_t_DistributionChannel_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_DistributionChannel_ID, kn) {
+						currentKey = ffj_t_DistributionChannel_ID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'k':
+
+					if bytes.Equal(ffj_key_DistributionChannel_Keywords, kn) {
+						currentKey = ffj_t_DistributionChannel_Keywords
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffj_key_DistributionChannel_Name, kn) {
+						currentKey = ffj_t_DistributionChannel_Name
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffj_key_DistributionChannel_Pub, kn) {
+						currentKey = ffj_t_DistributionChannel_Pub
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DistributionChannel_PageCat, kn) {
+						currentKey = ffj_t_DistributionChannel_PageCat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DistributionChannel_PrivPolicy, kn) {
+						currentKey = ffj_t_DistributionChannel_PrivPolicy
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffj_key_DistributionChannel_SectCat, kn) {
+						currentKey = ffj_t_DistributionChannel_SectCat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DistributionChannel_Ext, kn) {
+					currentKey = ffj_t_DistributionChannel_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_DistributionChannel_Keywords, kn) {
+					currentKey = ffj_t_DistributionChannel_Keywords
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DistributionChannel_PrivPolicy, kn) {
+					currentKey = ffj_t_DistributionChannel_PrivPolicy
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DistributionChannel_CatTax, kn) {
+					currentKey = ffj_t_DistributionChannel_CatTax
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DistributionChannel_PageCat, kn) {
+					currentKey = ffj_t_DistributionChannel_PageCat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_DistributionChannel_SectCat, kn) {
+					currentKey = ffj_t_DistributionChannel_SectCat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DistributionChannel_Cat, kn) {
+					currentKey = ffj_t_DistributionChannel_Cat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DistributionChannel_Domain, kn) {
+					currentKey = ffj_t_DistributionChannel_Domain
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DistributionChannel_Content, kn) {
+					currentKey = ffj_t_DistributionChannel_Content
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DistributionChannel_Pub, kn) {
+					currentKey = ffj_t_DistributionChannel_Pub
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DistributionChannel_Name, kn) {
+					currentKey = ffj_t_DistributionChannel_Name
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DistributionChannel_ID, kn) {
+					currentKey = ffj_t_DistributionChannel_ID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_DistributionChannelno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_DistributionChannel_ID:
+					goto handle_ID
+
+				case ffj_t_DistributionChannel_Name:
+					goto handle_Name
+
+				case ffj_t_DistributionChannel_Pub:
+					goto handle_Pub
+
+				case ffj_t_DistributionChannel_Content:
+					goto handle_Content
+
+				case ffj_t_DistributionChannel_Domain:
+					goto handle_Domain
+
+				case ffj_t_DistributionChannel_Cat:
+					goto handle_Cat
+
+				case ffj_t_DistributionChannel_SectCat:
+					goto handle_SectCat
+
+				case ffj_t_DistributionChannel_PageCat:
+					goto handle_PageCat
+
+				case ffj_t_DistributionChannel_CatTax:
+					goto handle_CatTax
+
+				case ffj_t_DistributionChannel_PrivPolicy:
+					goto handle_PrivPolicy
+
+				case ffj_t_DistributionChannel_Keywords:
+					goto handle_Keywords
+
+				case ffj_t_DistributionChannel_Ext:
+					goto handle_Ext
+
+				case ffj_t_DistributionChannelno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_ID:
+
+	/* handler: uj.ID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.ID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Name:
+
+	/* handler: uj.Name type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Name = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Pub:
+
+	/* handler: uj.Pub type=adcom1.Publisher kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Pub = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Pub == nil {
+			uj.Pub = new(Publisher)
+		}
+
+		err = uj.Pub.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Content:
+
+	/* handler: uj.Content type=adcom1.Content kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Content = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Content == nil {
+			uj.Content = new(Content)
+		}
+
+		err = uj.Content.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Domain:
+
+	/* handler: uj.Domain type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Domain = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Cat:
+
+	/* handler: uj.Cat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Cat = nil
+		} else {
+
+			uj.Cat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Cat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Cat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__Cat = string(string(outBuf))
+
+					}
+				}
+
+				uj.Cat = append(uj.Cat, tmp_uj__Cat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SectCat:
+
+	/* handler: uj.SectCat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.SectCat = nil
+		} else {
+
+			uj.SectCat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__SectCat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__SectCat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__SectCat = string(string(outBuf))
+
+					}
+				}
+
+				uj.SectCat = append(uj.SectCat, tmp_uj__SectCat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PageCat:
+
+	/* handler: uj.PageCat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.PageCat = nil
+		} else {
+
+			uj.PageCat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__PageCat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__PageCat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__PageCat = string(string(outBuf))
+
+					}
+				}
+
+				uj.PageCat = append(uj.PageCat, tmp_uj__PageCat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CatTax:
+
+	/* handler: uj.CatTax type=openrtb.CategoryTaxonomy kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for CategoryTaxonomy", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.CatTax = openrtb.CategoryTaxonomy(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PrivPolicy:
+
+	/* handler: uj.PrivPolicy type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			uj.PrivPolicy = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int(tval)
+			uj.PrivPolicy = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Keywords:
+
+	/* handler: uj.Keywords type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Keywords = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *Geo) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *Geo) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if mj.Type != 0 {
+		buf.WriteString(`"type":`)
+		fflib.FormatBits2(buf, uint64(mj.Type), 10, mj.Type < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Lat != 0 {
+		buf.WriteString(`"lat":`)
+		fflib.AppendFloat(buf, float64(mj.Lat), 'g', -1, 64)
+		buf.WriteByte(',')
+	}
+	if mj.Lon != 0 {
+		buf.WriteString(`"lon":`)
+		fflib.AppendFloat(buf, float64(mj.Lon), 'g', -1, 64)
+		buf.WriteByte(',')
+	}
+	if mj.Accur != 0 {
+		buf.WriteString(`"accur":`)
+		fflib.FormatBits2(buf, uint64(mj.Accur), 10, mj.Accur < 0)
+		buf.WriteByte(',')
+	}
+	if mj.LastFix != 0 {
+		buf.WriteString(`"lastfix":`)
+		fflib.FormatBits2(buf, uint64(mj.LastFix), 10, mj.LastFix < 0)
+		buf.WriteByte(',')
+	}
+	if mj.IPServ != 0 {
+		buf.WriteString(`"ipserv":`)
+		fflib.FormatBits2(buf, uint64(mj.IPServ), 10, mj.IPServ < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Country) != 0 {
+		buf.WriteString(`"country":`)
+		fflib.WriteJsonString(buf, string(mj.Country))
+		buf.WriteByte(',')
+	}
+	if len(mj.Region) != 0 {
+		buf.WriteString(`"region":`)
+		fflib.WriteJsonString(buf, string(mj.Region))
+		buf.WriteByte(',')
+	}
+	if len(mj.Metro) != 0 {
+		buf.WriteString(`"metro":`)
+		fflib.WriteJsonString(buf, string(mj.Metro))
+		buf.WriteByte(',')
+	}
+	if len(mj.City) != 0 {
+		buf.WriteString(`"city":`)
+		fflib.WriteJsonString(buf, string(mj.City))
+		buf.WriteByte(',')
+	}
+	if len(mj.Zip) != 0 {
+		buf.WriteString(`"zip":`)
+		fflib.WriteJsonString(buf, string(mj.Zip))
+		buf.WriteByte(',')
+	}
+	if mj.UTCOffset != 0 {
+		buf.WriteString(`"utcoffset":`)
+		fflib.FormatBits2(buf, uint64(mj.UTCOffset), 10, mj.UTCOffset < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Geobase = iota
+	ffj_t_Geono_such_key
+
+	ffj_t_Geo_Type
+
+	ffj_t_Geo_Lat
+
+	ffj_t_Geo_Lon
+
+	ffj_t_Geo_Accur
+
+	ffj_t_Geo_LastFix
+
+	ffj_t_Geo_IPServ
+
+	ffj_t_Geo_Country
+
+	ffj_t_Geo_Region
+
+	ffj_t_Geo_Metro
+
+	ffj_t_Geo_City
+
+	ffj_t_Geo_Zip
+
+	ffj_t_Geo_UTCOffset
+
+	ffj_t_Geo_Ext
+)
+
+var ffj_key_Geo_Type = []byte("type")
+
+var ffj_key_Geo_Lat = []byte("lat")
+
+var ffj_key_Geo_Lon = []byte("lon")
+
+var ffj_key_Geo_Accur = []byte("accur")
+
+var ffj_key_Geo_LastFix = []byte("lastfix")
+
+var ffj_key_Geo_IPServ = []byte("ipserv")
+
+var ffj_key_Geo_Country = []byte("country")
+
+var ffj_key_Geo_Region = []byte("region")
+
+var ffj_key_Geo_Metro = []byte("metro")
+
+var ffj_key_Geo_City = []byte("city")
+
+var ffj_key_Geo_Zip = []byte("zip")
+
+var ffj_key_Geo_UTCOffset = []byte("utcoffset")
+
+var ffj_key_Geo_Ext = []byte("ext")
+
+func (uj *Geo) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *Geo) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Geobase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Geono_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffj_key_Geo_Accur, kn) {
+						currentKey = ffj_t_Geo_Accur
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_Geo_Country, kn) {
+						currentKey = ffj_t_Geo_Country
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Geo_City, kn) {
+						currentKey = ffj_t_Geo_City
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_Geo_Ext, kn) {
+						currentKey = ffj_t_Geo_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_Geo_IPServ, kn) {
+						currentKey = ffj_t_Geo_IPServ
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'l':
+
+					if bytes.Equal(ffj_key_Geo_Lat, kn) {
+						currentKey = ffj_t_Geo_Lat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Geo_Lon, kn) {
+						currentKey = ffj_t_Geo_Lon
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Geo_LastFix, kn) {
+						currentKey = ffj_t_Geo_LastFix
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffj_key_Geo_Metro, kn) {
+						currentKey = ffj_t_Geo_Metro
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'r':
+
+					if bytes.Equal(ffj_key_Geo_Region, kn) {
+						currentKey = ffj_t_Geo_Region
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffj_key_Geo_Type, kn) {
+						currentKey = ffj_t_Geo_Type
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'u':
+
+					if bytes.Equal(ffj_key_Geo_UTCOffset, kn) {
+						currentKey = ffj_t_Geo_UTCOffset
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'z':
+
+					if bytes.Equal(ffj_key_Geo_Zip, kn) {
+						currentKey = ffj_t_Geo_Zip
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Geo_Ext, kn) {
+					currentKey = ffj_t_Geo_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Geo_UTCOffset, kn) {
+					currentKey = ffj_t_Geo_UTCOffset
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Geo_Zip, kn) {
+					currentKey = ffj_t_Geo_Zip
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Geo_City, kn) {
+					currentKey = ffj_t_Geo_City
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Geo_Metro, kn) {
+					currentKey = ffj_t_Geo_Metro
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Geo_Region, kn) {
+					currentKey = ffj_t_Geo_Region
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Geo_Country, kn) {
+					currentKey = ffj_t_Geo_Country
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Geo_IPServ, kn) {
+					currentKey = ffj_t_Geo_IPServ
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Geo_LastFix, kn) {
+					currentKey = ffj_t_Geo_LastFix
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Geo_Accur, kn) {
+					currentKey = ffj_t_Geo_Accur
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Geo_Lon, kn) {
+					currentKey = ffj_t_Geo_Lon
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Geo_Lat, kn) {
+					currentKey = ffj_t_Geo_Lat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Geo_Type, kn) {
+					currentKey = ffj_t_Geo_Type
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Geono_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_Geo_Type:
+					goto handle_Type
+
+				case ffj_t_Geo_Lat:
+					goto handle_Lat
+
+				case ffj_t_Geo_Lon:
+					goto handle_Lon
+
+				case ffj_t_Geo_Accur:
+					goto handle_Accur
+
+				case ffj_t_Geo_LastFix:
+					goto handle_LastFix
+
+				case ffj_t_Geo_IPServ:
+					goto handle_IPServ
+
+				case ffj_t_Geo_Country:
+					goto handle_Country
+
+				case ffj_t_Geo_Region:
+					goto handle_Region
+
+				case ffj_t_Geo_Metro:
+					goto handle_Metro
+
+				case ffj_t_Geo_City:
+					goto handle_City
+
+				case ffj_t_Geo_Zip:
+					goto handle_Zip
+
+				case ffj_t_Geo_UTCOffset:
+					goto handle_UTCOffset
+
+				case ffj_t_Geo_Ext:
+					goto handle_Ext
+
+				case ffj_t_Geono_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Type:
+
+	/* handler: uj.Type type=openrtb.LocationType kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for LocationType", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Type = openrtb.LocationType(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Lat:
+
+	/* handler: uj.Lat type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Lat = float64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Lon:
+
+	/* handler: uj.Lon type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Lon = float64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Accur:
+
+	/* handler: uj.Accur type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Accur = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_LastFix:
+
+	/* handler: uj.LastFix type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.LastFix = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_IPServ:
+
+	/* handler: uj.IPServ type=openrtb.LocationService kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for LocationService", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.IPServ = openrtb.LocationService(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Country:
+
+	/* handler: uj.Country type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Country = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Region:
+
+	/* handler: uj.Region type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Region = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Metro:
+
+	/* handler: uj.Metro type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Metro = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_City:
+
+	/* handler: uj.City type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.City = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Zip:
+
+	/* handler: uj.Zip type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Zip = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_UTCOffset:
+
+	/* handler: uj.UTCOffset type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.UTCOffset = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *Producer) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *Producer) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.ID) != 0 {
+		buf.WriteString(`"id":`)
+		fflib.WriteJsonString(buf, string(mj.ID))
+		buf.WriteByte(',')
+	}
+	if len(mj.Name) != 0 {
+		buf.WriteString(`"name":`)
+		fflib.WriteJsonString(buf, string(mj.Name))
+		buf.WriteByte(',')
+	}
+	if len(mj.Domain) != 0 {
+		buf.WriteString(`"domain":`)
+		fflib.WriteJsonString(buf, string(mj.Domain))
+		buf.WriteByte(',')
+	}
+	if len(mj.Cat) != 0 {
+		buf.WriteString(`"cat":`)
+		if mj.Cat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Cat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.CatTax != 0 {
+		buf.WriteString(`"cattax":`)
+		fflib.FormatBits2(buf, uint64(mj.CatTax), 10, mj.CatTax < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Producerbase = iota
+	ffj_t_Producerno_such_key
+
+	ffj_t_Producer_ID
+
+	ffj_t_Producer_Name
+
+	ffj_t_Producer_Domain
+
+	ffj_t_Producer_Cat
+
+	ffj_t_Producer_CatTax
+
+	ffj_t_Producer_Ext
+)
+
+var ffj_key_Producer_ID = []byte("id")
+
+var ffj_key_Producer_Name = []byte("name")
+
+var ffj_key_Producer_Domain = []byte("domain")
+
+var ffj_key_Producer_Cat = []byte("cat")
+
+var ffj_key_Producer_CatTax = []byte("cattax")
+
+var ffj_key_Producer_Ext = []byte("ext")
+
+func (uj *Producer) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *Producer) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Producerbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Producerno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_Producer_Cat, kn) {
+						currentKey = ffj_t_Producer_Cat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Producer_CatTax, kn) {
+						currentKey = ffj_t_Producer_CatTax
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_Producer_Domain, kn) {
+						currentKey = ffj_t_Producer_Domain
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_Producer_Ext, kn) {
+						currentKey = ffj_t_Producer_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_Producer_ID, kn) {
+						currentKey = ffj_t_Producer_ID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffj_key_Producer_Name, kn) {
+						currentKey = ffj_t_Producer_Name
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Producer_Ext, kn) {
+					currentKey = ffj_t_Producer_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Producer_CatTax, kn) {
+					currentKey = ffj_t_Producer_CatTax
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Producer_Cat, kn) {
+					currentKey = ffj_t_Producer_Cat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Producer_Domain, kn) {
+					currentKey = ffj_t_Producer_Domain
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Producer_Name, kn) {
+					currentKey = ffj_t_Producer_Name
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Producer_ID, kn) {
+					currentKey = ffj_t_Producer_ID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Producerno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_Producer_ID:
+					goto handle_ID
+
+				case ffj_t_Producer_Name:
+					goto handle_Name
+
+				case ffj_t_Producer_Domain:
+					goto handle_Domain
+
+				case ffj_t_Producer_Cat:
+					goto handle_Cat
+
+				case ffj_t_Producer_CatTax:
+					goto handle_CatTax
+
+				case ffj_t_Producer_Ext:
+					goto handle_Ext
+
+				case ffj_t_Producerno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_ID:
+
+	/* handler: uj.ID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.ID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Name:
+
+	/* handler: uj.Name type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Name = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Domain:
+
+	/* handler: uj.Domain type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Domain = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Cat:
+
+	/* handler: uj.Cat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Cat = nil
+		} else {
+
+			uj.Cat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Cat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Cat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__Cat = string(string(outBuf))
+
+					}
+				}
+
+				uj.Cat = append(uj.Cat, tmp_uj__Cat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CatTax:
+
+	/* handler: uj.CatTax type=openrtb.CategoryTaxonomy kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for CategoryTaxonomy", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.CatTax = openrtb.CategoryTaxonomy(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *Publisher) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *Publisher) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.ID) != 0 {
+		buf.WriteString(`"id":`)
+		fflib.WriteJsonString(buf, string(mj.ID))
+		buf.WriteByte(',')
+	}
+	if len(mj.Name) != 0 {
+		buf.WriteString(`"name":`)
+		fflib.WriteJsonString(buf, string(mj.Name))
+		buf.WriteByte(',')
+	}
+	if len(mj.Domain) != 0 {
+		buf.WriteString(`"domain":`)
+		fflib.WriteJsonString(buf, string(mj.Domain))
+		buf.WriteByte(',')
+	}
+	if len(mj.Cat) != 0 {
+		buf.WriteString(`"cat":`)
+		if mj.Cat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Cat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.CatTax != 0 {
+		buf.WriteString(`"cattax":`)
+		fflib.FormatBits2(buf, uint64(mj.CatTax), 10, mj.CatTax < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Publisherbase = iota
+	ffj_t_Publisherno_such_key
+
+	ffj_t_Publisher_ID
+
+	ffj_t_Publisher_Name
+
+	ffj_t_Publisher_Domain
+
+	ffj_t_Publisher_Cat
+
+	ffj_t_Publisher_CatTax
+
+	ffj_t_Publisher_Ext
+)
+
+var ffj_key_Publisher_ID = []byte("id")
+
+var ffj_key_Publisher_Name = []byte("name")
+
+var ffj_key_Publisher_Domain = []byte("domain")
+
+var ffj_key_Publisher_Cat = []byte("cat")
+
+var ffj_key_Publisher_CatTax = []byte("cattax")
+
+var ffj_key_Publisher_Ext = []byte("ext")
+
+func (uj *Publisher) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *Publisher) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Publisherbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Publisherno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_Publisher_Cat, kn) {
+						currentKey = ffj_t_Publisher_Cat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Publisher_CatTax, kn) {
+						currentKey = ffj_t_Publisher_CatTax
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_Publisher_Domain, kn) {
+						currentKey = ffj_t_Publisher_Domain
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_Publisher_Ext, kn) {
+						currentKey = ffj_t_Publisher_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_Publisher_ID, kn) {
+						currentKey = ffj_t_Publisher_ID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffj_key_Publisher_Name, kn) {
+						currentKey = ffj_t_Publisher_Name
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Publisher_Ext, kn) {
+					currentKey = ffj_t_Publisher_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Publisher_CatTax, kn) {
+					currentKey = ffj_t_Publisher_CatTax
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Publisher_Cat, kn) {
+					currentKey = ffj_t_Publisher_Cat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Publisher_Domain, kn) {
+					currentKey = ffj_t_Publisher_Domain
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Publisher_Name, kn) {
+					currentKey = ffj_t_Publisher_Name
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Publisher_ID, kn) {
+					currentKey = ffj_t_Publisher_ID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Publisherno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_Publisher_ID:
+					goto handle_ID
+
+				case ffj_t_Publisher_Name:
+					goto handle_Name
+
+				case ffj_t_Publisher_Domain:
+					goto handle_Domain
+
+				case ffj_t_Publisher_Cat:
+					goto handle_Cat
+
+				case ffj_t_Publisher_CatTax:
+					goto handle_CatTax
+
+				case ffj_t_Publisher_Ext:
+					goto handle_Ext
+
+				case ffj_t_Publisherno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_ID:
+
+	/* handler: uj.ID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.ID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Name:
+
+	/* handler: uj.Name type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Name = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Domain:
+
+	/* handler: uj.Domain type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Domain = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Cat:
+
+	/* handler: uj.Cat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Cat = nil
+		} else {
+
+			uj.Cat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Cat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Cat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__Cat = string(string(outBuf))
+
+					}
+				}
+
+				uj.Cat = append(uj.Cat, tmp_uj__Cat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CatTax:
+
+	/* handler: uj.CatTax type=openrtb.CategoryTaxonomy kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for CategoryTaxonomy", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.CatTax = openrtb.CategoryTaxonomy(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *Regs) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *Regs) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if mj.COPPA != 0 {
+		buf.WriteString(`"coppa":`)
+		fflib.FormatBits2(buf, uint64(mj.COPPA), 10, mj.COPPA < 0)
+		buf.WriteByte(',')
+	}
+	if mj.GDPR != nil {
+		if true {
+			buf.WriteString(`"gdpr":`)
+			fflib.FormatBits2(buf, uint64(*mj.GDPR), 10, *mj.GDPR < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Regsbase = iota
+	ffj_t_Regsno_such_key
+
+	ffj_t_Regs_COPPA
+
+	ffj_t_Regs_GDPR
+
+	ffj_t_Regs_Ext
+)
+
+var ffj_key_Regs_COPPA = []byte("coppa")
+
+var ffj_key_Regs_GDPR = []byte("gdpr")
+
+var ffj_key_Regs_Ext = []byte("ext")
+
+func (uj *Regs) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *Regs) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Regsbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Regsno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_Regs_COPPA, kn) {
+						currentKey = ffj_t_Regs_COPPA
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_Regs_Ext, kn) {
+						currentKey = ffj_t_Regs_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'g':
+
+					if bytes.Equal(ffj_key_Regs_GDPR, kn) {
+						currentKey = ffj_t_Regs_GDPR
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Regs_Ext, kn) {
+					currentKey = ffj_t_Regs_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Regs_GDPR, kn) {
+					currentKey = ffj_t_Regs_GDPR
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Regs_COPPA, kn) {
+					currentKey = ffj_t_Regs_COPPA
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Regsno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_Regs_COPPA:
+					goto handle_COPPA
+
+				case ffj_t_Regs_GDPR:
+					goto handle_GDPR
+
+				case ffj_t_Regs_Ext:
+					goto handle_Ext
+
+				case ffj_t_Regsno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_COPPA:
+
+	/* handler: uj.COPPA type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.COPPA = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_GDPR:
+
+	/* handler: uj.GDPR type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			uj.GDPR = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int(tval)
+			uj.GDPR = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *Restrictions) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *Restrictions) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.BCat) != 0 {
+		buf.WriteString(`"bcat":`)
+		if mj.BCat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.BCat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.CatTax != 0 {
+		buf.WriteString(`"cattax":`)
+		fflib.FormatBits2(buf, uint64(mj.CatTax), 10, mj.CatTax < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.BAdv) != 0 {
+		buf.WriteString(`"badv":`)
+		if mj.BAdv != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.BAdv {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.BApp) != 0 {
+		buf.WriteString(`"bapp":`)
+		if mj.BApp != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.BApp {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.BAttr) != 0 {
+		buf.WriteString(`"battr":`)
+		if mj.BAttr != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.BAttr {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Restrictionsbase = iota
+	ffj_t_Restrictionsno_such_key
+
+	ffj_t_Restrictions_BCat
+
+	ffj_t_Restrictions_CatTax
+
+	ffj_t_Restrictions_BAdv
+
+	ffj_t_Restrictions_BApp
+
+	ffj_t_Restrictions_BAttr
+
+	ffj_t_Restrictions_Ext
+)
+
+var ffj_key_Restrictions_BCat = []byte("bcat")
+
+var ffj_key_Restrictions_CatTax = []byte("cattax")
+
+var ffj_key_Restrictions_BAdv = []byte("badv")
+
+var ffj_key_Restrictions_BApp = []byte("bapp")
+
+var ffj_key_Restrictions_BAttr = []byte("battr")
+
+var ffj_key_Restrictions_Ext = []byte("ext")
+
+func (uj *Restrictions) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *Restrictions) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Restrictionsbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Restrictionsno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffj_key_Restrictions_BCat, kn) {
+						currentKey = ffj_t_Restrictions_BCat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Restrictions_BAdv, kn) {
+						currentKey = ffj_t_Restrictions_BAdv
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Restrictions_BApp, kn) {
+						currentKey = ffj_t_Restrictions_BApp
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Restrictions_BAttr, kn) {
+						currentKey = ffj_t_Restrictions_BAttr
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_Restrictions_CatTax, kn) {
+						currentKey = ffj_t_Restrictions_CatTax
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_Restrictions_Ext, kn) {
+						currentKey = ffj_t_Restrictions_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Restrictions_Ext, kn) {
+					currentKey = ffj_t_Restrictions_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Restrictions_BAttr, kn) {
+					currentKey = ffj_t_Restrictions_BAttr
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Restrictions_BApp, kn) {
+					currentKey = ffj_t_Restrictions_BApp
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Restrictions_BAdv, kn) {
+					currentKey = ffj_t_Restrictions_BAdv
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Restrictions_CatTax, kn) {
+					currentKey = ffj_t_Restrictions_CatTax
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Restrictions_BCat, kn) {
+					currentKey = ffj_t_Restrictions_BCat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Restrictionsno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_Restrictions_BCat:
+					goto handle_BCat
+
+				case ffj_t_Restrictions_CatTax:
+					goto handle_CatTax
+
+				case ffj_t_Restrictions_BAdv:
+					goto handle_BAdv
+
+				case ffj_t_Restrictions_BApp:
+					goto handle_BApp
+
+				case ffj_t_Restrictions_BAttr:
+					goto handle_BAttr
+
+				case ffj_t_Restrictions_Ext:
+					goto handle_Ext
+
+				case ffj_t_Restrictionsno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_BCat:
+
+	/* handler: uj.BCat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.BCat = nil
+		} else {
+
+			uj.BCat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__BCat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__BCat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__BCat = string(string(outBuf))
+
+					}
+				}
+
+				uj.BCat = append(uj.BCat, tmp_uj__BCat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CatTax:
+
+	/* handler: uj.CatTax type=openrtb.CategoryTaxonomy kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for CategoryTaxonomy", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.CatTax = openrtb.CategoryTaxonomy(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BAdv:
+
+	/* handler: uj.BAdv type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.BAdv = nil
+		} else {
+
+			uj.BAdv = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__BAdv string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__BAdv type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__BAdv = string(string(outBuf))
+
+					}
+				}
+
+				uj.BAdv = append(uj.BAdv, tmp_uj__BAdv)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BApp:
+
+	/* handler: uj.BApp type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.BApp = nil
+		} else {
+
+			uj.BApp = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__BApp string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__BApp type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__BApp = string(string(outBuf))
+
+					}
+				}
+
+				uj.BApp = append(uj.BApp, tmp_uj__BApp)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BAttr:
+
+	/* handler: uj.BAttr type=[]openrtb.CreativeAttribute kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.BAttr = nil
+		} else {
+
+			uj.BAttr = []openrtb.CreativeAttribute{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__BAttr openrtb.CreativeAttribute
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__BAttr type=openrtb.CreativeAttribute kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for CreativeAttribute", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__BAttr = openrtb.CreativeAttribute(tval)
+
+					}
+				}
+
+				uj.BAttr = append(uj.BAttr, tmp_uj__BAttr)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *Segment) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *Segment) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.ID) != 0 {
+		buf.WriteString(`"id":`)
+		fflib.WriteJsonString(buf, string(mj.ID))
+		buf.WriteByte(',')
+	}
+	if len(mj.Name) != 0 {
+		buf.WriteString(`"name":`)
+		fflib.WriteJsonString(buf, string(mj.Name))
+		buf.WriteByte(',')
+	}
+	if len(mj.Value) != 0 {
+		buf.WriteString(`"value":`)
+		fflib.WriteJsonString(buf, string(mj.Value))
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Segmentbase = iota
+	ffj_t_Segmentno_such_key
+
+	ffj_t_Segment_ID
+
+	ffj_t_Segment_Name
+
+	ffj_t_Segment_Value
+
+	ffj_t_Segment_Ext
+)
+
+var ffj_key_Segment_ID = []byte("id")
+
+var ffj_key_Segment_Name = []byte("name")
+
+var ffj_key_Segment_Value = []byte("value")
+
+var ffj_key_Segment_Ext = []byte("ext")
+
+func (uj *Segment) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *Segment) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Segmentbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Segmentno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_Segment_Ext, kn) {
+						currentKey = ffj_t_Segment_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_Segment_ID, kn) {
+						currentKey = ffj_t_Segment_ID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffj_key_Segment_Name, kn) {
+						currentKey = ffj_t_Segment_Name
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'v':
+
+					if bytes.Equal(ffj_key_Segment_Value, kn) {
+						currentKey = ffj_t_Segment_Value
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Segment_Ext, kn) {
+					currentKey = ffj_t_Segment_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Segment_Value, kn) {
+					currentKey = ffj_t_Segment_Value
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Segment_Name, kn) {
+					currentKey = ffj_t_Segment_Name
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Segment_ID, kn) {
+					currentKey = ffj_t_Segment_ID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Segmentno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_Segment_ID:
+					goto handle_ID
+
+				case ffj_t_Segment_Name:
+					goto handle_Name
+
+				case ffj_t_Segment_Value:
+					goto handle_Value
+
+				case ffj_t_Segment_Ext:
+					goto handle_Ext
+
+				case ffj_t_Segmentno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_ID:
+
+	/* handler: uj.ID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.ID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Name:
+
+	/* handler: uj.Name type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Name = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Value:
+
+	/* handler: uj.Value type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Value = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *Site) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *Site) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.Page) != 0 {
+		buf.WriteString(`"page":`)
+		fflib.WriteJsonString(buf, string(mj.Page))
+		buf.WriteByte(',')
+	}
+	if len(mj.Ref) != 0 {
+		buf.WriteString(`"ref":`)
+		fflib.WriteJsonString(buf, string(mj.Ref))
+		buf.WriteByte(',')
+	}
+	if len(mj.Search) != 0 {
+		buf.WriteString(`"search":`)
+		fflib.WriteJsonString(buf, string(mj.Search))
+		buf.WriteByte(',')
+	}
+	if mj.Mobile != 0 {
+		buf.WriteString(`"mobile":`)
+		fflib.FormatBits2(buf, uint64(mj.Mobile), 10, mj.Mobile < 0)
+		buf.WriteByte(',')
+	}
+	if mj.AMP != 0 {
+		buf.WriteString(`"amp":`)
+		fflib.FormatBits2(buf, uint64(mj.AMP), 10, mj.AMP < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.ID) != 0 {
+		buf.WriteString(`"id":`)
+		fflib.WriteJsonString(buf, string(mj.ID))
+		buf.WriteByte(',')
+	}
+	if len(mj.Name) != 0 {
+		buf.WriteString(`"name":`)
+		fflib.WriteJsonString(buf, string(mj.Name))
+		buf.WriteByte(',')
+	}
+	if mj.Pub != nil {
+		if true {
+			buf.WriteString(`"pub":`)
+
+			{
+
+				err = mj.Pub.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if mj.Content != nil {
+		if true {
+			buf.WriteString(`"content":`)
+
+			{
+
+				err = mj.Content.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Domain) != 0 {
+		buf.WriteString(`"domain":`)
+		fflib.WriteJsonString(buf, string(mj.Domain))
+		buf.WriteByte(',')
+	}
+	if len(mj.Cat) != 0 {
+		buf.WriteString(`"cat":`)
+		if mj.Cat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Cat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.SectCat) != 0 {
+		buf.WriteString(`"sectcat":`)
+		if mj.SectCat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.SectCat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.PageCat) != 0 {
+		buf.WriteString(`"pagecat":`)
+		if mj.PageCat != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.PageCat {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.CatTax != 0 {
+		buf.WriteString(`"cattax":`)
+		fflib.FormatBits2(buf, uint64(mj.CatTax), 10, mj.CatTax < 0)
+		buf.WriteByte(',')
+	}
+	if mj.PrivPolicy != nil {
+		if true {
+			buf.WriteString(`"privpolicy":`)
+			fflib.FormatBits2(buf, uint64(*mj.PrivPolicy), 10, *mj.PrivPolicy < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Keywords) != 0 {
+		buf.WriteString(`"keywords":`)
+		fflib.WriteJsonString(buf, string(mj.Keywords))
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Sitebase = iota
+	ffj_t_Siteno_such_key
+
+	ffj_t_Site_Page
+
+	ffj_t_Site_Ref
+
+	ffj_t_Site_Search
+
+	ffj_t_Site_Mobile
+
+	ffj_t_Site_AMP
+
+	ffj_t_Site_ID
+
+	ffj_t_Site_Name
+
+	ffj_t_Site_Pub
+
+	ffj_t_Site_Content
+
+	ffj_t_Site_Domain
+
+	ffj_t_Site_Cat
+
+	ffj_t_Site_SectCat
+
+	ffj_t_Site_PageCat
+
+	ffj_t_Site_CatTax
+
+	ffj_t_Site_PrivPolicy
+
+	ffj_t_Site_Keywords
+
+	ffj_t_Site_Ext
+)
+
+var ffj_key_Site_Page = []byte("page")
+
+var ffj_key_Site_Ref = []byte("ref")
+
+var ffj_key_Site_Search = []byte("search")
+
+var ffj_key_Site_Mobile = []byte("mobile")
+
+var ffj_key_Site_AMP = []byte("amp")
+
+var ffj_key_Site_ID = []byte("id")
+
+var ffj_key_Site_Name = []byte("name")
+
+var ffj_key_Site_Pub = []byte("pub")
+
+var ffj_key_Site_Content = []byte("content")
+
+var ffj_key_Site_Domain = []byte("domain")
+
+var ffj_key_Site_Cat = []byte("cat")
+
+var ffj_key_Site_SectCat = []byte("sectcat")
+
+var ffj_key_Site_PageCat = []byte("pagecat")
+
+var ffj_key_Site_CatTax = []byte("cattax")
+
+var ffj_key_Site_PrivPolicy = []byte("privpolicy")
+
+var ffj_key_Site_Keywords = []byte("keywords")
+
+var ffj_key_Site_Ext = []byte("ext")
+
+func (uj *Site) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *Site) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Sitebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Siteno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffj_key_Site_AMP, kn) {
+						currentKey = ffj_t_Site_AMP
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_Site_Content, kn) {
+						currentKey = ffj_t_Site_Content
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Site_Cat, kn) {
+						currentKey = ffj_t_Site_Cat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Site_CatTax, kn) {
+						currentKey = ffj_t_Site_CatTax
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_Site_Domain, kn) {
+						currentKey = ffj_t_Site_Domain
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_Site_Ext, kn) {
+						currentKey = ffj_t_Site_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_Site_ID, kn) {
+						currentKey = ffj_t_Site_ID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'k':
+
+					if bytes.Equal(ffj_key_Site_Keywords, kn) {
+						currentKey = ffj_t_Site_Keywords
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffj_key_Site_Mobile, kn) {
+						currentKey = ffj_t_Site_Mobile
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffj_key_Site_Name, kn) {
+						currentKey = ffj_t_Site_Name
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffj_key_Site_Page, kn) {
+						currentKey = ffj_t_Site_Page
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Site_Pub, kn) {
+						currentKey = ffj_t_Site_Pub
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Site_PageCat, kn) {
+						currentKey = ffj_t_Site_PageCat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Site_PrivPolicy, kn) {
+						currentKey = ffj_t_Site_PrivPolicy
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'r':
+
+					if bytes.Equal(ffj_key_Site_Ref, kn) {
+						currentKey = ffj_t_Site_Ref
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffj_key_Site_Search, kn) {
+						currentKey = ffj_t_Site_Search
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Site_SectCat, kn) {
+						currentKey = ffj_t_Site_SectCat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_Ext, kn) {
+					currentKey = ffj_t_Site_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Site_Keywords, kn) {
+					currentKey = ffj_t_Site_Keywords
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_PrivPolicy, kn) {
+					currentKey = ffj_t_Site_PrivPolicy
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_CatTax, kn) {
+					currentKey = ffj_t_Site_CatTax
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_PageCat, kn) {
+					currentKey = ffj_t_Site_PageCat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Site_SectCat, kn) {
+					currentKey = ffj_t_Site_SectCat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_Cat, kn) {
+					currentKey = ffj_t_Site_Cat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_Domain, kn) {
+					currentKey = ffj_t_Site_Domain
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_Content, kn) {
+					currentKey = ffj_t_Site_Content
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_Pub, kn) {
+					currentKey = ffj_t_Site_Pub
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_Name, kn) {
+					currentKey = ffj_t_Site_Name
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_ID, kn) {
+					currentKey = ffj_t_Site_ID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_AMP, kn) {
+					currentKey = ffj_t_Site_AMP
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_Mobile, kn) {
+					currentKey = ffj_t_Site_Mobile
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Site_Search, kn) {
+					currentKey = ffj_t_Site_Search
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_Ref, kn) {
+					currentKey = ffj_t_Site_Ref
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Site_Page, kn) {
+					currentKey = ffj_t_Site_Page
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Siteno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_Site_Page:
+					goto handle_Page
+
+				case ffj_t_Site_Ref:
+					goto handle_Ref
+
+				case ffj_t_Site_Search:
+					goto handle_Search
+
+				case ffj_t_Site_Mobile:
+					goto handle_Mobile
+
+				case ffj_t_Site_AMP:
+					goto handle_AMP
+
+				case ffj_t_Site_ID:
+					goto handle_ID
+
+				case ffj_t_Site_Name:
+					goto handle_Name
+
+				case ffj_t_Site_Pub:
+					goto handle_Pub
+
+				case ffj_t_Site_Content:
+					goto handle_Content
+
+				case ffj_t_Site_Domain:
+					goto handle_Domain
+
+				case ffj_t_Site_Cat:
+					goto handle_Cat
+
+				case ffj_t_Site_SectCat:
+					goto handle_SectCat
+
+				case ffj_t_Site_PageCat:
+					goto handle_PageCat
+
+				case ffj_t_Site_CatTax:
+					goto handle_CatTax
+
+				case ffj_t_Site_PrivPolicy:
+					goto handle_PrivPolicy
+
+				case ffj_t_Site_Keywords:
+					goto handle_Keywords
+
+				case ffj_t_Site_Ext:
+					goto handle_Ext
+
+				case ffj_t_Siteno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Page:
+
+	/* handler: uj.Page type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Page = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ref:
+
+	/* handler: uj.Ref type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Ref = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Search:
+
+	/* handler: uj.Search type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Search = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Mobile:
+
+	/* handler: uj.Mobile type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Mobile = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_AMP:
+
+	/* handler: uj.AMP type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.AMP = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ID:
+
+	/* handler: uj.ID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.ID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Name:
+
+	/* handler: uj.Name type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Name = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Pub:
+
+	/* handler: uj.Pub type=adcom1.Publisher kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Pub = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Pub == nil {
+			uj.Pub = new(Publisher)
+		}
+
+		err = uj.Pub.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Content:
+
+	/* handler: uj.Content type=adcom1.Content kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Content = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Content == nil {
+			uj.Content = new(Content)
+		}
+
+		err = uj.Content.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Domain:
+
+	/* handler: uj.Domain type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Domain = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Cat:
+
+	/* handler: uj.Cat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Cat = nil
+		} else {
+
+			uj.Cat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Cat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Cat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__Cat = string(string(outBuf))
+
+					}
+				}
+
+				uj.Cat = append(uj.Cat, tmp_uj__Cat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SectCat:
+
+	/* handler: uj.SectCat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.SectCat = nil
+		} else {
+
+			uj.SectCat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__SectCat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__SectCat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__SectCat = string(string(outBuf))
+
+					}
+				}
+
+				uj.SectCat = append(uj.SectCat, tmp_uj__SectCat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PageCat:
+
+	/* handler: uj.PageCat type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.PageCat = nil
+		} else {
+
+			uj.PageCat = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__PageCat string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__PageCat type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__PageCat = string(string(outBuf))
+
+					}
+				}
+
+				uj.PageCat = append(uj.PageCat, tmp_uj__PageCat)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CatTax:
+
+	/* handler: uj.CatTax type=openrtb.CategoryTaxonomy kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for CategoryTaxonomy", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.CatTax = openrtb.CategoryTaxonomy(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PrivPolicy:
+
+	/* handler: uj.PrivPolicy type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			uj.PrivPolicy = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int(tval)
+			uj.PrivPolicy = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Keywords:
+
+	/* handler: uj.Keywords type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Keywords = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *User) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *User) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.ID) != 0 {
+		buf.WriteString(`"id":`)
+		fflib.WriteJsonString(buf, string(mj.ID))
+		buf.WriteByte(',')
+	}
+	if len(mj.BuyerUID) != 0 {
+		buf.WriteString(`"buyeruid":`)
+		fflib.WriteJsonString(buf, string(mj.BuyerUID))
+		buf.WriteByte(',')
+	}
+	if mj.YOB != 0 {
+		buf.WriteString(`"yob":`)
+		fflib.FormatBits2(buf, uint64(mj.YOB), 10, mj.YOB < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Gender) != 0 {
+		buf.WriteString(`"gender":`)
+		fflib.WriteJsonString(buf, string(mj.Gender))
+		buf.WriteByte(',')
+	}
+	if len(mj.Keywords) != 0 {
+		buf.WriteString(`"keywords":`)
+		fflib.WriteJsonString(buf, string(mj.Keywords))
+		buf.WriteByte(',')
+	}
+	if len(mj.Consent) != 0 {
+		buf.WriteString(`"consent":`)
+		fflib.WriteJsonString(buf, string(mj.Consent))
+		buf.WriteByte(',')
+	}
+	if mj.Geo != nil {
+		if true {
+			buf.WriteString(`"geo":`)
+
+			{
+
+				err = mj.Geo.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Data) != 0 {
+		buf.WriteString(`"data":`)
+		if mj.Data != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Data {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					err = v.MarshalJSONBuf(buf)
+					if err != nil {
+						return err
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Userbase = iota
+	ffj_t_Userno_such_key
+
+	ffj_t_User_ID
+
+	ffj_t_User_BuyerUID
+
+	ffj_t_User_YOB
+
+	ffj_t_User_Gender
+
+	ffj_t_User_Keywords
+
+	ffj_t_User_Consent
+
+	ffj_t_User_Geo
+
+	ffj_t_User_Data
+
+	ffj_t_User_Ext
+)
+
+var ffj_key_User_ID = []byte("id")
+
+var ffj_key_User_BuyerUID = []byte("buyeruid")
+
+var ffj_key_User_YOB = []byte("yob")
+
+var ffj_key_User_Gender = []byte("gender")
+
+var ffj_key_User_Keywords = []byte("keywords")
+
+var ffj_key_User_Consent = []byte("consent")
+
+var ffj_key_User_Geo = []byte("geo")
+
+var ffj_key_User_Data = []byte("data")
+
+var ffj_key_User_Ext = []byte("ext")
+
+func (uj *User) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *User) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Userbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Userno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffj_key_User_BuyerUID, kn) {
+						currentKey = ffj_t_User_BuyerUID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_User_Consent, kn) {
+						currentKey = ffj_t_User_Consent
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_User_Data, kn) {
+						currentKey = ffj_t_User_Data
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_User_Ext, kn) {
+						currentKey = ffj_t_User_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'g':
+
+					if bytes.Equal(ffj_key_User_Gender, kn) {
+						currentKey = ffj_t_User_Gender
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_User_Geo, kn) {
+						currentKey = ffj_t_User_Geo
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_User_ID, kn) {
+						currentKey = ffj_t_User_ID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'k':
+
+					if bytes.Equal(ffj_key_User_Keywords, kn) {
+						currentKey = ffj_t_User_Keywords
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'y':
+
+					if bytes.Equal(ffj_key_User_YOB, kn) {
+						currentKey = ffj_t_User_YOB
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_User_Ext, kn) {
+					currentKey = ffj_t_User_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_User_Data, kn) {
+					currentKey = ffj_t_User_Data
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_User_Geo, kn) {
+					currentKey = ffj_t_User_Geo
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_User_Consent, kn) {
+					currentKey = ffj_t_User_Consent
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_User_Keywords, kn) {
+					currentKey = ffj_t_User_Keywords
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_User_Gender, kn) {
+					currentKey = ffj_t_User_Gender
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_User_YOB, kn) {
+					currentKey = ffj_t_User_YOB
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_User_BuyerUID, kn) {
+					currentKey = ffj_t_User_BuyerUID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_User_ID, kn) {
+					currentKey = ffj_t_User_ID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Userno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_User_ID:
+					goto handle_ID
+
+				case ffj_t_User_BuyerUID:
+					goto handle_BuyerUID
+
+				case ffj_t_User_YOB:
+					goto handle_YOB
+
+				case ffj_t_User_Gender:
+					goto handle_Gender
+
+				case ffj_t_User_Keywords:
+					goto handle_Keywords
+
+				case ffj_t_User_Consent:
+					goto handle_Consent
+
+				case ffj_t_User_Geo:
+					goto handle_Geo
+
+				case ffj_t_User_Data:
+					goto handle_Data
+
+				case ffj_t_User_Ext:
+					goto handle_Ext
+
+				case ffj_t_Userno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_ID:
+
+	/* handler: uj.ID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.ID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BuyerUID:
+
+	/* handler: uj.BuyerUID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.BuyerUID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_YOB:
+
+	/* handler: uj.YOB type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.YOB = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Gender:
+
+	/* handler: uj.Gender type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Gender = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Keywords:
+
+	/* handler: uj.Keywords type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Keywords = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Consent:
+
+	/* handler: uj.Consent type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Consent = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Geo:
+
+	/* handler: uj.Geo type=adcom1.Geo kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Geo = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Geo == nil {
+			uj.Geo = new(Geo)
+		}
+
+		err = uj.Geo.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Data:
+
+	/* handler: uj.Data type=[]adcom1.Data kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Data = nil
+		} else {
+
+			uj.Data = []Data{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Data Data
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Data type=adcom1.Data kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						state = fflib.FFParse_after_value
+						goto mainparse
+					}
+
+					err = tmp_uj__Data.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+					if err != nil {
+						return err
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				uj.Data = append(uj.Data, tmp_uj__Data)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
@@ -0,0 +1,413 @@
+package adcom1
+
+//go:generate ffjson $GOFILE
+
+import "github.com/bsm/openrtb"
+
+// This object describes the placement in which an ad will be displayed, i.e. the
+// unit of supply offered by the publisher. Exactly one of the display, video or
+// audio subtypes should be present for a given item, although multiple may be
+// offered where the publisher accepts more than one media.
+type Placement struct {
+	TagID   string            `json:"tagid,omitempty"`  // Identifier for specific ad placement or ad tag
+	SSAI    openrtb.SSAIType  `json:"ssai,omitempty"`   // Indicates if server-side ad insertion is used
+	SDK     string            `json:"sdk,omitempty"`    // Name of ad mediation partner, SDK technology or player
+	SDKVer  string            `json:"sdkver,omitempty"` // Version of the SDK
+	Reward  int               `json:"reward,omitempty"` // Indicates a rewarded placement, where 0 = no, 1 = yes
+	WLang   []string          `json:"wlang,omitempty"`  // Whitelist of permitted languages of the creative using ISO-639-1-alpha-2
+	WLangB  []string          `json:"wlangb,omitempty"` // Whitelist of permitted languages of the creative using IETF BCP 47
+	Secure  int               `json:"secure,omitempty"` // Flag to indicate if the creative must be secure (HTTPS), where 0 = no, 1 = yes
+	AdmX    int               `json:"admx,omitempty"`   // Indicates if including markup is supported, where 0 = no, 1 = yes
+	CURLX   int               `json:"curlx,omitempty"`  // Indicates if the markup may be served via URL, where 0 = no, 1 = yes
+	Display *DisplayPlacement `json:"display,omitempty"`
+	Video   *VideoPlacement   `json:"video,omitempty"`
+	Audio   *AudioPlacement   `json:"audio,omitempty"`
+	Ext     openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (p *Placement) Reset() {
+	p.TagID = ""
+	p.SSAI = 0
+	p.SDK = ""
+	p.SDKVer = ""
+	p.Reward = 0
+	if p.WLang != nil {
+		p.WLang = p.WLang[:0]
+	}
+	if p.WLangB != nil {
+		p.WLangB = p.WLangB[:0]
+	}
+	p.Secure = 0
+	p.AdmX = 0
+	p.CURLX = 0
+	p.Display = nil
+	p.Video = nil
+	p.Audio = nil
+	p.Ext = nil
+}
+
+// This object signals that the placement may be a display placement. It
+// covers banners and native formats alike.
+type DisplayPlacement struct {
+	Pos        openrtb.AdPosition     `json:"pos,omitempty"`        // Placement position on the screen
+	Instl      int                    `json:"instl,omitempty"`      // Indicates if this is an interstitial placement, where 0 = no, 1 = yes
+	TopFrame   int                    `json:"topframe,omitempty"`   // Indicates if the placement is in the top frame, where 0 = no, 1 = yes
+	IfrBust    []string               `json:"ifrbust,omitempty"`    // Names of supported iframe busters
+	ClkType    ClickType              `json:"clktype,omitempty"`    // Placement clickability and type of browser
+	AmpRen     int                    `json:"ampren,omitempty"`     // AMP ad rendering mode
+	PType      DisplayPlacementType   `json:"ptype,omitempty"`      // Type of the display placement
+	Context    DisplayContextType     `json:"context,omitempty"`    // Context of the content surrounding the placement
+	MIME       []string               `json:"mime,omitempty"`       // Supported MIME types
+	API        []openrtb.APIFramework `json:"api,omitempty"`        // Supported API frameworks
+	CType      []DisplayCreativeType  `json:"ctype,omitempty"`      // Supported creative subtypes
+	W          int                    `json:"w,omitempty"`          // Width of the placement in units specified by unit
+	H          int                    `json:"h,omitempty"`          // Height of the placement in units specified by unit
+	Unit       SizeUnit               `json:"unit,omitempty"`       // Unit of size used for w and h
+	Priv       int                    `json:"priv,omitempty"`       // Indicates if the ad privacy icon is rendered by the publisher, where 0 = no, 1 = yes
+	DisplayFmt []DisplayFormat        `json:"displayfmt,omitempty"` // Permitted display formats
+	NativeFmt  *NativeFormat          `json:"nativefmt,omitempty"`  // Permitted native format
+	Event      []EventSpec            `json:"event,omitempty"`      // Supported event tracking
+	Ext        openrtb.Extension      `json:"ext,omitempty"`
+}
+
+func (d *DisplayPlacement) Reset() {
+	d.Pos = 0
+	d.Instl = 0
+	d.TopFrame = 0
+	if d.IfrBust != nil {
+		d.IfrBust = d.IfrBust[:0]
+	}
+	d.ClkType = 0
+	d.AmpRen = 0
+	d.PType = 0
+	d.Context = 0
+	if d.MIME != nil {
+		d.MIME = d.MIME[:0]
+	}
+	if d.API != nil {
+		d.API = d.API[:0]
+	}
+	if d.CType != nil {
+		d.CType = d.CType[:0]
+	}
+	d.W = 0
+	d.H = 0
+	d.Unit = 0
+	d.Priv = 0
+	if d.DisplayFmt != nil {
+		for i := 0; i < len(d.DisplayFmt); i++ {
+			(&d.DisplayFmt[i]).Reset()
+		}
+		d.DisplayFmt = d.DisplayFmt[:0]
+	}
+	d.NativeFmt = nil
+	if d.Event != nil {
+		for i := 0; i < len(d.Event); i++ {
+			(&d.Event[i]).Reset()
+		}
+		d.Event = d.Event[:0]
+	}
+	d.Ext = nil
+}
+
+// This object represents an allowed size or aspect ratio of a display placement.
+type DisplayFormat struct {
+	W      int                           `json:"w,omitempty"`      // Absolute width of the creative
+	H      int                           `json:"h,omitempty"`      // Absolute height of the creative
+	WRatio int                           `json:"wratio,omitempty"` // Relative width when expressing size as a ratio
+	HRatio int                           `json:"hratio,omitempty"` // Relative height when expressing size as a ratio
+	ExpDir []openrtb.ExpandableDirection `json:"expdir,omitempty"` // Directions in which the creative is permitted to expand
+	Ext    openrtb.Extension             `json:"ext,omitempty"`
+}
+
+func (d *DisplayFormat) Reset() {
+	d.W = 0
+	d.H = 0
+	d.WRatio = 0
+	d.HRatio = 0
+	if d.ExpDir != nil {
+		d.ExpDir = d.ExpDir[:0]
+	}
+	d.Ext = nil
+}
+
+// This object specifies the assets permitted in a native display placement.
+type NativeFormat struct {
+	Asset []AssetFormat     `json:"asset,omitempty"` // Permitted asset formats
+	Ext   openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (n *NativeFormat) Reset() {
+	if n.Asset != nil {
+		for i := 0; i < len(n.Asset); i++ {
+			(&n.Asset[i]).Reset()
+		}
+		n.Asset = n.Asset[:0]
+	}
+	n.Ext = nil
+}
+
+// This object represents a permitted asset of a native format. Exactly one of
+// the title, img, video or data subtypes must be present.
+type AssetFormat struct {
+	ID    int               `json:"id"`              // Asset ID, unique within the native format
+	Req   int               `json:"req,omitempty"`   // Indicates if the asset is required, where 0 = no, 1 = yes
+	Title *TitleAssetFormat `json:"title,omitempty"` // Title asset format
+	Img   *ImageAssetFormat `json:"img,omitempty"`   // Image asset format
+	Video *VideoPlacement   `json:"video,omitempty"` // Video asset format
+	Data  *DataAssetFormat  `json:"data,omitempty"`  // Data asset format
+	Ext   openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (a *AssetFormat) Reset() {
+	a.ID = 0
+	a.Req = 0
+	a.Title = nil
+	a.Img = nil
+	a.Video = nil
+	a.Data = nil
+	a.Ext = nil
+}
+
+// This object is used to describe a title asset format.
+type TitleAssetFormat struct {
+	Len int               `json:"len"` // Maximum length of the title text
+	Ext openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (t *TitleAssetFormat) Reset() {
+	t.Len = 0
+	t.Ext = nil
+}
+
+// This object is used to describe an image asset format.
+type ImageAssetFormat struct {
+	Type   NativeImageAssetType `json:"type,omitempty"`   // Type of image asset
+	MIME   []string             `json:"mime,omitempty"`   // Supported MIME types
+	W      int                  `json:"w,omitempty"`      // Absolute width of the image
+	H      int                  `json:"h,omitempty"`      // Absolute height of the image
+	WMin   int                  `json:"wmin,omitempty"`   // Minimum width of the image
+	HMin   int                  `json:"hmin,omitempty"`   // Minimum height of the image
+	WRatio int                  `json:"wratio,omitempty"` // Relative width when expressing size as a ratio
+	HRatio int                  `json:"hratio,omitempty"` // Relative height when expressing size as a ratio
+	Ext    openrtb.Extension    `json:"ext,omitempty"`
+}
+
+func (i *ImageAssetFormat) Reset() {
+	i.Type = 0
+	if i.MIME != nil {
+		i.MIME = i.MIME[:0]
+	}
+	i.W = 0
+	i.H = 0
+	i.WMin = 0
+	i.HMin = 0
+	i.WRatio = 0
+	i.HRatio = 0
+	i.Ext = nil
+}
+
+// This object is used to describe a data asset format.
+type DataAssetFormat struct {
+	Type NativeDataAssetType `json:"type"`          // Type of data asset
+	Len  int                 `json:"len,omitempty"` // Maximum length of the data value
+	Ext  openrtb.Extension   `json:"ext,omitempty"`
+}
+
+func (d *DataAssetFormat) Reset() {
+	d.Type = 0
+	d.Len = 0
+	d.Ext = nil
+}
+
+// This object specifies the type and methods of an event tracking supported by
+// the placement.
+type EventSpec struct {
+	Type   EventType              `json:"type"`             // Type of supported ad tracking event
+	Method []EventTrackingMethod  `json:"method,omitempty"` // Supported tracking methods
+	API    []openrtb.APIFramework `json:"api,omitempty"`    // Supported API frameworks for JavaScript tracking
+	JSTrk  []string               `json:"jstrk,omitempty"`  // Supported domains for JavaScript trackers
+	WJS    int                    `json:"wjs,omitempty"`    // Indicates if the jstrk list is a whitelist (1) or blacklist (0)
+	PxTrk  []string               `json:"pxtrk,omitempty"`  // Supported domains for pixel trackers
+	WPx    int                    `json:"wpx,omitempty"`    // Indicates if the pxtrk list is a whitelist (1) or blacklist (0)
+	Ext    openrtb.Extension      `json:"ext,omitempty"`
+}
+
+func (e *EventSpec) Reset() {
+	e.Type = 0
+	if e.Method != nil {
+		e.Method = e.Method[:0]
+	}
+	if e.API != nil {
+		e.API = e.API[:0]
+	}
+	if e.JSTrk != nil {
+		e.JSTrk = e.JSTrk[:0]
+	}
+	e.WJS = 0
+	if e.PxTrk != nil {
+		e.PxTrk = e.PxTrk[:0]
+	}
+	e.WPx = 0
+	e.Ext = nil
+}
+
+// This object signals that the placement may be a video placement.
+type VideoPlacement struct {
+	PType      openrtb.VideoPlacement    `json:"ptype,omitempty"`      // Placement subtype
+	Pos        openrtb.AdPosition        `json:"pos,omitempty"`        // Placement position on the screen
+	Delay      int                       `json:"delay,omitempty"`      // Start delay in seconds for pre-roll, mid-roll, or post-roll placements
+	Skip       int                       `json:"skip,omitempty"`       // Indicates if the placement imposes ad skippability, where 0 = no, 1 = yes
+	SkipMin    int                       `json:"skipmin,omitempty"`    // Only ads with a duration greater than this are skippable
+	SkipAfter  int                       `json:"skipafter,omitempty"`  // Number of seconds before skipping is enabled
+	PlayMethod []openrtb.PlaybackMethod  `json:"playmethod,omitempty"` // Playback methods that may be in use
+	PlayEnd    PlaybackCessationMode     `json:"playend,omitempty"`    // The event that causes playback to end
+	ClkType    ClickType                 `json:"clktype,omitempty"`    // Placement clickability and type of browser
+	MIME       []string                  `json:"mime,omitempty"`       // Supported MIME types
+	API        []openrtb.APIFramework    `json:"api,omitempty"`        // Supported API frameworks
+	CType      []openrtb.Protocol        `json:"ctype,omitempty"`      // Supported creative subtypes
+	W          int                       `json:"w,omitempty"`          // Width of the placement in units specified by unit
+	H          int                       `json:"h,omitempty"`          // Height of the placement in units specified by unit
+	Unit       SizeUnit                  `json:"unit,omitempty"`       // Unit of size used for w and h
+	MinDur     int                       `json:"mindur,omitempty"`     // Minimum creative duration in seconds
+	MaxDur     int                       `json:"maxdur,omitempty"`     // Maximum creative duration in seconds
+	MaxExt     int                       `json:"maxext,omitempty"`     // Maximum extended duration if extension is allowed
+	MinBitR    int                       `json:"minbitr,omitempty"`    // Minimum bit rate in Kbps
+	MaxBitR    int                       `json:"maxbitr,omitempty"`    // Maximum bit rate in Kbps
+	Delivery   []openrtb.ContentDelivery `json:"delivery,omitempty"`   // Supported delivery methods
+	MaxSeq     int                       `json:"maxseq,omitempty"`     // Maximum number of ads that may be served in a dynamic pod
+	Linear     openrtb.VideoLinearity    `json:"linear,omitempty"`     // Indicates if the creative must be linear, non-linear, etc
+	Boxing     *int                      `json:"boxing,omitempty"`     // Indicates if letter-boxing of 4:3 creatives into a 16:9 window is allowed
+	Comp       []Companion               `json:"comp,omitempty"`       // Companion ads that are available
+	CompType   []openrtb.CompanionType   `json:"comptype,omitempty"`   // Supported companion ad types
+	Ext        openrtb.Extension         `json:"ext,omitempty"`
+}
+
+func (v *VideoPlacement) Reset() {
+	v.PType = 0
+	v.Pos = 0
+	v.Delay = 0
+	v.Skip = 0
+	v.SkipMin = 0
+	v.SkipAfter = 0
+	if v.PlayMethod != nil {
+		v.PlayMethod = v.PlayMethod[:0]
+	}
+	v.PlayEnd = 0
+	v.ClkType = 0
+	if v.MIME != nil {
+		v.MIME = v.MIME[:0]
+	}
+	if v.API != nil {
+		v.API = v.API[:0]
+	}
+	if v.CType != nil {
+		v.CType = v.CType[:0]
+	}
+	v.W = 0
+	v.H = 0
+	v.Unit = 0
+	v.MinDur = 0
+	v.MaxDur = 0
+	v.MaxExt = 0
+	v.MinBitR = 0
+	v.MaxBitR = 0
+	if v.Delivery != nil {
+		v.Delivery = v.Delivery[:0]
+	}
+	v.MaxSeq = 0
+	v.Linear = 0
+	v.Boxing = nil
+	if v.Comp != nil {
+		for i := 0; i < len(v.Comp); i++ {
+			(&v.Comp[i]).Reset()
+		}
+		v.Comp = v.Comp[:0]
+	}
+	if v.CompType != nil {
+		v.CompType = v.CompType[:0]
+	}
+	v.Ext = nil
+}
+
+// This object signals that the placement may be an audio placement.
+type AudioPlacement struct {
+	Delay      int                         `json:"delay,omitempty"`      // Start delay in seconds for pre-roll, mid-roll, or post-roll placements
+	Skip       int                         `json:"skip,omitempty"`       // Indicates if the placement imposes ad skippability, where 0 = no, 1 = yes
+	SkipMin    int                         `json:"skipmin,omitempty"`    // Only ads with a duration greater than this are skippable
+	SkipAfter  int                         `json:"skipafter,omitempty"`  // Number of seconds before skipping is enabled
+	PlayMethod []openrtb.PlaybackMethod    `json:"playmethod,omitempty"` // Playback methods that may be in use
+	PlayEnd    PlaybackCessationMode       `json:"playend,omitempty"`    // The event that causes playback to end
+	Feed       openrtb.FeedType            `json:"feed,omitempty"`       // Type of audio feed
+	NVol       openrtb.VolumeNormalization `json:"nvol,omitempty"`       // Volume normalization mode
+	MIME       []string                    `json:"mime,omitempty"`       // Supported MIME types
+	API        []openrtb.APIFramework      `json:"api,omitempty"`        // Supported API frameworks
+	CType      []openrtb.Protocol          `json:"ctype,omitempty"`      // Supported creative subtypes
+	MinDur     int                         `json:"mindur,omitempty"`     // Minimum creative duration in seconds
+	MaxDur     int                         `json:"maxdur,omitempty"`     // Maximum creative duration in seconds
+	MaxExt     int                         `json:"maxext,omitempty"`     // Maximum extended duration if extension is allowed
+	MinBitR    int                         `json:"minbitr,omitempty"`    // Minimum bit rate in Kbps
+	MaxBitR    int                         `json:"maxbitr,omitempty"`    // Maximum bit rate in Kbps
+	Delivery   []openrtb.ContentDelivery   `json:"delivery,omitempty"`   // Supported delivery methods
+	MaxSeq     int                         `json:"maxseq,omitempty"`     // Maximum number of ads that may be served in a dynamic pod
+	Comp       []Companion                 `json:"comp,omitempty"`       // Companion ads that are available
+	CompType   []openrtb.CompanionType     `json:"comptype,omitempty"`   // Supported companion ad types
+	Ext        openrtb.Extension           `json:"ext,omitempty"`
+}
+
+func (a *AudioPlacement) Reset() {
+	a.Delay = 0
+	a.Skip = 0
+	a.SkipMin = 0
+	a.SkipAfter = 0
+	if a.PlayMethod != nil {
+		a.PlayMethod = a.PlayMethod[:0]
+	}
+	a.PlayEnd = 0
+	a.Feed = 0
+	a.NVol = 0
+	if a.MIME != nil {
+		a.MIME = a.MIME[:0]
+	}
+	if a.API != nil {
+		a.API = a.API[:0]
+	}
+	if a.CType != nil {
+		a.CType = a.CType[:0]
+	}
+	a.MinDur = 0
+	a.MaxDur = 0
+	a.MaxExt = 0
+	a.MinBitR = 0
+	a.MaxBitR = 0
+	if a.Delivery != nil {
+		a.Delivery = a.Delivery[:0]
+	}
+	a.MaxSeq = 0
+	if a.Comp != nil {
+		for i := 0; i < len(a.Comp); i++ {
+			(&a.Comp[i]).Reset()
+		}
+		a.Comp = a.Comp[:0]
+	}
+	if a.CompType != nil {
+		a.CompType = a.CompType[:0]
+	}
+	a.Ext = nil
+}
+
+// This object is used in video and audio placements to specify an associated
+// display placement for a companion ad.
+type Companion struct {
+	ID      string            `json:"id,omitempty"`      // Identifier of the companion
+	VCM     int               `json:"vcm,omitempty"`     // Indicates the companion is rendered as an end-card, where 0 = concurrent, 1 = end-card
+	Display *DisplayPlacement `json:"display,omitempty"` // Display placement of the companion
+	Ext     openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (c *Companion) Reset() {
+	c.ID = ""
+	c.VCM = 0
+	c.Display = nil
+	c.Ext = nil
+}
@@ -0,0 +1,10175 @@
+// DO NOT EDIT!
+// Code generated by ffjson <https://github.com/pquerna/ffjson>
+// source: placement.go
+// DO NOT EDIT!
+
+package adcom1
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/bsm/openrtb"
+	fflib "github.com/pquerna/ffjson/fflib/v1"
+)
+
+func (mj *AssetFormat) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *AssetFormat) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ "id":`)
+	fflib.FormatBits2(buf, uint64(mj.ID), 10, mj.ID < 0)
+	buf.WriteByte(',')
+	if mj.Req != 0 {
+		buf.WriteString(`"req":`)
+		fflib.FormatBits2(buf, uint64(mj.Req), 10, mj.Req < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Title != nil {
+		if true {
+			buf.WriteString(`"title":`)
+
+			{
+
+				err = mj.Title.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if mj.Img != nil {
+		if true {
+			buf.WriteString(`"img":`)
+
+			{
+
+				err = mj.Img.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if mj.Video != nil {
+		if true {
+			buf.WriteString(`"video":`)
+
+			{
+
+				err = mj.Video.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if mj.Data != nil {
+		if true {
+			buf.WriteString(`"data":`)
+
+			{
+
+				err = mj.Data.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_AssetFormatbase = iota
+	ffj_t_AssetFormatno_such_key
+
+	ffj_t_AssetFormat_ID
+
+	ffj_t_AssetFormat_Req
+
+	ffj_t_AssetFormat_Title
+
+	ffj_t_AssetFormat_Img
+
+	ffj_t_AssetFormat_Video
+
+	ffj_t_AssetFormat_Data
+
+	ffj_t_AssetFormat_Ext
+)
+
+var ffj_key_AssetFormat_ID = []byte("id")
+
+var ffj_key_AssetFormat_Req = []byte("req")
+
+var ffj_key_AssetFormat_Title = []byte("title")
+
+var ffj_key_AssetFormat_Img = []byte("img")
+
+var ffj_key_AssetFormat_Video = []byte("video")
+
+var ffj_key_AssetFormat_Data = []byte("data")
+
+var ffj_key_AssetFormat_Ext = []byte("ext")
+
+func (uj *AssetFormat) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *AssetFormat) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_AssetFormatbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_AssetFormatno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_AssetFormat_Data, kn) {
+						currentKey = ffj_t_AssetFormat_Data
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_AssetFormat_Ext, kn) {
+						currentKey = ffj_t_AssetFormat_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_AssetFormat_ID, kn) {
+						currentKey = ffj_t_AssetFormat_ID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AssetFormat_Img, kn) {
+						currentKey = ffj_t_AssetFormat_Img
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'r':
+
+					if bytes.Equal(ffj_key_AssetFormat_Req, kn) {
+						currentKey = ffj_t_AssetFormat_Req
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffj_key_AssetFormat_Title, kn) {
+						currentKey = ffj_t_AssetFormat_Title
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'v':
+
+					if bytes.Equal(ffj_key_AssetFormat_Video, kn) {
+						currentKey = ffj_t_AssetFormat_Video
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AssetFormat_Ext, kn) {
+					currentKey = ffj_t_AssetFormat_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AssetFormat_Data, kn) {
+					currentKey = ffj_t_AssetFormat_Data
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AssetFormat_Video, kn) {
+					currentKey = ffj_t_AssetFormat_Video
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AssetFormat_Img, kn) {
+					currentKey = ffj_t_AssetFormat_Img
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AssetFormat_Title, kn) {
+					currentKey = ffj_t_AssetFormat_Title
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AssetFormat_Req, kn) {
+					currentKey = ffj_t_AssetFormat_Req
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AssetFormat_ID, kn) {
+					currentKey = ffj_t_AssetFormat_ID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_AssetFormatno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_AssetFormat_ID:
+					goto handle_ID
+
+				case ffj_t_AssetFormat_Req:
+					goto handle_Req
+
+				case ffj_t_AssetFormat_Title:
+					goto handle_Title
+
+				case ffj_t_AssetFormat_Img:
+					goto handle_Img
+
+				case ffj_t_AssetFormat_Video:
+					goto handle_Video
+
+				case ffj_t_AssetFormat_Data:
+					goto handle_Data
+
+				case ffj_t_AssetFormat_Ext:
+					goto handle_Ext
+
+				case ffj_t_AssetFormatno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_ID:
+
+	/* handler: uj.ID type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.ID = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Req:
+
+	/* handler: uj.Req type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Req = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Title:
+
+	/* handler: uj.Title type=adcom1.TitleAssetFormat kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Title = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Title == nil {
+			uj.Title = new(TitleAssetFormat)
+		}
+
+		err = uj.Title.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Img:
+
+	/* handler: uj.Img type=adcom1.ImageAssetFormat kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Img = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Img == nil {
+			uj.Img = new(ImageAssetFormat)
+		}
+
+		err = uj.Img.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Video:
+
+	/* handler: uj.Video type=adcom1.VideoPlacement kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Video = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Video == nil {
+			uj.Video = new(VideoPlacement)
+		}
+
+		err = uj.Video.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Data:
+
+	/* handler: uj.Data type=adcom1.DataAssetFormat kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Data = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Data == nil {
+			uj.Data = new(DataAssetFormat)
+		}
+
+		err = uj.Data.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *AudioPlacement) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *AudioPlacement) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if mj.Delay != 0 {
+		buf.WriteString(`"delay":`)
+		fflib.FormatBits2(buf, uint64(mj.Delay), 10, mj.Delay < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Skip != 0 {
+		buf.WriteString(`"skip":`)
+		fflib.FormatBits2(buf, uint64(mj.Skip), 10, mj.Skip < 0)
+		buf.WriteByte(',')
+	}
+	if mj.SkipMin != 0 {
+		buf.WriteString(`"skipmin":`)
+		fflib.FormatBits2(buf, uint64(mj.SkipMin), 10, mj.SkipMin < 0)
+		buf.WriteByte(',')
+	}
+	if mj.SkipAfter != 0 {
+		buf.WriteString(`"skipafter":`)
+		fflib.FormatBits2(buf, uint64(mj.SkipAfter), 10, mj.SkipAfter < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.PlayMethod) != 0 {
+		buf.WriteString(`"playmethod":`)
+		if mj.PlayMethod != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.PlayMethod {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.PlayEnd != 0 {
+		buf.WriteString(`"playend":`)
+		fflib.FormatBits2(buf, uint64(mj.PlayEnd), 10, mj.PlayEnd < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Feed != 0 {
+		buf.WriteString(`"feed":`)
+		fflib.FormatBits2(buf, uint64(mj.Feed), 10, mj.Feed < 0)
+		buf.WriteByte(',')
+	}
+	if mj.NVol != 0 {
+		buf.WriteString(`"nvol":`)
+		fflib.FormatBits2(buf, uint64(mj.NVol), 10, mj.NVol < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.MIME) != 0 {
+		buf.WriteString(`"mime":`)
+		if mj.MIME != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.MIME {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.API) != 0 {
+		buf.WriteString(`"api":`)
+		if mj.API != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.API {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.CType) != 0 {
+		buf.WriteString(`"ctype":`)
+		if mj.CType != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.CType {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.MinDur != 0 {
+		buf.WriteString(`"mindur":`)
+		fflib.FormatBits2(buf, uint64(mj.MinDur), 10, mj.MinDur < 0)
+		buf.WriteByte(',')
+	}
+	if mj.MaxDur != 0 {
+		buf.WriteString(`"maxdur":`)
+		fflib.FormatBits2(buf, uint64(mj.MaxDur), 10, mj.MaxDur < 0)
+		buf.WriteByte(',')
+	}
+	if mj.MaxExt != 0 {
+		buf.WriteString(`"maxext":`)
+		fflib.FormatBits2(buf, uint64(mj.MaxExt), 10, mj.MaxExt < 0)
+		buf.WriteByte(',')
+	}
+	if mj.MinBitR != 0 {
+		buf.WriteString(`"minbitr":`)
+		fflib.FormatBits2(buf, uint64(mj.MinBitR), 10, mj.MinBitR < 0)
+		buf.WriteByte(',')
+	}
+	if mj.MaxBitR != 0 {
+		buf.WriteString(`"maxbitr":`)
+		fflib.FormatBits2(buf, uint64(mj.MaxBitR), 10, mj.MaxBitR < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Delivery) != 0 {
+		buf.WriteString(`"delivery":`)
+		if mj.Delivery != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Delivery {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.MaxSeq != 0 {
+		buf.WriteString(`"maxseq":`)
+		fflib.FormatBits2(buf, uint64(mj.MaxSeq), 10, mj.MaxSeq < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Comp) != 0 {
+		buf.WriteString(`"comp":`)
+		if mj.Comp != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Comp {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					err = v.MarshalJSONBuf(buf)
+					if err != nil {
+						return err
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.CompType) != 0 {
+		buf.WriteString(`"comptype":`)
+		if mj.CompType != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.CompType {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_AudioPlacementbase = iota
+	ffj_t_AudioPlacementno_such_key
+
+	ffj_t_AudioPlacement_Delay
+
+	ffj_t_AudioPlacement_Skip
+
+	ffj_t_AudioPlacement_SkipMin
+
+	ffj_t_AudioPlacement_SkipAfter
+
+	ffj_t_AudioPlacement_PlayMethod
+
+	ffj_t_AudioPlacement_PlayEnd
+
+	ffj_t_AudioPlacement_Feed
+
+	ffj_t_AudioPlacement_NVol
+
+	ffj_t_AudioPlacement_MIME
+
+	ffj_t_AudioPlacement_API
+
+	ffj_t_AudioPlacement_CType
+
+	ffj_t_AudioPlacement_MinDur
+
+	ffj_t_AudioPlacement_MaxDur
+
+	ffj_t_AudioPlacement_MaxExt
+
+	ffj_t_AudioPlacement_MinBitR
+
+	ffj_t_AudioPlacement_MaxBitR
+
+	ffj_t_AudioPlacement_Delivery
+
+	ffj_t_AudioPlacement_MaxSeq
+
+	ffj_t_AudioPlacement_Comp
+
+	ffj_t_AudioPlacement_CompType
+
+	ffj_t_AudioPlacement_Ext
+)
+
+var ffj_key_AudioPlacement_Delay = []byte("delay")
+
+var ffj_key_AudioPlacement_Skip = []byte("skip")
+
+var ffj_key_AudioPlacement_SkipMin = []byte("skipmin")
+
+var ffj_key_AudioPlacement_SkipAfter = []byte("skipafter")
+
+var ffj_key_AudioPlacement_PlayMethod = []byte("playmethod")
+
+var ffj_key_AudioPlacement_PlayEnd = []byte("playend")
+
+var ffj_key_AudioPlacement_Feed = []byte("feed")
+
+var ffj_key_AudioPlacement_NVol = []byte("nvol")
+
+var ffj_key_AudioPlacement_MIME = []byte("mime")
+
+var ffj_key_AudioPlacement_API = []byte("api")
+
+var ffj_key_AudioPlacement_CType = []byte("ctype")
+
+var ffj_key_AudioPlacement_MinDur = []byte("mindur")
+
+var ffj_key_AudioPlacement_MaxDur = []byte("maxdur")
+
+var ffj_key_AudioPlacement_MaxExt = []byte("maxext")
+
+var ffj_key_AudioPlacement_MinBitR = []byte("minbitr")
+
+var ffj_key_AudioPlacement_MaxBitR = []byte("maxbitr")
+
+var ffj_key_AudioPlacement_Delivery = []byte("delivery")
+
+var ffj_key_AudioPlacement_MaxSeq = []byte("maxseq")
+
+var ffj_key_AudioPlacement_Comp = []byte("comp")
+
+var ffj_key_AudioPlacement_CompType = []byte("comptype")
+
+var ffj_key_AudioPlacement_Ext = []byte("ext")
+
+func (uj *AudioPlacement) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *AudioPlacement) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_AudioPlacementbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_AudioPlacementno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffj_key_AudioPlacement_API, kn) {
+						currentKey = ffj_t_AudioPlacement_API
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_AudioPlacement_CType, kn) {
+						currentKey = ffj_t_AudioPlacement_CType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AudioPlacement_Comp, kn) {
+						currentKey = ffj_t_AudioPlacement_Comp
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AudioPlacement_CompType, kn) {
+						currentKey = ffj_t_AudioPlacement_CompType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_AudioPlacement_Delay, kn) {
+						currentKey = ffj_t_AudioPlacement_Delay
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AudioPlacement_Delivery, kn) {
+						currentKey = ffj_t_AudioPlacement_Delivery
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_AudioPlacement_Ext, kn) {
+						currentKey = ffj_t_AudioPlacement_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'f':
+
+					if bytes.Equal(ffj_key_AudioPlacement_Feed, kn) {
+						currentKey = ffj_t_AudioPlacement_Feed
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffj_key_AudioPlacement_MIME, kn) {
+						currentKey = ffj_t_AudioPlacement_MIME
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AudioPlacement_MinDur, kn) {
+						currentKey = ffj_t_AudioPlacement_MinDur
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AudioPlacement_MaxDur, kn) {
+						currentKey = ffj_t_AudioPlacement_MaxDur
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AudioPlacement_MaxExt, kn) {
+						currentKey = ffj_t_AudioPlacement_MaxExt
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AudioPlacement_MinBitR, kn) {
+						currentKey = ffj_t_AudioPlacement_MinBitR
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AudioPlacement_MaxBitR, kn) {
+						currentKey = ffj_t_AudioPlacement_MaxBitR
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AudioPlacement_MaxSeq, kn) {
+						currentKey = ffj_t_AudioPlacement_MaxSeq
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffj_key_AudioPlacement_NVol, kn) {
+						currentKey = ffj_t_AudioPlacement_NVol
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffj_key_AudioPlacement_PlayMethod, kn) {
+						currentKey = ffj_t_AudioPlacement_PlayMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AudioPlacement_PlayEnd, kn) {
+						currentKey = ffj_t_AudioPlacement_PlayEnd
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffj_key_AudioPlacement_Skip, kn) {
+						currentKey = ffj_t_AudioPlacement_Skip
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AudioPlacement_SkipMin, kn) {
+						currentKey = ffj_t_AudioPlacement_SkipMin
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_AudioPlacement_SkipAfter, kn) {
+						currentKey = ffj_t_AudioPlacement_SkipAfter
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_Ext, kn) {
+					currentKey = ffj_t_AudioPlacement_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_CompType, kn) {
+					currentKey = ffj_t_AudioPlacement_CompType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_Comp, kn) {
+					currentKey = ffj_t_AudioPlacement_Comp
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_AudioPlacement_MaxSeq, kn) {
+					currentKey = ffj_t_AudioPlacement_MaxSeq
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_Delivery, kn) {
+					currentKey = ffj_t_AudioPlacement_Delivery
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_MaxBitR, kn) {
+					currentKey = ffj_t_AudioPlacement_MaxBitR
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_MinBitR, kn) {
+					currentKey = ffj_t_AudioPlacement_MinBitR
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_MaxExt, kn) {
+					currentKey = ffj_t_AudioPlacement_MaxExt
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_MaxDur, kn) {
+					currentKey = ffj_t_AudioPlacement_MaxDur
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_MinDur, kn) {
+					currentKey = ffj_t_AudioPlacement_MinDur
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_CType, kn) {
+					currentKey = ffj_t_AudioPlacement_CType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_API, kn) {
+					currentKey = ffj_t_AudioPlacement_API
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_MIME, kn) {
+					currentKey = ffj_t_AudioPlacement_MIME
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_NVol, kn) {
+					currentKey = ffj_t_AudioPlacement_NVol
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_Feed, kn) {
+					currentKey = ffj_t_AudioPlacement_Feed
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_PlayEnd, kn) {
+					currentKey = ffj_t_AudioPlacement_PlayEnd
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_PlayMethod, kn) {
+					currentKey = ffj_t_AudioPlacement_PlayMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_AudioPlacement_SkipAfter, kn) {
+					currentKey = ffj_t_AudioPlacement_SkipAfter
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_AudioPlacement_SkipMin, kn) {
+					currentKey = ffj_t_AudioPlacement_SkipMin
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_AudioPlacement_Skip, kn) {
+					currentKey = ffj_t_AudioPlacement_Skip
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_AudioPlacement_Delay, kn) {
+					currentKey = ffj_t_AudioPlacement_Delay
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_AudioPlacementno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_AudioPlacement_Delay:
+					goto handle_Delay
+
+				case ffj_t_AudioPlacement_Skip:
+					goto handle_Skip
+
+				case ffj_t_AudioPlacement_SkipMin:
+					goto handle_SkipMin
+
+				case ffj_t_AudioPlacement_SkipAfter:
+					goto handle_SkipAfter
+
+				case ffj_t_AudioPlacement_PlayMethod:
+					goto handle_PlayMethod
+
+				case ffj_t_AudioPlacement_PlayEnd:
+					goto handle_PlayEnd
+
+				case ffj_t_AudioPlacement_Feed:
+					goto handle_Feed
+
+				case ffj_t_AudioPlacement_NVol:
+					goto handle_NVol
+
+				case ffj_t_AudioPlacement_MIME:
+					goto handle_MIME
+
+				case ffj_t_AudioPlacement_API:
+					goto handle_API
+
+				case ffj_t_AudioPlacement_CType:
+					goto handle_CType
+
+				case ffj_t_AudioPlacement_MinDur:
+					goto handle_MinDur
+
+				case ffj_t_AudioPlacement_MaxDur:
+					goto handle_MaxDur
+
+				case ffj_t_AudioPlacement_MaxExt:
+					goto handle_MaxExt
+
+				case ffj_t_AudioPlacement_MinBitR:
+					goto handle_MinBitR
+
+				case ffj_t_AudioPlacement_MaxBitR:
+					goto handle_MaxBitR
+
+				case ffj_t_AudioPlacement_Delivery:
+					goto handle_Delivery
+
+				case ffj_t_AudioPlacement_MaxSeq:
+					goto handle_MaxSeq
+
+				case ffj_t_AudioPlacement_Comp:
+					goto handle_Comp
+
+				case ffj_t_AudioPlacement_CompType:
+					goto handle_CompType
+
+				case ffj_t_AudioPlacement_Ext:
+					goto handle_Ext
+
+				case ffj_t_AudioPlacementno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Delay:
+
+	/* handler: uj.Delay type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Delay = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Skip:
+
+	/* handler: uj.Skip type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Skip = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SkipMin:
+
+	/* handler: uj.SkipMin type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.SkipMin = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SkipAfter:
+
+	/* handler: uj.SkipAfter type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.SkipAfter = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PlayMethod:
+
+	/* handler: uj.PlayMethod type=[]openrtb.PlaybackMethod kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.PlayMethod = nil
+		} else {
+
+			uj.PlayMethod = []openrtb.PlaybackMethod{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__PlayMethod openrtb.PlaybackMethod
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__PlayMethod type=openrtb.PlaybackMethod kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for PlaybackMethod", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__PlayMethod = openrtb.PlaybackMethod(tval)
+
+					}
+				}
+
+				uj.PlayMethod = append(uj.PlayMethod, tmp_uj__PlayMethod)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PlayEnd:
+
+	/* handler: uj.PlayEnd type=adcom1.PlaybackCessationMode kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for PlaybackCessationMode", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.PlayEnd = PlaybackCessationMode(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Feed:
+
+	/* handler: uj.Feed type=openrtb.FeedType kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for FeedType", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Feed = openrtb.FeedType(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_NVol:
+
+	/* handler: uj.NVol type=openrtb.VolumeNormalization kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for VolumeNormalization", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.NVol = openrtb.VolumeNormalization(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MIME:
+
+	/* handler: uj.MIME type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.MIME = nil
+		} else {
+
+			uj.MIME = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__MIME string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__MIME type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__MIME = string(string(outBuf))
+
+					}
+				}
+
+				uj.MIME = append(uj.MIME, tmp_uj__MIME)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_API:
+
+	/* handler: uj.API type=[]openrtb.APIFramework kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.API = nil
+		} else {
+
+			uj.API = []openrtb.APIFramework{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__API openrtb.APIFramework
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__API type=openrtb.APIFramework kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for APIFramework", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__API = openrtb.APIFramework(tval)
+
+					}
+				}
+
+				uj.API = append(uj.API, tmp_uj__API)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CType:
+
+	/* handler: uj.CType type=[]openrtb.Protocol kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.CType = nil
+		} else {
+
+			uj.CType = []openrtb.Protocol{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__CType openrtb.Protocol
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__CType type=openrtb.Protocol kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for Protocol", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__CType = openrtb.Protocol(tval)
+
+					}
+				}
+
+				uj.CType = append(uj.CType, tmp_uj__CType)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MinDur:
+
+	/* handler: uj.MinDur type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MinDur = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MaxDur:
+
+	/* handler: uj.MaxDur type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MaxDur = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MaxExt:
+
+	/* handler: uj.MaxExt type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MaxExt = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MinBitR:
+
+	/* handler: uj.MinBitR type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MinBitR = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MaxBitR:
+
+	/* handler: uj.MaxBitR type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MaxBitR = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Delivery:
+
+	/* handler: uj.Delivery type=[]openrtb.ContentDelivery kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Delivery = nil
+		} else {
+
+			uj.Delivery = []openrtb.ContentDelivery{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Delivery openrtb.ContentDelivery
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Delivery type=openrtb.ContentDelivery kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ContentDelivery", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__Delivery = openrtb.ContentDelivery(tval)
+
+					}
+				}
+
+				uj.Delivery = append(uj.Delivery, tmp_uj__Delivery)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MaxSeq:
+
+	/* handler: uj.MaxSeq type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MaxSeq = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Comp:
+
+	/* handler: uj.Comp type=[]adcom1.Companion kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Comp = nil
+		} else {
+
+			uj.Comp = []Companion{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Comp Companion
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Comp type=adcom1.Companion kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						state = fflib.FFParse_after_value
+						goto mainparse
+					}
+
+					err = tmp_uj__Comp.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+					if err != nil {
+						return err
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				uj.Comp = append(uj.Comp, tmp_uj__Comp)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CompType:
+
+	/* handler: uj.CompType type=[]openrtb.CompanionType kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.CompType = nil
+		} else {
+
+			uj.CompType = []openrtb.CompanionType{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__CompType openrtb.CompanionType
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__CompType type=openrtb.CompanionType kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for CompanionType", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__CompType = openrtb.CompanionType(tval)
+
+					}
+				}
+
+				uj.CompType = append(uj.CompType, tmp_uj__CompType)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *Companion) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *Companion) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.ID) != 0 {
+		buf.WriteString(`"id":`)
+		fflib.WriteJsonString(buf, string(mj.ID))
+		buf.WriteByte(',')
+	}
+	if mj.VCM != 0 {
+		buf.WriteString(`"vcm":`)
+		fflib.FormatBits2(buf, uint64(mj.VCM), 10, mj.VCM < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Display != nil {
+		if true {
+			buf.WriteString(`"display":`)
+
+			{
+
+				err = mj.Display.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Companionbase = iota
+	ffj_t_Companionno_such_key
+
+	ffj_t_Companion_ID
+
+	ffj_t_Companion_VCM
+
+	ffj_t_Companion_Display
+
+	ffj_t_Companion_Ext
+)
+
+var ffj_key_Companion_ID = []byte("id")
+
+var ffj_key_Companion_VCM = []byte("vcm")
+
+var ffj_key_Companion_Display = []byte("display")
+
+var ffj_key_Companion_Ext = []byte("ext")
+
+func (uj *Companion) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *Companion) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Companionbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Companionno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_Companion_Display, kn) {
+						currentKey = ffj_t_Companion_Display
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_Companion_Ext, kn) {
+						currentKey = ffj_t_Companion_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_Companion_ID, kn) {
+						currentKey = ffj_t_Companion_ID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'v':
+
+					if bytes.Equal(ffj_key_Companion_VCM, kn) {
+						currentKey = ffj_t_Companion_VCM
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Companion_Ext, kn) {
+					currentKey = ffj_t_Companion_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Companion_Display, kn) {
+					currentKey = ffj_t_Companion_Display
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Companion_VCM, kn) {
+					currentKey = ffj_t_Companion_VCM
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Companion_ID, kn) {
+					currentKey = ffj_t_Companion_ID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Companionno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_Companion_ID:
+					goto handle_ID
+
+				case ffj_t_Companion_VCM:
+					goto handle_VCM
+
+				case ffj_t_Companion_Display:
+					goto handle_Display
+
+				case ffj_t_Companion_Ext:
+					goto handle_Ext
+
+				case ffj_t_Companionno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_ID:
+
+	/* handler: uj.ID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.ID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_VCM:
+
+	/* handler: uj.VCM type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.VCM = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Display:
+
+	/* handler: uj.Display type=adcom1.DisplayPlacement kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Display = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Display == nil {
+			uj.Display = new(DisplayPlacement)
+		}
+
+		err = uj.Display.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *DataAssetFormat) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *DataAssetFormat) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ "type":`)
+	fflib.FormatBits2(buf, uint64(mj.Type), 10, mj.Type < 0)
+	buf.WriteByte(',')
+	if mj.Len != 0 {
+		buf.WriteString(`"len":`)
+		fflib.FormatBits2(buf, uint64(mj.Len), 10, mj.Len < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_DataAssetFormatbase = iota
+	ffj_t_DataAssetFormatno_such_key
+
+	ffj_t_DataAssetFormat_Type
+
+	ffj_t_DataAssetFormat_Len
+
+	ffj_t_DataAssetFormat_Ext
+)
+
+var ffj_key_DataAssetFormat_Type = []byte("type")
+
+var ffj_key_DataAssetFormat_Len = []byte("len")
+
+var ffj_key_DataAssetFormat_Ext = []byte("ext")
+
+func (uj *DataAssetFormat) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *DataAssetFormat) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_DataAssetFormatbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_DataAssetFormatno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_DataAssetFormat_Ext, kn) {
+						currentKey = ffj_t_DataAssetFormat_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'l':
+
+					if bytes.Equal(ffj_key_DataAssetFormat_Len, kn) {
+						currentKey = ffj_t_DataAssetFormat_Len
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffj_key_DataAssetFormat_Type, kn) {
+						currentKey = ffj_t_DataAssetFormat_Type
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DataAssetFormat_Ext, kn) {
+					currentKey = ffj_t_DataAssetFormat_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DataAssetFormat_Len, kn) {
+					currentKey = ffj_t_DataAssetFormat_Len
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DataAssetFormat_Type, kn) {
+					currentKey = ffj_t_DataAssetFormat_Type
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_DataAssetFormatno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_DataAssetFormat_Type:
+					goto handle_Type
+
+				case ffj_t_DataAssetFormat_Len:
+					goto handle_Len
+
+				case ffj_t_DataAssetFormat_Ext:
+					goto handle_Ext
+
+				case ffj_t_DataAssetFormatno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Type:
+
+	/* handler: uj.Type type=adcom1.NativeDataAssetType kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for NativeDataAssetType", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Type = NativeDataAssetType(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Len:
+
+	/* handler: uj.Len type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Len = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *DisplayFormat) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *DisplayFormat) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if mj.W != 0 {
+		buf.WriteString(`"w":`)
+		fflib.FormatBits2(buf, uint64(mj.W), 10, mj.W < 0)
+		buf.WriteByte(',')
+	}
+	if mj.H != 0 {
+		buf.WriteString(`"h":`)
+		fflib.FormatBits2(buf, uint64(mj.H), 10, mj.H < 0)
+		buf.WriteByte(',')
+	}
+	if mj.WRatio != 0 {
+		buf.WriteString(`"wratio":`)
+		fflib.FormatBits2(buf, uint64(mj.WRatio), 10, mj.WRatio < 0)
+		buf.WriteByte(',')
+	}
+	if mj.HRatio != 0 {
+		buf.WriteString(`"hratio":`)
+		fflib.FormatBits2(buf, uint64(mj.HRatio), 10, mj.HRatio < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.ExpDir) != 0 {
+		buf.WriteString(`"expdir":`)
+		if mj.ExpDir != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.ExpDir {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_DisplayFormatbase = iota
+	ffj_t_DisplayFormatno_such_key
+
+	ffj_t_DisplayFormat_W
+
+	ffj_t_DisplayFormat_H
+
+	ffj_t_DisplayFormat_WRatio
+
+	ffj_t_DisplayFormat_HRatio
+
+	ffj_t_DisplayFormat_ExpDir
+
+	ffj_t_DisplayFormat_Ext
+)
+
+var ffj_key_DisplayFormat_W = []byte("w")
+
+var ffj_key_DisplayFormat_H = []byte("h")
+
+var ffj_key_DisplayFormat_WRatio = []byte("wratio")
+
+var ffj_key_DisplayFormat_HRatio = []byte("hratio")
+
+var ffj_key_DisplayFormat_ExpDir = []byte("expdir")
+
+var ffj_key_DisplayFormat_Ext = []byte("ext")
+
+func (uj *DisplayFormat) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *DisplayFormat) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_DisplayFormatbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_DisplayFormatno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_DisplayFormat_ExpDir, kn) {
+						currentKey = ffj_t_DisplayFormat_ExpDir
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DisplayFormat_Ext, kn) {
+						currentKey = ffj_t_DisplayFormat_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'h':
+
+					if bytes.Equal(ffj_key_DisplayFormat_H, kn) {
+						currentKey = ffj_t_DisplayFormat_H
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DisplayFormat_HRatio, kn) {
+						currentKey = ffj_t_DisplayFormat_HRatio
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'w':
+
+					if bytes.Equal(ffj_key_DisplayFormat_W, kn) {
+						currentKey = ffj_t_DisplayFormat_W
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DisplayFormat_WRatio, kn) {
+						currentKey = ffj_t_DisplayFormat_WRatio
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayFormat_Ext, kn) {
+					currentKey = ffj_t_DisplayFormat_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayFormat_ExpDir, kn) {
+					currentKey = ffj_t_DisplayFormat_ExpDir
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayFormat_HRatio, kn) {
+					currentKey = ffj_t_DisplayFormat_HRatio
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayFormat_WRatio, kn) {
+					currentKey = ffj_t_DisplayFormat_WRatio
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayFormat_H, kn) {
+					currentKey = ffj_t_DisplayFormat_H
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayFormat_W, kn) {
+					currentKey = ffj_t_DisplayFormat_W
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_DisplayFormatno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_DisplayFormat_W:
+					goto handle_W
+
+				case ffj_t_DisplayFormat_H:
+					goto handle_H
+
+				case ffj_t_DisplayFormat_WRatio:
+					goto handle_WRatio
+
+				case ffj_t_DisplayFormat_HRatio:
+					goto handle_HRatio
+
+				case ffj_t_DisplayFormat_ExpDir:
+					goto handle_ExpDir
+
+				case ffj_t_DisplayFormat_Ext:
+					goto handle_Ext
+
+				case ffj_t_DisplayFormatno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_W:
+
+	/* handler: uj.W type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.W = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_H:
+
+	/* handler: uj.H type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.H = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_WRatio:
+
+	/* handler: uj.WRatio type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.WRatio = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_HRatio:
+
+	/* handler: uj.HRatio type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.HRatio = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ExpDir:
+
+	/* handler: uj.ExpDir type=[]openrtb.ExpandableDirection kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.ExpDir = nil
+		} else {
+
+			uj.ExpDir = []openrtb.ExpandableDirection{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__ExpDir openrtb.ExpandableDirection
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__ExpDir type=openrtb.ExpandableDirection kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ExpandableDirection", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__ExpDir = openrtb.ExpandableDirection(tval)
+
+					}
+				}
+
+				uj.ExpDir = append(uj.ExpDir, tmp_uj__ExpDir)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *DisplayPlacement) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *DisplayPlacement) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if mj.Pos != 0 {
+		buf.WriteString(`"pos":`)
+		fflib.FormatBits2(buf, uint64(mj.Pos), 10, mj.Pos < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Instl != 0 {
+		buf.WriteString(`"instl":`)
+		fflib.FormatBits2(buf, uint64(mj.Instl), 10, mj.Instl < 0)
+		buf.WriteByte(',')
+	}
+	if mj.TopFrame != 0 {
+		buf.WriteString(`"topframe":`)
+		fflib.FormatBits2(buf, uint64(mj.TopFrame), 10, mj.TopFrame < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.IfrBust) != 0 {
+		buf.WriteString(`"ifrbust":`)
+		if mj.IfrBust != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.IfrBust {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.ClkType != 0 {
+		buf.WriteString(`"clktype":`)
+		fflib.FormatBits2(buf, uint64(mj.ClkType), 10, mj.ClkType < 0)
+		buf.WriteByte(',')
+	}
+	if mj.AmpRen != 0 {
+		buf.WriteString(`"ampren":`)
+		fflib.FormatBits2(buf, uint64(mj.AmpRen), 10, mj.AmpRen < 0)
+		buf.WriteByte(',')
+	}
+	if mj.PType != 0 {
+		buf.WriteString(`"ptype":`)
+		fflib.FormatBits2(buf, uint64(mj.PType), 10, mj.PType < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Context != 0 {
+		buf.WriteString(`"context":`)
+		fflib.FormatBits2(buf, uint64(mj.Context), 10, mj.Context < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.MIME) != 0 {
+		buf.WriteString(`"mime":`)
+		if mj.MIME != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.MIME {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.API) != 0 {
+		buf.WriteString(`"api":`)
+		if mj.API != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.API {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.CType) != 0 {
+		buf.WriteString(`"ctype":`)
+		if mj.CType != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.CType {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.W != 0 {
+		buf.WriteString(`"w":`)
+		fflib.FormatBits2(buf, uint64(mj.W), 10, mj.W < 0)
+		buf.WriteByte(',')
+	}
+	if mj.H != 0 {
+		buf.WriteString(`"h":`)
+		fflib.FormatBits2(buf, uint64(mj.H), 10, mj.H < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Unit != 0 {
+		buf.WriteString(`"unit":`)
+		fflib.FormatBits2(buf, uint64(mj.Unit), 10, mj.Unit < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Priv != 0 {
+		buf.WriteString(`"priv":`)
+		fflib.FormatBits2(buf, uint64(mj.Priv), 10, mj.Priv < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.DisplayFmt) != 0 {
+		buf.WriteString(`"displayfmt":`)
+		if mj.DisplayFmt != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.DisplayFmt {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					err = v.MarshalJSONBuf(buf)
+					if err != nil {
+						return err
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.NativeFmt != nil {
+		if true {
+			buf.WriteString(`"nativefmt":`)
+
+			{
+
+				err = mj.NativeFmt.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Event) != 0 {
+		buf.WriteString(`"event":`)
+		if mj.Event != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Event {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					err = v.MarshalJSONBuf(buf)
+					if err != nil {
+						return err
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_DisplayPlacementbase = iota
+	ffj_t_DisplayPlacementno_such_key
+
+	ffj_t_DisplayPlacement_Pos
+
+	ffj_t_DisplayPlacement_Instl
+
+	ffj_t_DisplayPlacement_TopFrame
+
+	ffj_t_DisplayPlacement_IfrBust
+
+	ffj_t_DisplayPlacement_ClkType
+
+	ffj_t_DisplayPlacement_AmpRen
+
+	ffj_t_DisplayPlacement_PType
+
+	ffj_t_DisplayPlacement_Context
+
+	ffj_t_DisplayPlacement_MIME
+
+	ffj_t_DisplayPlacement_API
+
+	ffj_t_DisplayPlacement_CType
+
+	ffj_t_DisplayPlacement_W
+
+	ffj_t_DisplayPlacement_H
+
+	ffj_t_DisplayPlacement_Unit
+
+	ffj_t_DisplayPlacement_Priv
+
+	ffj_t_DisplayPlacement_DisplayFmt
+
+	ffj_t_DisplayPlacement_NativeFmt
+
+	ffj_t_DisplayPlacement_Event
+
+	ffj_t_DisplayPlacement_Ext
+)
+
+var ffj_key_DisplayPlacement_Pos = []byte("pos")
+
+var ffj_key_DisplayPlacement_Instl = []byte("instl")
+
+var ffj_key_DisplayPlacement_TopFrame = []byte("topframe")
+
+var ffj_key_DisplayPlacement_IfrBust = []byte("ifrbust")
+
+var ffj_key_DisplayPlacement_ClkType = []byte("clktype")
+
+var ffj_key_DisplayPlacement_AmpRen = []byte("ampren")
+
+var ffj_key_DisplayPlacement_PType = []byte("ptype")
+
+var ffj_key_DisplayPlacement_Context = []byte("context")
+
+var ffj_key_DisplayPlacement_MIME = []byte("mime")
+
+var ffj_key_DisplayPlacement_API = []byte("api")
+
+var ffj_key_DisplayPlacement_CType = []byte("ctype")
+
+var ffj_key_DisplayPlacement_W = []byte("w")
+
+var ffj_key_DisplayPlacement_H = []byte("h")
+
+var ffj_key_DisplayPlacement_Unit = []byte("unit")
+
+var ffj_key_DisplayPlacement_Priv = []byte("priv")
+
+var ffj_key_DisplayPlacement_DisplayFmt = []byte("displayfmt")
+
+var ffj_key_DisplayPlacement_NativeFmt = []byte("nativefmt")
+
+var ffj_key_DisplayPlacement_Event = []byte("event")
+
+var ffj_key_DisplayPlacement_Ext = []byte("ext")
+
+func (uj *DisplayPlacement) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *DisplayPlacement) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_DisplayPlacementbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_DisplayPlacementno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffj_key_DisplayPlacement_AmpRen, kn) {
+						currentKey = ffj_t_DisplayPlacement_AmpRen
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DisplayPlacement_API, kn) {
+						currentKey = ffj_t_DisplayPlacement_API
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_DisplayPlacement_ClkType, kn) {
+						currentKey = ffj_t_DisplayPlacement_ClkType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DisplayPlacement_Context, kn) {
+						currentKey = ffj_t_DisplayPlacement_Context
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DisplayPlacement_CType, kn) {
+						currentKey = ffj_t_DisplayPlacement_CType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_DisplayPlacement_DisplayFmt, kn) {
+						currentKey = ffj_t_DisplayPlacement_DisplayFmt
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_DisplayPlacement_Event, kn) {
+						currentKey = ffj_t_DisplayPlacement_Event
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DisplayPlacement_Ext, kn) {
+						currentKey = ffj_t_DisplayPlacement_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'h':
+
+					if bytes.Equal(ffj_key_DisplayPlacement_H, kn) {
+						currentKey = ffj_t_DisplayPlacement_H
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffj_key_DisplayPlacement_Instl, kn) {
+						currentKey = ffj_t_DisplayPlacement_Instl
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DisplayPlacement_IfrBust, kn) {
+						currentKey = ffj_t_DisplayPlacement_IfrBust
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffj_key_DisplayPlacement_MIME, kn) {
+						currentKey = ffj_t_DisplayPlacement_MIME
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffj_key_DisplayPlacement_NativeFmt, kn) {
+						currentKey = ffj_t_DisplayPlacement_NativeFmt
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffj_key_DisplayPlacement_Pos, kn) {
+						currentKey = ffj_t_DisplayPlacement_Pos
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DisplayPlacement_PType, kn) {
+						currentKey = ffj_t_DisplayPlacement_PType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_DisplayPlacement_Priv, kn) {
+						currentKey = ffj_t_DisplayPlacement_Priv
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffj_key_DisplayPlacement_TopFrame, kn) {
+						currentKey = ffj_t_DisplayPlacement_TopFrame
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'u':
+
+					if bytes.Equal(ffj_key_DisplayPlacement_Unit, kn) {
+						currentKey = ffj_t_DisplayPlacement_Unit
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'w':
+
+					if bytes.Equal(ffj_key_DisplayPlacement_W, kn) {
+						currentKey = ffj_t_DisplayPlacement_W
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_Ext, kn) {
+					currentKey = ffj_t_DisplayPlacement_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_Event, kn) {
+					currentKey = ffj_t_DisplayPlacement_Event
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_NativeFmt, kn) {
+					currentKey = ffj_t_DisplayPlacement_NativeFmt
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_DisplayPlacement_DisplayFmt, kn) {
+					currentKey = ffj_t_DisplayPlacement_DisplayFmt
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_Priv, kn) {
+					currentKey = ffj_t_DisplayPlacement_Priv
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_Unit, kn) {
+					currentKey = ffj_t_DisplayPlacement_Unit
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_H, kn) {
+					currentKey = ffj_t_DisplayPlacement_H
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_W, kn) {
+					currentKey = ffj_t_DisplayPlacement_W
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_CType, kn) {
+					currentKey = ffj_t_DisplayPlacement_CType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_API, kn) {
+					currentKey = ffj_t_DisplayPlacement_API
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_MIME, kn) {
+					currentKey = ffj_t_DisplayPlacement_MIME
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_Context, kn) {
+					currentKey = ffj_t_DisplayPlacement_Context
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_PType, kn) {
+					currentKey = ffj_t_DisplayPlacement_PType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_AmpRen, kn) {
+					currentKey = ffj_t_DisplayPlacement_AmpRen
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_DisplayPlacement_ClkType, kn) {
+					currentKey = ffj_t_DisplayPlacement_ClkType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_DisplayPlacement_IfrBust, kn) {
+					currentKey = ffj_t_DisplayPlacement_IfrBust
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_DisplayPlacement_TopFrame, kn) {
+					currentKey = ffj_t_DisplayPlacement_TopFrame
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_DisplayPlacement_Instl, kn) {
+					currentKey = ffj_t_DisplayPlacement_Instl
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_DisplayPlacement_Pos, kn) {
+					currentKey = ffj_t_DisplayPlacement_Pos
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_DisplayPlacementno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_DisplayPlacement_Pos:
+					goto handle_Pos
+
+				case ffj_t_DisplayPlacement_Instl:
+					goto handle_Instl
+
+				case ffj_t_DisplayPlacement_TopFrame:
+					goto handle_TopFrame
+
+				case ffj_t_DisplayPlacement_IfrBust:
+					goto handle_IfrBust
+
+				case ffj_t_DisplayPlacement_ClkType:
+					goto handle_ClkType
+
+				case ffj_t_DisplayPlacement_AmpRen:
+					goto handle_AmpRen
+
+				case ffj_t_DisplayPlacement_PType:
+					goto handle_PType
+
+				case ffj_t_DisplayPlacement_Context:
+					goto handle_Context
+
+				case ffj_t_DisplayPlacement_MIME:
+					goto handle_MIME
+
+				case ffj_t_DisplayPlacement_API:
+					goto handle_API
+
+				case ffj_t_DisplayPlacement_CType:
+					goto handle_CType
+
+				case ffj_t_DisplayPlacement_W:
+					goto handle_W
+
+				case ffj_t_DisplayPlacement_H:
+					goto handle_H
+
+				case ffj_t_DisplayPlacement_Unit:
+					goto handle_Unit
+
+				case ffj_t_DisplayPlacement_Priv:
+					goto handle_Priv
+
+				case ffj_t_DisplayPlacement_DisplayFmt:
+					goto handle_DisplayFmt
+
+				case ffj_t_DisplayPlacement_NativeFmt:
+					goto handle_NativeFmt
+
+				case ffj_t_DisplayPlacement_Event:
+					goto handle_Event
+
+				case ffj_t_DisplayPlacement_Ext:
+					goto handle_Ext
+
+				case ffj_t_DisplayPlacementno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Pos:
+
+	/* handler: uj.Pos type=openrtb.AdPosition kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for AdPosition", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Pos = openrtb.AdPosition(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Instl:
+
+	/* handler: uj.Instl type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Instl = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TopFrame:
+
+	/* handler: uj.TopFrame type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.TopFrame = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_IfrBust:
+
+	/* handler: uj.IfrBust type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.IfrBust = nil
+		} else {
+
+			uj.IfrBust = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__IfrBust string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__IfrBust type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__IfrBust = string(string(outBuf))
+
+					}
+				}
+
+				uj.IfrBust = append(uj.IfrBust, tmp_uj__IfrBust)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ClkType:
+
+	/* handler: uj.ClkType type=adcom1.ClickType kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ClickType", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.ClkType = ClickType(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_AmpRen:
+
+	/* handler: uj.AmpRen type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.AmpRen = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PType:
+
+	/* handler: uj.PType type=adcom1.DisplayPlacementType kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for DisplayPlacementType", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.PType = DisplayPlacementType(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Context:
+
+	/* handler: uj.Context type=adcom1.DisplayContextType kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for DisplayContextType", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Context = DisplayContextType(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MIME:
+
+	/* handler: uj.MIME type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.MIME = nil
+		} else {
+
+			uj.MIME = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__MIME string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__MIME type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__MIME = string(string(outBuf))
+
+					}
+				}
+
+				uj.MIME = append(uj.MIME, tmp_uj__MIME)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_API:
+
+	/* handler: uj.API type=[]openrtb.APIFramework kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.API = nil
+		} else {
+
+			uj.API = []openrtb.APIFramework{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__API openrtb.APIFramework
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__API type=openrtb.APIFramework kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for APIFramework", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__API = openrtb.APIFramework(tval)
+
+					}
+				}
+
+				uj.API = append(uj.API, tmp_uj__API)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CType:
+
+	/* handler: uj.CType type=[]adcom1.DisplayCreativeType kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.CType = nil
+		} else {
+
+			uj.CType = []DisplayCreativeType{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__CType DisplayCreativeType
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__CType type=adcom1.DisplayCreativeType kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for DisplayCreativeType", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__CType = DisplayCreativeType(tval)
+
+					}
+				}
+
+				uj.CType = append(uj.CType, tmp_uj__CType)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_W:
+
+	/* handler: uj.W type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.W = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_H:
+
+	/* handler: uj.H type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.H = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Unit:
+
+	/* handler: uj.Unit type=adcom1.SizeUnit kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for SizeUnit", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Unit = SizeUnit(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Priv:
+
+	/* handler: uj.Priv type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Priv = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_DisplayFmt:
+
+	/* handler: uj.DisplayFmt type=[]adcom1.DisplayFormat kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.DisplayFmt = nil
+		} else {
+
+			uj.DisplayFmt = []DisplayFormat{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__DisplayFmt DisplayFormat
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__DisplayFmt type=adcom1.DisplayFormat kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						state = fflib.FFParse_after_value
+						goto mainparse
+					}
+
+					err = tmp_uj__DisplayFmt.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+					if err != nil {
+						return err
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				uj.DisplayFmt = append(uj.DisplayFmt, tmp_uj__DisplayFmt)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_NativeFmt:
+
+	/* handler: uj.NativeFmt type=adcom1.NativeFormat kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.NativeFmt = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.NativeFmt == nil {
+			uj.NativeFmt = new(NativeFormat)
+		}
+
+		err = uj.NativeFmt.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Event:
+
+	/* handler: uj.Event type=[]adcom1.EventSpec kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Event = nil
+		} else {
+
+			uj.Event = []EventSpec{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Event EventSpec
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Event type=adcom1.EventSpec kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						state = fflib.FFParse_after_value
+						goto mainparse
+					}
+
+					err = tmp_uj__Event.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+					if err != nil {
+						return err
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				uj.Event = append(uj.Event, tmp_uj__Event)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *EventSpec) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *EventSpec) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ "type":`)
+	fflib.FormatBits2(buf, uint64(mj.Type), 10, mj.Type < 0)
+	buf.WriteByte(',')
+	if len(mj.Method) != 0 {
+		buf.WriteString(`"method":`)
+		if mj.Method != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Method {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.API) != 0 {
+		buf.WriteString(`"api":`)
+		if mj.API != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.API {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.JSTrk) != 0 {
+		buf.WriteString(`"jstrk":`)
+		if mj.JSTrk != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.JSTrk {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.WJS != 0 {
+		buf.WriteString(`"wjs":`)
+		fflib.FormatBits2(buf, uint64(mj.WJS), 10, mj.WJS < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.PxTrk) != 0 {
+		buf.WriteString(`"pxtrk":`)
+		if mj.PxTrk != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.PxTrk {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.WPx != 0 {
+		buf.WriteString(`"wpx":`)
+		fflib.FormatBits2(buf, uint64(mj.WPx), 10, mj.WPx < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_EventSpecbase = iota
+	ffj_t_EventSpecno_such_key
+
+	ffj_t_EventSpec_Type
+
+	ffj_t_EventSpec_Method
+
+	ffj_t_EventSpec_API
+
+	ffj_t_EventSpec_JSTrk
+
+	ffj_t_EventSpec_WJS
+
+	ffj_t_EventSpec_PxTrk
+
+	ffj_t_EventSpec_WPx
+
+	ffj_t_EventSpec_Ext
+)
+
+var ffj_key_EventSpec_Type = []byte("type")
+
+var ffj_key_EventSpec_Method = []byte("method")
+
+var ffj_key_EventSpec_API = []byte("api")
+
+var ffj_key_EventSpec_JSTrk = []byte("jstrk")
+
+var ffj_key_EventSpec_WJS = []byte("wjs")
+
+var ffj_key_EventSpec_PxTrk = []byte("pxtrk")
+
+var ffj_key_EventSpec_WPx = []byte("wpx")
+
+var ffj_key_EventSpec_Ext = []byte("ext")
+
+func (uj *EventSpec) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *EventSpec) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_EventSpecbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_EventSpecno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffj_key_EventSpec_API, kn) {
+						currentKey = ffj_t_EventSpec_API
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_EventSpec_Ext, kn) {
+						currentKey = ffj_t_EventSpec_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'j':
+
+					if bytes.Equal(ffj_key_EventSpec_JSTrk, kn) {
+						currentKey = ffj_t_EventSpec_JSTrk
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffj_key_EventSpec_Method, kn) {
+						currentKey = ffj_t_EventSpec_Method
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffj_key_EventSpec_PxTrk, kn) {
+						currentKey = ffj_t_EventSpec_PxTrk
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffj_key_EventSpec_Type, kn) {
+						currentKey = ffj_t_EventSpec_Type
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'w':
+
+					if bytes.Equal(ffj_key_EventSpec_WJS, kn) {
+						currentKey = ffj_t_EventSpec_WJS
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_EventSpec_WPx, kn) {
+						currentKey = ffj_t_EventSpec_WPx
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_EventSpec_Ext, kn) {
+					currentKey = ffj_t_EventSpec_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_EventSpec_WPx, kn) {
+					currentKey = ffj_t_EventSpec_WPx
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_EventSpec_PxTrk, kn) {
+					currentKey = ffj_t_EventSpec_PxTrk
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_EventSpec_WJS, kn) {
+					currentKey = ffj_t_EventSpec_WJS
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_EventSpec_JSTrk, kn) {
+					currentKey = ffj_t_EventSpec_JSTrk
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_EventSpec_API, kn) {
+					currentKey = ffj_t_EventSpec_API
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_EventSpec_Method, kn) {
+					currentKey = ffj_t_EventSpec_Method
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_EventSpec_Type, kn) {
+					currentKey = ffj_t_EventSpec_Type
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_EventSpecno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_EventSpec_Type:
+					goto handle_Type
+
+				case ffj_t_EventSpec_Method:
+					goto handle_Method
+
+				case ffj_t_EventSpec_API:
+					goto handle_API
+
+				case ffj_t_EventSpec_JSTrk:
+					goto handle_JSTrk
+
+				case ffj_t_EventSpec_WJS:
+					goto handle_WJS
+
+				case ffj_t_EventSpec_PxTrk:
+					goto handle_PxTrk
+
+				case ffj_t_EventSpec_WPx:
+					goto handle_WPx
+
+				case ffj_t_EventSpec_Ext:
+					goto handle_Ext
+
+				case ffj_t_EventSpecno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Type:
+
+	/* handler: uj.Type type=adcom1.EventType kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for EventType", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Type = EventType(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Method:
+
+	/* handler: uj.Method type=[]adcom1.EventTrackingMethod kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Method = nil
+		} else {
+
+			uj.Method = []EventTrackingMethod{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Method EventTrackingMethod
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Method type=adcom1.EventTrackingMethod kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for EventTrackingMethod", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__Method = EventTrackingMethod(tval)
+
+					}
+				}
+
+				uj.Method = append(uj.Method, tmp_uj__Method)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_API:
+
+	/* handler: uj.API type=[]openrtb.APIFramework kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.API = nil
+		} else {
+
+			uj.API = []openrtb.APIFramework{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__API openrtb.APIFramework
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__API type=openrtb.APIFramework kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for APIFramework", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__API = openrtb.APIFramework(tval)
+
+					}
+				}
+
+				uj.API = append(uj.API, tmp_uj__API)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_JSTrk:
+
+	/* handler: uj.JSTrk type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.JSTrk = nil
+		} else {
+
+			uj.JSTrk = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__JSTrk string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__JSTrk type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__JSTrk = string(string(outBuf))
+
+					}
+				}
+
+				uj.JSTrk = append(uj.JSTrk, tmp_uj__JSTrk)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_WJS:
+
+	/* handler: uj.WJS type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.WJS = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PxTrk:
+
+	/* handler: uj.PxTrk type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.PxTrk = nil
+		} else {
+
+			uj.PxTrk = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__PxTrk string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__PxTrk type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__PxTrk = string(string(outBuf))
+
+					}
+				}
+
+				uj.PxTrk = append(uj.PxTrk, tmp_uj__PxTrk)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_WPx:
+
+	/* handler: uj.WPx type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.WPx = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *ImageAssetFormat) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *ImageAssetFormat) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if mj.Type != 0 {
+		buf.WriteString(`"type":`)
+		fflib.FormatBits2(buf, uint64(mj.Type), 10, mj.Type < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.MIME) != 0 {
+		buf.WriteString(`"mime":`)
+		if mj.MIME != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.MIME {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.W != 0 {
+		buf.WriteString(`"w":`)
+		fflib.FormatBits2(buf, uint64(mj.W), 10, mj.W < 0)
+		buf.WriteByte(',')
+	}
+	if mj.H != 0 {
+		buf.WriteString(`"h":`)
+		fflib.FormatBits2(buf, uint64(mj.H), 10, mj.H < 0)
+		buf.WriteByte(',')
+	}
+	if mj.WMin != 0 {
+		buf.WriteString(`"wmin":`)
+		fflib.FormatBits2(buf, uint64(mj.WMin), 10, mj.WMin < 0)
+		buf.WriteByte(',')
+	}
+	if mj.HMin != 0 {
+		buf.WriteString(`"hmin":`)
+		fflib.FormatBits2(buf, uint64(mj.HMin), 10, mj.HMin < 0)
+		buf.WriteByte(',')
+	}
+	if mj.WRatio != 0 {
+		buf.WriteString(`"wratio":`)
+		fflib.FormatBits2(buf, uint64(mj.WRatio), 10, mj.WRatio < 0)
+		buf.WriteByte(',')
+	}
+	if mj.HRatio != 0 {
+		buf.WriteString(`"hratio":`)
+		fflib.FormatBits2(buf, uint64(mj.HRatio), 10, mj.HRatio < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_ImageAssetFormatbase = iota
+	ffj_t_ImageAssetFormatno_such_key
+
+	ffj_t_ImageAssetFormat_Type
+
+	ffj_t_ImageAssetFormat_MIME
+
+	ffj_t_ImageAssetFormat_W
+
+	ffj_t_ImageAssetFormat_H
+
+	ffj_t_ImageAssetFormat_WMin
+
+	ffj_t_ImageAssetFormat_HMin
+
+	ffj_t_ImageAssetFormat_WRatio
+
+	ffj_t_ImageAssetFormat_HRatio
+
+	ffj_t_ImageAssetFormat_Ext
+)
+
+var ffj_key_ImageAssetFormat_Type = []byte("type")
+
+var ffj_key_ImageAssetFormat_MIME = []byte("mime")
+
+var ffj_key_ImageAssetFormat_W = []byte("w")
+
+var ffj_key_ImageAssetFormat_H = []byte("h")
+
+var ffj_key_ImageAssetFormat_WMin = []byte("wmin")
+
+var ffj_key_ImageAssetFormat_HMin = []byte("hmin")
+
+var ffj_key_ImageAssetFormat_WRatio = []byte("wratio")
+
+var ffj_key_ImageAssetFormat_HRatio = []byte("hratio")
+
+var ffj_key_ImageAssetFormat_Ext = []byte("ext")
+
+func (uj *ImageAssetFormat) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *ImageAssetFormat) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_ImageAssetFormatbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_ImageAssetFormatno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_ImageAssetFormat_Ext, kn) {
+						currentKey = ffj_t_ImageAssetFormat_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'h':
+
+					if bytes.Equal(ffj_key_ImageAssetFormat_H, kn) {
+						currentKey = ffj_t_ImageAssetFormat_H
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_ImageAssetFormat_HMin, kn) {
+						currentKey = ffj_t_ImageAssetFormat_HMin
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_ImageAssetFormat_HRatio, kn) {
+						currentKey = ffj_t_ImageAssetFormat_HRatio
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffj_key_ImageAssetFormat_MIME, kn) {
+						currentKey = ffj_t_ImageAssetFormat_MIME
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffj_key_ImageAssetFormat_Type, kn) {
+						currentKey = ffj_t_ImageAssetFormat_Type
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'w':
+
+					if bytes.Equal(ffj_key_ImageAssetFormat_W, kn) {
+						currentKey = ffj_t_ImageAssetFormat_W
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_ImageAssetFormat_WMin, kn) {
+						currentKey = ffj_t_ImageAssetFormat_WMin
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_ImageAssetFormat_WRatio, kn) {
+						currentKey = ffj_t_ImageAssetFormat_WRatio
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_ImageAssetFormat_Ext, kn) {
+					currentKey = ffj_t_ImageAssetFormat_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_ImageAssetFormat_HRatio, kn) {
+					currentKey = ffj_t_ImageAssetFormat_HRatio
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_ImageAssetFormat_WRatio, kn) {
+					currentKey = ffj_t_ImageAssetFormat_WRatio
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_ImageAssetFormat_HMin, kn) {
+					currentKey = ffj_t_ImageAssetFormat_HMin
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_ImageAssetFormat_WMin, kn) {
+					currentKey = ffj_t_ImageAssetFormat_WMin
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_ImageAssetFormat_H, kn) {
+					currentKey = ffj_t_ImageAssetFormat_H
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_ImageAssetFormat_W, kn) {
+					currentKey = ffj_t_ImageAssetFormat_W
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_ImageAssetFormat_MIME, kn) {
+					currentKey = ffj_t_ImageAssetFormat_MIME
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_ImageAssetFormat_Type, kn) {
+					currentKey = ffj_t_ImageAssetFormat_Type
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_ImageAssetFormatno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_ImageAssetFormat_Type:
+					goto handle_Type
+
+				case ffj_t_ImageAssetFormat_MIME:
+					goto handle_MIME
+
+				case ffj_t_ImageAssetFormat_W:
+					goto handle_W
+
+				case ffj_t_ImageAssetFormat_H:
+					goto handle_H
+
+				case ffj_t_ImageAssetFormat_WMin:
+					goto handle_WMin
+
+				case ffj_t_ImageAssetFormat_HMin:
+					goto handle_HMin
+
+				case ffj_t_ImageAssetFormat_WRatio:
+					goto handle_WRatio
+
+				case ffj_t_ImageAssetFormat_HRatio:
+					goto handle_HRatio
+
+				case ffj_t_ImageAssetFormat_Ext:
+					goto handle_Ext
+
+				case ffj_t_ImageAssetFormatno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Type:
+
+	/* handler: uj.Type type=adcom1.NativeImageAssetType kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for NativeImageAssetType", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Type = NativeImageAssetType(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MIME:
+
+	/* handler: uj.MIME type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.MIME = nil
+		} else {
+
+			uj.MIME = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__MIME string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__MIME type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__MIME = string(string(outBuf))
+
+					}
+				}
+
+				uj.MIME = append(uj.MIME, tmp_uj__MIME)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_W:
+
+	/* handler: uj.W type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.W = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_H:
+
+	/* handler: uj.H type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.H = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_WMin:
+
+	/* handler: uj.WMin type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.WMin = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_HMin:
+
+	/* handler: uj.HMin type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.HMin = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_WRatio:
+
+	/* handler: uj.WRatio type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.WRatio = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_HRatio:
+
+	/* handler: uj.HRatio type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.HRatio = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *NativeFormat) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *NativeFormat) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.Asset) != 0 {
+		buf.WriteString(`"asset":`)
+		if mj.Asset != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Asset {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					err = v.MarshalJSONBuf(buf)
+					if err != nil {
+						return err
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_NativeFormatbase = iota
+	ffj_t_NativeFormatno_such_key
+
+	ffj_t_NativeFormat_Asset
+
+	ffj_t_NativeFormat_Ext
+)
+
+var ffj_key_NativeFormat_Asset = []byte("asset")
+
+var ffj_key_NativeFormat_Ext = []byte("ext")
+
+func (uj *NativeFormat) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *NativeFormat) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_NativeFormatbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_NativeFormatno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffj_key_NativeFormat_Asset, kn) {
+						currentKey = ffj_t_NativeFormat_Asset
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_NativeFormat_Ext, kn) {
+						currentKey = ffj_t_NativeFormat_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_NativeFormat_Ext, kn) {
+					currentKey = ffj_t_NativeFormat_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_NativeFormat_Asset, kn) {
+					currentKey = ffj_t_NativeFormat_Asset
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_NativeFormatno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_NativeFormat_Asset:
+					goto handle_Asset
+
+				case ffj_t_NativeFormat_Ext:
+					goto handle_Ext
+
+				case ffj_t_NativeFormatno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Asset:
+
+	/* handler: uj.Asset type=[]adcom1.AssetFormat kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Asset = nil
+		} else {
+
+			uj.Asset = []AssetFormat{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Asset AssetFormat
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Asset type=adcom1.AssetFormat kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						state = fflib.FFParse_after_value
+						goto mainparse
+					}
+
+					err = tmp_uj__Asset.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+					if err != nil {
+						return err
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				uj.Asset = append(uj.Asset, tmp_uj__Asset)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *Placement) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *Placement) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if len(mj.TagID) != 0 {
+		buf.WriteString(`"tagid":`)
+		fflib.WriteJsonString(buf, string(mj.TagID))
+		buf.WriteByte(',')
+	}
+	if mj.SSAI != 0 {
+		buf.WriteString(`"ssai":`)
+		fflib.FormatBits2(buf, uint64(mj.SSAI), 10, mj.SSAI < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.SDK) != 0 {
+		buf.WriteString(`"sdk":`)
+		fflib.WriteJsonString(buf, string(mj.SDK))
+		buf.WriteByte(',')
+	}
+	if len(mj.SDKVer) != 0 {
+		buf.WriteString(`"sdkver":`)
+		fflib.WriteJsonString(buf, string(mj.SDKVer))
+		buf.WriteByte(',')
+	}
+	if mj.Reward != 0 {
+		buf.WriteString(`"reward":`)
+		fflib.FormatBits2(buf, uint64(mj.Reward), 10, mj.Reward < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.WLang) != 0 {
+		buf.WriteString(`"wlang":`)
+		if mj.WLang != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.WLang {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.WLangB) != 0 {
+		buf.WriteString(`"wlangb":`)
+		if mj.WLangB != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.WLangB {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.Secure != 0 {
+		buf.WriteString(`"secure":`)
+		fflib.FormatBits2(buf, uint64(mj.Secure), 10, mj.Secure < 0)
+		buf.WriteByte(',')
+	}
+	if mj.AdmX != 0 {
+		buf.WriteString(`"admx":`)
+		fflib.FormatBits2(buf, uint64(mj.AdmX), 10, mj.AdmX < 0)
+		buf.WriteByte(',')
+	}
+	if mj.CURLX != 0 {
+		buf.WriteString(`"curlx":`)
+		fflib.FormatBits2(buf, uint64(mj.CURLX), 10, mj.CURLX < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Display != nil {
+		if true {
+			buf.WriteString(`"display":`)
+
+			{
+
+				err = mj.Display.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if mj.Video != nil {
+		if true {
+			buf.WriteString(`"video":`)
+
+			{
+
+				err = mj.Video.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if mj.Audio != nil {
+		if true {
+			buf.WriteString(`"audio":`)
+
+			{
+
+				err = mj.Audio.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_Placementbase = iota
+	ffj_t_Placementno_such_key
+
+	ffj_t_Placement_TagID
+
+	ffj_t_Placement_SSAI
+
+	ffj_t_Placement_SDK
+
+	ffj_t_Placement_SDKVer
+
+	ffj_t_Placement_Reward
+
+	ffj_t_Placement_WLang
+
+	ffj_t_Placement_WLangB
+
+	ffj_t_Placement_Secure
+
+	ffj_t_Placement_AdmX
+
+	ffj_t_Placement_CURLX
+
+	ffj_t_Placement_Display
+
+	ffj_t_Placement_Video
+
+	ffj_t_Placement_Audio
+
+	ffj_t_Placement_Ext
+)
+
+var ffj_key_Placement_TagID = []byte("tagid")
+
+var ffj_key_Placement_SSAI = []byte("ssai")
+
+var ffj_key_Placement_SDK = []byte("sdk")
+
+var ffj_key_Placement_SDKVer = []byte("sdkver")
+
+var ffj_key_Placement_Reward = []byte("reward")
+
+var ffj_key_Placement_WLang = []byte("wlang")
+
+var ffj_key_Placement_WLangB = []byte("wlangb")
+
+var ffj_key_Placement_Secure = []byte("secure")
+
+var ffj_key_Placement_AdmX = []byte("admx")
+
+var ffj_key_Placement_CURLX = []byte("curlx")
+
+var ffj_key_Placement_Display = []byte("display")
+
+var ffj_key_Placement_Video = []byte("video")
+
+var ffj_key_Placement_Audio = []byte("audio")
+
+var ffj_key_Placement_Ext = []byte("ext")
+
+func (uj *Placement) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *Placement) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_Placementbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_Placementno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffj_key_Placement_AdmX, kn) {
+						currentKey = ffj_t_Placement_AdmX
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Placement_Audio, kn) {
+						currentKey = ffj_t_Placement_Audio
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_Placement_CURLX, kn) {
+						currentKey = ffj_t_Placement_CURLX
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_Placement_Display, kn) {
+						currentKey = ffj_t_Placement_Display
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_Placement_Ext, kn) {
+						currentKey = ffj_t_Placement_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'r':
+
+					if bytes.Equal(ffj_key_Placement_Reward, kn) {
+						currentKey = ffj_t_Placement_Reward
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffj_key_Placement_SSAI, kn) {
+						currentKey = ffj_t_Placement_SSAI
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Placement_SDK, kn) {
+						currentKey = ffj_t_Placement_SDK
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Placement_SDKVer, kn) {
+						currentKey = ffj_t_Placement_SDKVer
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Placement_Secure, kn) {
+						currentKey = ffj_t_Placement_Secure
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffj_key_Placement_TagID, kn) {
+						currentKey = ffj_t_Placement_TagID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'v':
+
+					if bytes.Equal(ffj_key_Placement_Video, kn) {
+						currentKey = ffj_t_Placement_Video
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'w':
+
+					if bytes.Equal(ffj_key_Placement_WLang, kn) {
+						currentKey = ffj_t_Placement_WLang
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Placement_WLangB, kn) {
+						currentKey = ffj_t_Placement_WLangB
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Placement_Ext, kn) {
+					currentKey = ffj_t_Placement_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Placement_Audio, kn) {
+					currentKey = ffj_t_Placement_Audio
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Placement_Video, kn) {
+					currentKey = ffj_t_Placement_Video
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Placement_Display, kn) {
+					currentKey = ffj_t_Placement_Display
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Placement_CURLX, kn) {
+					currentKey = ffj_t_Placement_CURLX
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Placement_AdmX, kn) {
+					currentKey = ffj_t_Placement_AdmX
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Placement_Secure, kn) {
+					currentKey = ffj_t_Placement_Secure
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Placement_WLangB, kn) {
+					currentKey = ffj_t_Placement_WLangB
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Placement_WLang, kn) {
+					currentKey = ffj_t_Placement_WLang
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Placement_Reward, kn) {
+					currentKey = ffj_t_Placement_Reward
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Placement_SDKVer, kn) {
+					currentKey = ffj_t_Placement_SDKVer
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Placement_SDK, kn) {
+					currentKey = ffj_t_Placement_SDK
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Placement_SSAI, kn) {
+					currentKey = ffj_t_Placement_SSAI
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Placement_TagID, kn) {
+					currentKey = ffj_t_Placement_TagID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_Placementno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_Placement_TagID:
+					goto handle_TagID
+
+				case ffj_t_Placement_SSAI:
+					goto handle_SSAI
+
+				case ffj_t_Placement_SDK:
+					goto handle_SDK
+
+				case ffj_t_Placement_SDKVer:
+					goto handle_SDKVer
+
+				case ffj_t_Placement_Reward:
+					goto handle_Reward
+
+				case ffj_t_Placement_WLang:
+					goto handle_WLang
+
+				case ffj_t_Placement_WLangB:
+					goto handle_WLangB
+
+				case ffj_t_Placement_Secure:
+					goto handle_Secure
+
+				case ffj_t_Placement_AdmX:
+					goto handle_AdmX
+
+				case ffj_t_Placement_CURLX:
+					goto handle_CURLX
+
+				case ffj_t_Placement_Display:
+					goto handle_Display
+
+				case ffj_t_Placement_Video:
+					goto handle_Video
+
+				case ffj_t_Placement_Audio:
+					goto handle_Audio
+
+				case ffj_t_Placement_Ext:
+					goto handle_Ext
+
+				case ffj_t_Placementno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_TagID:
+
+	/* handler: uj.TagID type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.TagID = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SSAI:
+
+	/* handler: uj.SSAI type=openrtb.SSAIType kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for SSAIType", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.SSAI = openrtb.SSAIType(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SDK:
+
+	/* handler: uj.SDK type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.SDK = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SDKVer:
+
+	/* handler: uj.SDKVer type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.SDKVer = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Reward:
+
+	/* handler: uj.Reward type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Reward = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_WLang:
+
+	/* handler: uj.WLang type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.WLang = nil
+		} else {
+
+			uj.WLang = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__WLang string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__WLang type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__WLang = string(string(outBuf))
+
+					}
+				}
+
+				uj.WLang = append(uj.WLang, tmp_uj__WLang)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_WLangB:
+
+	/* handler: uj.WLangB type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.WLangB = nil
+		} else {
+
+			uj.WLangB = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__WLangB string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__WLangB type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__WLangB = string(string(outBuf))
+
+					}
+				}
+
+				uj.WLangB = append(uj.WLangB, tmp_uj__WLangB)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Secure:
+
+	/* handler: uj.Secure type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Secure = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_AdmX:
+
+	/* handler: uj.AdmX type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.AdmX = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CURLX:
+
+	/* handler: uj.CURLX type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.CURLX = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Display:
+
+	/* handler: uj.Display type=adcom1.DisplayPlacement kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Display = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Display == nil {
+			uj.Display = new(DisplayPlacement)
+		}
+
+		err = uj.Display.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Video:
+
+	/* handler: uj.Video type=adcom1.VideoPlacement kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Video = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Video == nil {
+			uj.Video = new(VideoPlacement)
+		}
+
+		err = uj.Video.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Audio:
+
+	/* handler: uj.Audio type=adcom1.AudioPlacement kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Audio = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Audio == nil {
+			uj.Audio = new(AudioPlacement)
+		}
+
+		err = uj.Audio.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *TitleAssetFormat) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *TitleAssetFormat) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ "len":`)
+	fflib.FormatBits2(buf, uint64(mj.Len), 10, mj.Len < 0)
+	buf.WriteByte(',')
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_TitleAssetFormatbase = iota
+	ffj_t_TitleAssetFormatno_such_key
+
+	ffj_t_TitleAssetFormat_Len
+
+	ffj_t_TitleAssetFormat_Ext
+)
+
+var ffj_key_TitleAssetFormat_Len = []byte("len")
+
+var ffj_key_TitleAssetFormat_Ext = []byte("ext")
+
+func (uj *TitleAssetFormat) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *TitleAssetFormat) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_TitleAssetFormatbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_TitleAssetFormatno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_TitleAssetFormat_Ext, kn) {
+						currentKey = ffj_t_TitleAssetFormat_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'l':
+
+					if bytes.Equal(ffj_key_TitleAssetFormat_Len, kn) {
+						currentKey = ffj_t_TitleAssetFormat_Len
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_TitleAssetFormat_Ext, kn) {
+					currentKey = ffj_t_TitleAssetFormat_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_TitleAssetFormat_Len, kn) {
+					currentKey = ffj_t_TitleAssetFormat_Len
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_TitleAssetFormatno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_TitleAssetFormat_Len:
+					goto handle_Len
+
+				case ffj_t_TitleAssetFormat_Ext:
+					goto handle_Ext
+
+				case ffj_t_TitleAssetFormatno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Len:
+
+	/* handler: uj.Len type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Len = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+func (mj *VideoPlacement) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if mj == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := mj.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+func (mj *VideoPlacement) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if mj == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if mj.PType != 0 {
+		buf.WriteString(`"ptype":`)
+		fflib.FormatBits2(buf, uint64(mj.PType), 10, mj.PType < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Pos != 0 {
+		buf.WriteString(`"pos":`)
+		fflib.FormatBits2(buf, uint64(mj.Pos), 10, mj.Pos < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Delay != 0 {
+		buf.WriteString(`"delay":`)
+		fflib.FormatBits2(buf, uint64(mj.Delay), 10, mj.Delay < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Skip != 0 {
+		buf.WriteString(`"skip":`)
+		fflib.FormatBits2(buf, uint64(mj.Skip), 10, mj.Skip < 0)
+		buf.WriteByte(',')
+	}
+	if mj.SkipMin != 0 {
+		buf.WriteString(`"skipmin":`)
+		fflib.FormatBits2(buf, uint64(mj.SkipMin), 10, mj.SkipMin < 0)
+		buf.WriteByte(',')
+	}
+	if mj.SkipAfter != 0 {
+		buf.WriteString(`"skipafter":`)
+		fflib.FormatBits2(buf, uint64(mj.SkipAfter), 10, mj.SkipAfter < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.PlayMethod) != 0 {
+		buf.WriteString(`"playmethod":`)
+		if mj.PlayMethod != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.PlayMethod {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.PlayEnd != 0 {
+		buf.WriteString(`"playend":`)
+		fflib.FormatBits2(buf, uint64(mj.PlayEnd), 10, mj.PlayEnd < 0)
+		buf.WriteByte(',')
+	}
+	if mj.ClkType != 0 {
+		buf.WriteString(`"clktype":`)
+		fflib.FormatBits2(buf, uint64(mj.ClkType), 10, mj.ClkType < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.MIME) != 0 {
+		buf.WriteString(`"mime":`)
+		if mj.MIME != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.MIME {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.API) != 0 {
+		buf.WriteString(`"api":`)
+		if mj.API != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.API {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.CType) != 0 {
+		buf.WriteString(`"ctype":`)
+		if mj.CType != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.CType {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.W != 0 {
+		buf.WriteString(`"w":`)
+		fflib.FormatBits2(buf, uint64(mj.W), 10, mj.W < 0)
+		buf.WriteByte(',')
+	}
+	if mj.H != 0 {
+		buf.WriteString(`"h":`)
+		fflib.FormatBits2(buf, uint64(mj.H), 10, mj.H < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Unit != 0 {
+		buf.WriteString(`"unit":`)
+		fflib.FormatBits2(buf, uint64(mj.Unit), 10, mj.Unit < 0)
+		buf.WriteByte(',')
+	}
+	if mj.MinDur != 0 {
+		buf.WriteString(`"mindur":`)
+		fflib.FormatBits2(buf, uint64(mj.MinDur), 10, mj.MinDur < 0)
+		buf.WriteByte(',')
+	}
+	if mj.MaxDur != 0 {
+		buf.WriteString(`"maxdur":`)
+		fflib.FormatBits2(buf, uint64(mj.MaxDur), 10, mj.MaxDur < 0)
+		buf.WriteByte(',')
+	}
+	if mj.MaxExt != 0 {
+		buf.WriteString(`"maxext":`)
+		fflib.FormatBits2(buf, uint64(mj.MaxExt), 10, mj.MaxExt < 0)
+		buf.WriteByte(',')
+	}
+	if mj.MinBitR != 0 {
+		buf.WriteString(`"minbitr":`)
+		fflib.FormatBits2(buf, uint64(mj.MinBitR), 10, mj.MinBitR < 0)
+		buf.WriteByte(',')
+	}
+	if mj.MaxBitR != 0 {
+		buf.WriteString(`"maxbitr":`)
+		fflib.FormatBits2(buf, uint64(mj.MaxBitR), 10, mj.MaxBitR < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.Delivery) != 0 {
+		buf.WriteString(`"delivery":`)
+		if mj.Delivery != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Delivery {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.MaxSeq != 0 {
+		buf.WriteString(`"maxseq":`)
+		fflib.FormatBits2(buf, uint64(mj.MaxSeq), 10, mj.MaxSeq < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Linear != 0 {
+		buf.WriteString(`"linear":`)
+		fflib.FormatBits2(buf, uint64(mj.Linear), 10, mj.Linear < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Boxing != nil {
+		if true {
+			buf.WriteString(`"boxing":`)
+			fflib.FormatBits2(buf, uint64(*mj.Boxing), 10, *mj.Boxing < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.Comp) != 0 {
+		buf.WriteString(`"comp":`)
+		if mj.Comp != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Comp {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					err = v.MarshalJSONBuf(buf)
+					if err != nil {
+						return err
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.CompType) != 0 {
+		buf.WriteString(`"comptype":`)
+		if mj.CompType != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.CompType {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.Ext) != 0 {
+		buf.WriteString(`"ext":`)
+
+		{
+
+			obj, err = mj.Ext.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(obj)
+
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffj_t_VideoPlacementbase = iota
+	ffj_t_VideoPlacementno_such_key
+
+	ffj_t_VideoPlacement_PType
+
+	ffj_t_VideoPlacement_Pos
+
+	ffj_t_VideoPlacement_Delay
+
+	ffj_t_VideoPlacement_Skip
+
+	ffj_t_VideoPlacement_SkipMin
+
+	ffj_t_VideoPlacement_SkipAfter
+
+	ffj_t_VideoPlacement_PlayMethod
+
+	ffj_t_VideoPlacement_PlayEnd
+
+	ffj_t_VideoPlacement_ClkType
+
+	ffj_t_VideoPlacement_MIME
+
+	ffj_t_VideoPlacement_API
+
+	ffj_t_VideoPlacement_CType
+
+	ffj_t_VideoPlacement_W
+
+	ffj_t_VideoPlacement_H
+
+	ffj_t_VideoPlacement_Unit
+
+	ffj_t_VideoPlacement_MinDur
+
+	ffj_t_VideoPlacement_MaxDur
+
+	ffj_t_VideoPlacement_MaxExt
+
+	ffj_t_VideoPlacement_MinBitR
+
+	ffj_t_VideoPlacement_MaxBitR
+
+	ffj_t_VideoPlacement_Delivery
+
+	ffj_t_VideoPlacement_MaxSeq
+
+	ffj_t_VideoPlacement_Linear
+
+	ffj_t_VideoPlacement_Boxing
+
+	ffj_t_VideoPlacement_Comp
+
+	ffj_t_VideoPlacement_CompType
+
+	ffj_t_VideoPlacement_Ext
+)
+
+var ffj_key_VideoPlacement_PType = []byte("ptype")
+
+var ffj_key_VideoPlacement_Pos = []byte("pos")
+
+var ffj_key_VideoPlacement_Delay = []byte("delay")
+
+var ffj_key_VideoPlacement_Skip = []byte("skip")
+
+var ffj_key_VideoPlacement_SkipMin = []byte("skipmin")
+
+var ffj_key_VideoPlacement_SkipAfter = []byte("skipafter")
+
+var ffj_key_VideoPlacement_PlayMethod = []byte("playmethod")
+
+var ffj_key_VideoPlacement_PlayEnd = []byte("playend")
+
+var ffj_key_VideoPlacement_ClkType = []byte("clktype")
+
+var ffj_key_VideoPlacement_MIME = []byte("mime")
+
+var ffj_key_VideoPlacement_API = []byte("api")
+
+var ffj_key_VideoPlacement_CType = []byte("ctype")
+
+var ffj_key_VideoPlacement_W = []byte("w")
+
+var ffj_key_VideoPlacement_H = []byte("h")
+
+var ffj_key_VideoPlacement_Unit = []byte("unit")
+
+var ffj_key_VideoPlacement_MinDur = []byte("mindur")
+
+var ffj_key_VideoPlacement_MaxDur = []byte("maxdur")
+
+var ffj_key_VideoPlacement_MaxExt = []byte("maxext")
+
+var ffj_key_VideoPlacement_MinBitR = []byte("minbitr")
+
+var ffj_key_VideoPlacement_MaxBitR = []byte("maxbitr")
+
+var ffj_key_VideoPlacement_Delivery = []byte("delivery")
+
+var ffj_key_VideoPlacement_MaxSeq = []byte("maxseq")
+
+var ffj_key_VideoPlacement_Linear = []byte("linear")
+
+var ffj_key_VideoPlacement_Boxing = []byte("boxing")
+
+var ffj_key_VideoPlacement_Comp = []byte("comp")
+
+var ffj_key_VideoPlacement_CompType = []byte("comptype")
+
+var ffj_key_VideoPlacement_Ext = []byte("ext")
+
+func (uj *VideoPlacement) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+func (uj *VideoPlacement) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error = nil
+	currentKey := ffj_t_VideoPlacementbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffj_t_VideoPlacementno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'a':
+
+					if bytes.Equal(ffj_key_VideoPlacement_API, kn) {
+						currentKey = ffj_t_VideoPlacement_API
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'b':
+
+					if bytes.Equal(ffj_key_VideoPlacement_Boxing, kn) {
+						currentKey = ffj_t_VideoPlacement_Boxing
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffj_key_VideoPlacement_ClkType, kn) {
+						currentKey = ffj_t_VideoPlacement_ClkType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_CType, kn) {
+						currentKey = ffj_t_VideoPlacement_CType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_Comp, kn) {
+						currentKey = ffj_t_VideoPlacement_Comp
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_CompType, kn) {
+						currentKey = ffj_t_VideoPlacement_CompType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_VideoPlacement_Delay, kn) {
+						currentKey = ffj_t_VideoPlacement_Delay
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_Delivery, kn) {
+						currentKey = ffj_t_VideoPlacement_Delivery
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffj_key_VideoPlacement_Ext, kn) {
+						currentKey = ffj_t_VideoPlacement_Ext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'h':
+
+					if bytes.Equal(ffj_key_VideoPlacement_H, kn) {
+						currentKey = ffj_t_VideoPlacement_H
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'l':
+
+					if bytes.Equal(ffj_key_VideoPlacement_Linear, kn) {
+						currentKey = ffj_t_VideoPlacement_Linear
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffj_key_VideoPlacement_MIME, kn) {
+						currentKey = ffj_t_VideoPlacement_MIME
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_MinDur, kn) {
+						currentKey = ffj_t_VideoPlacement_MinDur
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_MaxDur, kn) {
+						currentKey = ffj_t_VideoPlacement_MaxDur
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_MaxExt, kn) {
+						currentKey = ffj_t_VideoPlacement_MaxExt
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_MinBitR, kn) {
+						currentKey = ffj_t_VideoPlacement_MinBitR
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_MaxBitR, kn) {
+						currentKey = ffj_t_VideoPlacement_MaxBitR
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_MaxSeq, kn) {
+						currentKey = ffj_t_VideoPlacement_MaxSeq
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffj_key_VideoPlacement_PType, kn) {
+						currentKey = ffj_t_VideoPlacement_PType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_Pos, kn) {
+						currentKey = ffj_t_VideoPlacement_Pos
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_PlayMethod, kn) {
+						currentKey = ffj_t_VideoPlacement_PlayMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_PlayEnd, kn) {
+						currentKey = ffj_t_VideoPlacement_PlayEnd
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffj_key_VideoPlacement_Skip, kn) {
+						currentKey = ffj_t_VideoPlacement_Skip
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_SkipMin, kn) {
+						currentKey = ffj_t_VideoPlacement_SkipMin
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_VideoPlacement_SkipAfter, kn) {
+						currentKey = ffj_t_VideoPlacement_SkipAfter
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'u':
+
+					if bytes.Equal(ffj_key_VideoPlacement_Unit, kn) {
+						currentKey = ffj_t_VideoPlacement_Unit
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'w':
+
+					if bytes.Equal(ffj_key_VideoPlacement_W, kn) {
+						currentKey = ffj_t_VideoPlacement_W
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_Ext, kn) {
+					currentKey = ffj_t_VideoPlacement_Ext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_CompType, kn) {
+					currentKey = ffj_t_VideoPlacement_CompType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_Comp, kn) {
+					currentKey = ffj_t_VideoPlacement_Comp
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_Boxing, kn) {
+					currentKey = ffj_t_VideoPlacement_Boxing
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_Linear, kn) {
+					currentKey = ffj_t_VideoPlacement_Linear
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_VideoPlacement_MaxSeq, kn) {
+					currentKey = ffj_t_VideoPlacement_MaxSeq
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_Delivery, kn) {
+					currentKey = ffj_t_VideoPlacement_Delivery
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_MaxBitR, kn) {
+					currentKey = ffj_t_VideoPlacement_MaxBitR
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_MinBitR, kn) {
+					currentKey = ffj_t_VideoPlacement_MinBitR
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_MaxExt, kn) {
+					currentKey = ffj_t_VideoPlacement_MaxExt
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_MaxDur, kn) {
+					currentKey = ffj_t_VideoPlacement_MaxDur
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_MinDur, kn) {
+					currentKey = ffj_t_VideoPlacement_MinDur
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_Unit, kn) {
+					currentKey = ffj_t_VideoPlacement_Unit
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_H, kn) {
+					currentKey = ffj_t_VideoPlacement_H
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_W, kn) {
+					currentKey = ffj_t_VideoPlacement_W
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_CType, kn) {
+					currentKey = ffj_t_VideoPlacement_CType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_API, kn) {
+					currentKey = ffj_t_VideoPlacement_API
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_MIME, kn) {
+					currentKey = ffj_t_VideoPlacement_MIME
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_VideoPlacement_ClkType, kn) {
+					currentKey = ffj_t_VideoPlacement_ClkType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_PlayEnd, kn) {
+					currentKey = ffj_t_VideoPlacement_PlayEnd
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_PlayMethod, kn) {
+					currentKey = ffj_t_VideoPlacement_PlayMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_VideoPlacement_SkipAfter, kn) {
+					currentKey = ffj_t_VideoPlacement_SkipAfter
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_VideoPlacement_SkipMin, kn) {
+					currentKey = ffj_t_VideoPlacement_SkipMin
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_VideoPlacement_Skip, kn) {
+					currentKey = ffj_t_VideoPlacement_Skip
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_Delay, kn) {
+					currentKey = ffj_t_VideoPlacement_Delay
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_VideoPlacement_Pos, kn) {
+					currentKey = ffj_t_VideoPlacement_Pos
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_VideoPlacement_PType, kn) {
+					currentKey = ffj_t_VideoPlacement_PType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffj_t_VideoPlacementno_such_key
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffj_t_VideoPlacement_PType:
+					goto handle_PType
+
+				case ffj_t_VideoPlacement_Pos:
+					goto handle_Pos
+
+				case ffj_t_VideoPlacement_Delay:
+					goto handle_Delay
+
+				case ffj_t_VideoPlacement_Skip:
+					goto handle_Skip
+
+				case ffj_t_VideoPlacement_SkipMin:
+					goto handle_SkipMin
+
+				case ffj_t_VideoPlacement_SkipAfter:
+					goto handle_SkipAfter
+
+				case ffj_t_VideoPlacement_PlayMethod:
+					goto handle_PlayMethod
+
+				case ffj_t_VideoPlacement_PlayEnd:
+					goto handle_PlayEnd
+
+				case ffj_t_VideoPlacement_ClkType:
+					goto handle_ClkType
+
+				case ffj_t_VideoPlacement_MIME:
+					goto handle_MIME
+
+				case ffj_t_VideoPlacement_API:
+					goto handle_API
+
+				case ffj_t_VideoPlacement_CType:
+					goto handle_CType
+
+				case ffj_t_VideoPlacement_W:
+					goto handle_W
+
+				case ffj_t_VideoPlacement_H:
+					goto handle_H
+
+				case ffj_t_VideoPlacement_Unit:
+					goto handle_Unit
+
+				case ffj_t_VideoPlacement_MinDur:
+					goto handle_MinDur
+
+				case ffj_t_VideoPlacement_MaxDur:
+					goto handle_MaxDur
+
+				case ffj_t_VideoPlacement_MaxExt:
+					goto handle_MaxExt
+
+				case ffj_t_VideoPlacement_MinBitR:
+					goto handle_MinBitR
+
+				case ffj_t_VideoPlacement_MaxBitR:
+					goto handle_MaxBitR
+
+				case ffj_t_VideoPlacement_Delivery:
+					goto handle_Delivery
+
+				case ffj_t_VideoPlacement_MaxSeq:
+					goto handle_MaxSeq
+
+				case ffj_t_VideoPlacement_Linear:
+					goto handle_Linear
+
+				case ffj_t_VideoPlacement_Boxing:
+					goto handle_Boxing
+
+				case ffj_t_VideoPlacement_Comp:
+					goto handle_Comp
+
+				case ffj_t_VideoPlacement_CompType:
+					goto handle_CompType
+
+				case ffj_t_VideoPlacement_Ext:
+					goto handle_Ext
+
+				case ffj_t_VideoPlacementno_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_PType:
+
+	/* handler: uj.PType type=openrtb.VideoPlacement kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for VideoPlacement", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.PType = openrtb.VideoPlacement(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Pos:
+
+	/* handler: uj.Pos type=openrtb.AdPosition kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for AdPosition", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Pos = openrtb.AdPosition(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Delay:
+
+	/* handler: uj.Delay type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Delay = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Skip:
+
+	/* handler: uj.Skip type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Skip = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SkipMin:
+
+	/* handler: uj.SkipMin type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.SkipMin = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SkipAfter:
+
+	/* handler: uj.SkipAfter type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.SkipAfter = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PlayMethod:
+
+	/* handler: uj.PlayMethod type=[]openrtb.PlaybackMethod kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.PlayMethod = nil
+		} else {
+
+			uj.PlayMethod = []openrtb.PlaybackMethod{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__PlayMethod openrtb.PlaybackMethod
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__PlayMethod type=openrtb.PlaybackMethod kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for PlaybackMethod", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__PlayMethod = openrtb.PlaybackMethod(tval)
+
+					}
+				}
+
+				uj.PlayMethod = append(uj.PlayMethod, tmp_uj__PlayMethod)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PlayEnd:
+
+	/* handler: uj.PlayEnd type=adcom1.PlaybackCessationMode kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for PlaybackCessationMode", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.PlayEnd = PlaybackCessationMode(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ClkType:
+
+	/* handler: uj.ClkType type=adcom1.ClickType kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ClickType", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.ClkType = ClickType(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MIME:
+
+	/* handler: uj.MIME type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.MIME = nil
+		} else {
+
+			uj.MIME = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__MIME string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__MIME type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmp_uj__MIME = string(string(outBuf))
+
+					}
+				}
+
+				uj.MIME = append(uj.MIME, tmp_uj__MIME)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_API:
+
+	/* handler: uj.API type=[]openrtb.APIFramework kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.API = nil
+		} else {
+
+			uj.API = []openrtb.APIFramework{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__API openrtb.APIFramework
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__API type=openrtb.APIFramework kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for APIFramework", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__API = openrtb.APIFramework(tval)
+
+					}
+				}
+
+				uj.API = append(uj.API, tmp_uj__API)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CType:
+
+	/* handler: uj.CType type=[]openrtb.Protocol kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.CType = nil
+		} else {
+
+			uj.CType = []openrtb.Protocol{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__CType openrtb.Protocol
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__CType type=openrtb.Protocol kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for Protocol", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__CType = openrtb.Protocol(tval)
+
+					}
+				}
+
+				uj.CType = append(uj.CType, tmp_uj__CType)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_W:
+
+	/* handler: uj.W type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.W = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_H:
+
+	/* handler: uj.H type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.H = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Unit:
+
+	/* handler: uj.Unit type=adcom1.SizeUnit kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for SizeUnit", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Unit = SizeUnit(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MinDur:
+
+	/* handler: uj.MinDur type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MinDur = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MaxDur:
+
+	/* handler: uj.MaxDur type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MaxDur = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MaxExt:
+
+	/* handler: uj.MaxExt type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MaxExt = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MinBitR:
+
+	/* handler: uj.MinBitR type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MinBitR = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MaxBitR:
+
+	/* handler: uj.MaxBitR type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MaxBitR = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Delivery:
+
+	/* handler: uj.Delivery type=[]openrtb.ContentDelivery kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Delivery = nil
+		} else {
+
+			uj.Delivery = []openrtb.ContentDelivery{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Delivery openrtb.ContentDelivery
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Delivery type=openrtb.ContentDelivery kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ContentDelivery", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__Delivery = openrtb.ContentDelivery(tval)
+
+					}
+				}
+
+				uj.Delivery = append(uj.Delivery, tmp_uj__Delivery)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MaxSeq:
+
+	/* handler: uj.MaxSeq type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MaxSeq = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Linear:
+
+	/* handler: uj.Linear type=openrtb.VideoLinearity kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for VideoLinearity", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Linear = openrtb.VideoLinearity(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Boxing:
+
+	/* handler: uj.Boxing type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			uj.Boxing = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int(tval)
+			uj.Boxing = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Comp:
+
+	/* handler: uj.Comp type=[]adcom1.Companion kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Comp = nil
+		} else {
+
+			uj.Comp = []Companion{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Comp Companion
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Comp type=adcom1.Companion kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						state = fflib.FFParse_after_value
+						goto mainparse
+					}
+
+					err = tmp_uj__Comp.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+					if err != nil {
+						return err
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				uj.Comp = append(uj.Comp, tmp_uj__Comp)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CompType:
+
+	/* handler: uj.CompType type=[]openrtb.CompanionType kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.CompType = nil
+		} else {
+
+			uj.CompType = []openrtb.CompanionType{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__CompType openrtb.CompanionType
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__CompType type=openrtb.CompanionType kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for CompanionType", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__CompType = openrtb.CompanionType(tval)
+
+					}
+				}
+
+				uj.CompType = append(uj.CompType, tmp_uj__CompType)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Ext:
+
+	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = uj.Ext.UnmarshalJSON(tbuf)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
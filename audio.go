@@ -1,10 +1,12 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
 
 import (
-	"encoding/json"
 	"errors"
+	"sync"
+
+	fflib "github.com/pquerna/ffjson/fflib/v1"
 )
 
 // Validation errors
@@ -14,28 +16,31 @@ var (
 
 // The "audio" object must be included directly in the impression object
 type Audio struct {
-	Mimes         []string  `json:"mimes"`                 // Content MIME types supported.
-	MinDuration   int       `json:"minduration,omitempty"` // Minimum video ad duration in seconds
-	MaxDuration   int       `json:"maxduration,omitempty"` // Maximum video ad duration in seconds
-	Protocols     []int     `json:"protocols,omitempty"`   // Video bid response protocols
-	StartDelay    int       `json:"startdelay,omitempty"`  // Indicates the start delay in seconds
-	Sequence      int       `json:"sequence,omitempty"`    // Default: 1
-	BAttr         []int     `json:"battr,omitempty"`       // Blocked creative attributes
-	MaxExtended   int       `json:"maxextended,omitempty"` // Maximum extended video ad duration
-	MinBitrate    int       `json:"minbitrate,omitempty"`  // Minimum bit rate in Kbps
-	MaxBitrate    int       `json:"maxbitrate,omitempty"`  // Maximum bit rate in Kbps
-	Delivery      []int     `json:"delivery,omitempty"`    // List of supported delivery methods
-	CompanionAd   []Banner  `json:"companionad,omitempty"`
-	API           []int     `json:"api,omitempty"`
-	CompanionType []int     `json:"companiontype,omitempty"`
-	MaxSequence   int       `json:"maxseq,omitempty"`   // The maximumnumber of ads that canbe played in an ad pod.
-	Feed          int       `json:"feed,omitempty"`     // Type of audio feed.
-	Stitched      int       `json:"stitched,omitempty"` // Indicates if the ad is stitched with audio content or delivered independently
-	NVol          int       `json:"nvol,omitempty"`     // Volume normalization mode.
-	Ext           Extension `json:"ext,omitempty"`
+	Mimes         []string            `json:"mimes"`                 // Content MIME types supported.
+	MinDuration   int                 `json:"minduration,omitempty"` // Minimum video ad duration in seconds
+	MaxDuration   int                 `json:"maxduration,omitempty"` // Maximum video ad duration in seconds
+	Protocols     []Protocol          `json:"protocols,omitempty"`   // Video bid response protocols
+	StartDelay    int                 `json:"startdelay,omitempty"`  // Indicates the start delay in seconds
+	Sequence      int                 `json:"sequence,omitempty"`    // Default: 1
+	BAttr         []CreativeAttribute `json:"battr,omitempty"`       // Blocked creative attributes
+	MaxExtended   int                 `json:"maxextended,omitempty"` // Maximum extended video ad duration
+	MinBitrate    int                 `json:"minbitrate,omitempty"`  // Minimum bit rate in Kbps
+	MaxBitrate    int                 `json:"maxbitrate,omitempty"`  // Maximum bit rate in Kbps
+	Delivery      []ContentDelivery   `json:"delivery,omitempty"`    // List of supported delivery methods
+	CompanionAd   []Banner            `json:"companionad,omitempty"`
+	API           []APIFramework      `json:"api,omitempty"`
+	CompanionType []CompanionType     `json:"companiontype,omitempty"`
+	MaxSequence   int                 `json:"maxseq,omitempty"`   // The maximumnumber of ads that canbe played in an ad pod.
+	Feed          FeedType            `json:"feed,omitempty"`     // Type of audio feed.
+	Stitched      int                 `json:"stitched,omitempty"` // Indicates if the ad is stitched with audio content or delivered independently
+	NVol          VolumeNormalization `json:"nvol,omitempty"`     // Volume normalization mode.
+	Ext           Extension           `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 func (au *Audio) Reset() {
+	au.unknown = nil
 	if au.Ext != nil {
 		au.Ext = au.Ext[:0]
 	}
@@ -69,51 +74,82 @@ func (au *Audio) Reset() {
 		au.Protocols = au.Protocols[:0]
 	}
 	au.MinBitrate = 0
+	au.MinDuration = 0
 	au.MaxDuration = 0
 	if au.Mimes != nil {
 		au.Mimes = au.Mimes[:0]
 	}
 }
 
-//var audioPool = sync.Pool{
-//	New: func() interface{} {
-//		return new(Audio)
-//	},
-//}
-//
-//func NewAudio() *Audio{
-//	return audioPool.Get().(*Audio)
-//}
-//
-//func FreeAudio(au *Audio) {
-//	au.Reset()
-//	audioPool.Put(au)
-//}
+var audioPool = sync.Pool{
+	New: func() interface{} {
+		return new(Audio)
+	},
+}
+
+func NewAudio() *Audio {
+	return audioPool.Get().(*Audio)
+}
+
+func FreeAudio(au *Audio) {
+	if au == nil {
+		return
+	}
+	au.Reset()
+	audioPool.Put(au)
+}
 
 type jsonAudio Audio
 
 // Validates the object
 func (a *Audio) Validate() error {
+	return a.ValidateAll().First()
+}
+
+// ValidateAll validates the object and returns all issues found.
+func (a *Audio) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	a.validate(v, "")
+	return v.errs
+}
+
+func (a *Audio) validate(v *Validator, path string) {
 	if len(a.Mimes) == 0 {
-		return ErrInvalidAudioNoMimes
+		v.AddError(PathKey(path, "mimes"), ErrInvalidAudioNoMimes)
+	}
+}
+
+func (a *Audio) normalizeAll(n *normalizer, path string) {
+	if a.Sequence == 0 {
+		a.Sequence = 1
+		n.setDefault(PathKey(path, "sequence"))
 	}
-	return nil
 }
 
 // MarshalJSON custom marshalling with normalization
 func (a *Audio) MarshalJSON() ([]byte, error) {
 	a.normalize()
-	return json.Marshal((*jsonAudio)(a))
+	return (*jsonAudio)(a).MarshalJSON()
+}
+
+// MarshalJSONBuf custom marshalling with normalization
+func (a *Audio) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	a.normalize()
+	return (*jsonAudio)(a).MarshalJSONBuf(buf)
 }
 
 // UnmarshalJSON custom unmarshalling with normalization
 func (a *Audio) UnmarshalJSON(data []byte) error {
-	var h jsonAudio
-	if err := json.Unmarshal(data, &h); err != nil {
+	return a.UnmarshalJSONFFLexer(fflib.NewFFLexer(data), fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer custom unmarshalling with normalization
+func (a *Audio) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	// decode in place, reusing the allocations of recycled objects
+	a.Reset()
+	if err := (*jsonAudio)(a).UnmarshalJSONFFLexer(fs, state); err != nil {
 		return err
 	}
-
-	*a = (Audio)(h)
 	a.normalize()
 	return nil
 }
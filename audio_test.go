@@ -1,6 +1,8 @@
 package openrtb
 
 import (
+	"encoding/json"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -20,38 +22,48 @@ var _ = Describe("Audio", func() {
 			},
 			MinDuration: 5,
 			MaxDuration: 30,
-			Protocols:   []int{AudioProtocolDAAST1, AudioProtocolDAAST1Wrapper},
+			Protocols:   []Protocol{AudioProtocolDAAST1, AudioProtocolDAAST1Wrapper},
 			Sequence:    1,
-			BAttr:       []int{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert},
+			BAttr:       []CreativeAttribute{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert},
 			MaxExtended: 30,
 			MinBitrate:  300,
 			MaxBitrate:  1500,
-			Delivery:    []int{ContentDeliveryProgressive},
+			Delivery:    []ContentDelivery{ContentDeliveryProgressive},
 			CompanionAd: []Banner{
-				{W: 300, H: 250, ID: "1234567893-1", Pos: AdPosAboveFold, BAttr: []int{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert}, ExpDir: []int{ExpDirRight, ExpDirDown}},
-				{W: 728, H: 90, ID: "1234567893-2", Pos: AdPosAboveFold, BAttr: []int{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert}},
+				{W: 300, H: 250, ID: "1234567893-1", Pos: AdPosAboveFold, BAttr: []CreativeAttribute{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert}, ExpDir: []ExpandableDirection{ExpDirRight, ExpDirDown}},
+				{W: 728, H: 90, ID: "1234567893-2", Pos: AdPosAboveFold, BAttr: []CreativeAttribute{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert}},
 			},
-			API:           []int{APIFrameworkVPAID1, APIFrameworkVPAID2},
-			CompanionType: []int{VASTCompanionStatic, VASTCompanionHTML},
+			API:           []APIFramework{APIFrameworkVPAID1, APIFrameworkVPAID2},
+			CompanionType: []CompanionType{VASTCompanionStatic, VASTCompanionHTML},
 		}))
 	})
 
+	It("should reset to a blank state", func() {
+		subject.Reset()
+		Expect(subject.Mimes).To(BeEmpty())
+		Expect(subject.Validate()).To(Equal(ErrInvalidAudioNoMimes))
+
+		Expect(json.Unmarshal([]byte(`{}`), subject)).To(Succeed())
+		Expect(subject.Mimes).To(BeNil())
+		Expect(json.Marshal(subject)).To(MatchJSON(`{"mimes":null,"sequence":1}`))
+	})
+
 	It("should validate", func() {
 		Expect((&Audio{
 			MinDuration: 5,
 			MaxDuration: 30,
-			Protocols:   []int{AudioProtocolDAAST1, AudioProtocolDAAST1Wrapper},
+			Protocols:   []Protocol{AudioProtocolDAAST1, AudioProtocolDAAST1Wrapper},
 			Sequence:    1,
-			BAttr:       []int{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert},
+			BAttr:       []CreativeAttribute{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert},
 			MaxExtended: 30,
 			MinBitrate:  300,
 			MaxBitrate:  1500,
-			Delivery:    []int{ContentDeliveryProgressive},
+			Delivery:    []ContentDelivery{ContentDeliveryProgressive},
 			CompanionAd: []Banner{
-				{W: 300, H: 250, ID: "1234567893-1", Pos: AdPosAboveFold, BAttr: []int{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert}, ExpDir: []int{ExpDirRight, ExpDirDown}},
-				{W: 728, H: 90, ID: "1234567893-2", Pos: AdPosAboveFold, BAttr: []int{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert}},
+				{W: 300, H: 250, ID: "1234567893-1", Pos: AdPosAboveFold, BAttr: []CreativeAttribute{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert}, ExpDir: []ExpandableDirection{ExpDirRight, ExpDirDown}},
+				{W: 728, H: 90, ID: "1234567893-2", Pos: AdPosAboveFold, BAttr: []CreativeAttribute{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert}},
 			},
-			CompanionType: []int{VASTCompanionStatic, VASTCompanionHTML},
+			CompanionType: []CompanionType{VASTCompanionStatic, VASTCompanionHTML},
 		}).Validate()).To(Equal(ErrInvalidAudioNoMimes))
 	})
 
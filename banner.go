@@ -1,6 +1,21 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
+
+import (
+	"errors"
+	"sync"
+)
+
+// Validation errors
+var (
+	ErrInvalidBannerSize   = errors.New("openrtb: banner width and height must be set together")
+	ErrInvalidBannerNoSize = errors.New("openrtb: banner has neither size nor formats")
+	ErrInvalidBannerFormat = errors.New("openrtb: banner format size missing")
+	ErrInvalidBannerType   = errors.New("openrtb: banner type invalid")
+	ErrInvalidBannerAttr   = errors.New("openrtb: banner creative attribute invalid")
+	ErrInvalidBannerPos    = errors.New("openrtb: banner position invalid")
+)
 
 // The "banner" object must be included directly in the impression object if the impression offered
 // for auction is display or rich media, or it may be optionally embedded in the video object to
@@ -9,25 +24,28 @@ package openrtb
 // VAST response to dictate placement of the companion creatives when multiple companion ad
 // opportunities of the same size are available on a page.
 type Banner struct {
-	W        int       `json:"w,omitempty"`        // Width
-	H        int       `json:"h,omitempty"`        // Height
-	Format   []Format  `json:"format,omitempty"`   //Array of format objects representing the banner sizes permitted.
-	WMax     int       `json:"wmax,omitempty"`     // Width maximum DEPRECATED
-	HMax     int       `json:"hmax,omitempty"`     // Height maximum DEPRECATED
-	WMin     int       `json:"wmin,omitempty"`     // Width minimum DEPRECATED
-	HMin     int       `json:"hmin,omitempty"`     // Height minimum DEPRECATED
-	ID       string    `json:"id,omitempty"`       // A unique identifier
-	BType    []int     `json:"btype,omitempty"`    // Blocked creative types
-	BAttr    []int     `json:"battr,omitempty"`    // Blocked creative attributes
-	Pos      int       `json:"pos,omitempty"`      // Ad Position
-	Mimes    []string  `json:"mimes,omitempty"`    // Whitelist of content MIME types supported
-	TopFrame int       `json:"topframe,omitempty"` // Default: 0 ("1": Delivered in top frame, "0": Elsewhere)
-	ExpDir   []int     `json:"expdir,omitempty"`   // Specify properties for an expandable ad
-	Api      []int     `json:"api,omitempty"`      // List of supported API frameworks
-	Ext      Extension `json:"ext,omitempty"`
+	W        int                   `json:"w,omitempty"`        // Width
+	H        int                   `json:"h,omitempty"`        // Height
+	Format   []Format              `json:"format,omitempty"`   //Array of format objects representing the banner sizes permitted.
+	WMax     int                   `json:"wmax,omitempty"`     // Width maximum DEPRECATED
+	HMax     int                   `json:"hmax,omitempty"`     // Height maximum DEPRECATED
+	WMin     int                   `json:"wmin,omitempty"`     // Width minimum DEPRECATED
+	HMin     int                   `json:"hmin,omitempty"`     // Height minimum DEPRECATED
+	ID       string                `json:"id,omitempty"`       // A unique identifier
+	BType    []BannerType          `json:"btype,omitempty"`    // Blocked creative types
+	BAttr    []CreativeAttribute   `json:"battr,omitempty"`    // Blocked creative attributes
+	Pos      AdPosition            `json:"pos,omitempty"`      // Ad Position
+	Mimes    []string              `json:"mimes,omitempty"`    // Whitelist of content MIME types supported
+	TopFrame int                   `json:"topframe,omitempty"` // Default: 0 ("1": Delivered in top frame, "0": Elsewhere)
+	ExpDir   []ExpandableDirection `json:"expdir,omitempty"`   // Specify properties for an expandable ad
+	Api      []APIFramework        `json:"api,omitempty"`      // List of supported API frameworks
+	Ext      Extension             `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 func (bn *Banner) Reset() {
+	bn.unknown = nil
 	bn.W = 0
 	bn.H = 0
 	if bn.Format != nil {
@@ -63,17 +81,128 @@ func (bn *Banner) Reset() {
 	}
 }
 
-//var bannerPool = sync.Pool{
-//	New: func() interface{} {
-//		return new(Banner)
-//	},
-//}
-//
-//func NewBanner() *Banner{
-//	return bannerPool.Get().(*Banner)
-//}
-//
-//func FreeBanner(bn *Banner) {
-//	bn.Reset()
-//	bannerPool.Put(bn)
-//}
+var bannerPool = sync.Pool{
+	New: func() interface{} {
+		return new(Banner)
+	},
+}
+
+func NewBanner() *Banner {
+	return bannerPool.Get().(*Banner)
+}
+
+func FreeBanner(bn *Banner) {
+	if bn == nil {
+		return
+	}
+	bn.Reset()
+	bannerPool.Put(bn)
+}
+
+// Validates the object
+func (bn *Banner) Validate() error {
+	return bn.ValidateAll().First()
+}
+
+// ValidateAll validates the object and returns all issues found.
+func (bn *Banner) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	bn.validate(v, "")
+	return v.errs
+}
+
+func (bn *Banner) validate(v *Validator, path string) {
+	if bn.W < 0 || bn.H < 0 || (bn.W == 0) != (bn.H == 0) {
+		v.AddError(PathKey(path, "w"), ErrInvalidBannerSize)
+	} else if bn.W == 0 && len(bn.Format) == 0 {
+		v.AddWarning(PathKey(path, "w"), ErrInvalidBannerNoSize)
+	}
+	v.since(Version2_4, len(bn.Format) != 0, PathKey(path, "format"))
+	v.deprecated(Version2_4, bn.WMax != 0, PathKey(path, "wmax"))
+	v.deprecated(Version2_4, bn.HMax != 0, PathKey(path, "hmax"))
+	v.deprecated(Version2_4, bn.WMin != 0, PathKey(path, "wmin"))
+	v.deprecated(Version2_4, bn.HMin != 0, PathKey(path, "hmin"))
+	for i, f := range bn.Format {
+		if f.W <= 0 || f.H <= 0 {
+			v.AddError(PathIndex(PathKey(path, "format"), i), ErrInvalidBannerFormat)
+		}
+	}
+	for i, t := range bn.BType {
+		if !t.IsValid() {
+			v.AddError(PathIndex(PathKey(path, "btype"), i), ErrInvalidBannerType)
+		}
+	}
+	for i, a := range bn.BAttr {
+		if !a.IsValid() {
+			v.AddError(PathIndex(PathKey(path, "battr"), i), ErrInvalidBannerAttr)
+		}
+	}
+	if !bn.Pos.IsValid() {
+		v.AddError(PathKey(path, "pos"), ErrInvalidBannerPos)
+	}
+}
+
+func (bn *Banner) normalizeAll(n *normalizer, path string) {
+	if len(bn.Format) != 0 {
+		return
+	}
+
+	// exact sizes first, ranges are approximated by their bounds
+	if bn.W != 0 && bn.H != 0 {
+		bn.Format = append(bn.Format, Format{W: bn.W, H: bn.H})
+	}
+	if bn.hasRange() {
+		for _, f := range []Format{{W: bn.WMax, H: bn.HMax}, {W: bn.WMin, H: bn.HMin}} {
+			// open bounds are closed by the exact size
+			if f.W == 0 {
+				f.W = bn.W
+			}
+			if f.H == 0 {
+				f.H = bn.H
+			}
+			if f.W != 0 && f.H != 0 && bn.inRange(f.W, f.H) && !bn.offersFormat(f.W, f.H) {
+				bn.Format = append(bn.Format, f)
+			}
+		}
+	}
+	if len(bn.Format) != 0 {
+		bn.W, bn.H = 0, 0
+		bn.WMax, bn.HMax = 0, 0
+		bn.WMin, bn.HMin = 0, 0
+		n.migrate(PathKey(path, "format"))
+	}
+}
+
+// offersSize reports whether a creative of the given size is acceptable, i.e.
+// matches the exact size or one of the formats, or lies within the size
+// ranges. Returns true if the banner does not specify any sizes.
+func (bn *Banner) offersSize(w, h int) bool {
+	if bn.W == 0 && bn.H == 0 && len(bn.Format) == 0 && !bn.hasRange() {
+		return true
+	}
+	if bn.W == w && bn.H == h {
+		return true
+	}
+	return bn.offersFormat(w, h) || (bn.hasRange() && bn.inRange(w, h))
+}
+
+// hasRange reports whether any of the deprecated size bounds is set.
+func (bn *Banner) hasRange() bool {
+	return bn.WMin != 0 || bn.WMax != 0 || bn.HMin != 0 || bn.HMax != 0
+}
+
+// inRange reports whether the given size lies within the size ranges. Unset
+// bounds are open.
+func (bn *Banner) inRange(w, h int) bool {
+	return (bn.WMin == 0 || w >= bn.WMin) && (bn.WMax == 0 || w <= bn.WMax) &&
+		(bn.HMin == 0 || h >= bn.HMin) && (bn.HMax == 0 || h <= bn.HMax)
+}
+
+func (bn *Banner) offersFormat(w, h int) bool {
+	for _, f := range bn.Format {
+		if f.W == w && f.H == h {
+			return true
+		}
+	}
+	return false
+}
@@ -18,10 +18,50 @@ var _ = Describe("Banner", func() {
 			W:     728,
 			H:     90,
 			Pos:   AdPosAboveFold,
-			BType: []int{BannerTypeFrame},
-			BAttr: []int{CreativeAttributeWindowsDialogOrAlert},
-			Api:   []int{APIFrameworkMRAID1},
+			BType: []BannerType{BannerTypeFrame},
+			BAttr: []CreativeAttribute{CreativeAttributeWindowsDialogOrAlert},
+			Api:   []APIFramework{APIFrameworkMRAID1},
 		}))
 	})
 
+	It("should validate", func() {
+		Expect(subject.Validate()).NotTo(HaveOccurred())
+		Expect((&Banner{W: 300}).Validate()).To(Equal(ErrInvalidBannerSize))
+		Expect((&Banner{Format: []Format{{W: 300}}}).Validate()).To(Equal(ErrInvalidBannerFormat))
+		Expect((&Banner{W: 300, H: 250, BType: []BannerType{5}}).Validate()).To(Equal(ErrInvalidBannerType))
+		Expect((&Banner{W: 300, H: 250, BAttr: []CreativeAttribute{0}}).Validate()).To(Equal(ErrInvalidBannerAttr))
+		Expect((&Banner{W: 300, H: 250, Pos: 8}).Validate()).To(Equal(ErrInvalidBannerPos))
+
+		errs := (&Banner{}).ValidateAll()
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Severity).To(Equal(SeverityWarning))
+		Expect(errs[0].Err).To(Equal(ErrInvalidBannerNoSize))
+		Expect((&Banner{}).Validate()).NotTo(HaveOccurred())
+	})
+
+	It("should offer sizes within ranges", func() {
+		bn := &Banner{W: 300, H: 250, WMin: 320, WMax: 728, HMin: 50, HMax: 90}
+		Expect(bn.offersSize(300, 250)).To(BeTrue())
+		Expect(bn.offersSize(320, 50)).To(BeTrue())
+		Expect(bn.offersSize(468, 60)).To(BeTrue())
+		Expect(bn.offersSize(970, 90)).To(BeFalse())
+		Expect(bn.offersSize(300, 600)).To(BeFalse())
+
+		bn = &Banner{WMax: 728}
+		Expect(bn.offersSize(728, 600)).To(BeTrue())
+		Expect(bn.offersSize(970, 90)).To(BeFalse())
+		Expect((&Banner{}).offersSize(970, 90)).To(BeTrue())
+	})
+
+	It("should approximate ranges by formats", func() {
+		bn := &Banner{W: 300, H: 250, WMax: 728}
+		n := new(normalizer)
+		bn.normalizeAll(n, "")
+		Expect(bn).To(Equal(&Banner{Format: []Format{{W: 300, H: 250}, {W: 728, H: 250}}}))
+
+		bn = &Banner{W: 300, H: 250, WMin: 320, HMin: 50}
+		bn.normalizeAll(n, "")
+		Expect(bn.Format).To(Equal([]Format{{W: 300, H: 250}, {W: 320, H: 50}}))
+	})
+
 })
@@ -22,6 +22,23 @@ func BenchmarkBidRequest_Unmarshal(b *testing.B) {
 	}
 }
 
+func BenchmarkBidRequest_UnmarshalPooled(b *testing.B) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "breq.video.json"))
+	if err != nil {
+		b.Fatal(err.Error())
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		req, err := ParseBidRequest(data)
+		if err != nil {
+			b.Fatal(err.Error())
+		}
+		FreeBidRequest(req)
+	}
+}
+
 func BenchmarkBidRequest_Marshal(b *testing.B) {
 	data, err := ioutil.ReadFile(filepath.Join("testdata", "breq.video.json"))
 	if err != nil {
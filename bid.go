@@ -1,13 +1,29 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
 
 // Validation errors
 var (
 	ErrInvalidBidNoID    = errors.New("openrtb: bid is missing ID")
 	ErrInvalidBidNoImpID = errors.New("openrtb: bid is missing impression ID")
+
+	ErrInvalidBidUnknownImp  = errors.New("openrtb: bid references unknown impression")
+	ErrInvalidBidBelowFloor  = errors.New("openrtb: bid price below floor")
+	ErrInvalidBidBlockedAdv  = errors.New("openrtb: bid advertiser domain blocked")
+	ErrInvalidBidBlockedCat  = errors.New("openrtb: bid category blocked")
+	ErrInvalidBidBlockedAttr = errors.New("openrtb: bid creative attribute blocked")
+	ErrInvalidBidSize        = errors.New("openrtb: bid size not offered")
+	ErrInvalidBidUnknownDeal = errors.New("openrtb: bid references unknown deal")
+	ErrInvalidBidNoDealID    = errors.New("openrtb: bid on private auction is missing deal ID")
+	ErrInvalidBidMType       = errors.New("openrtb: bid markup type invalid")
+	ErrInvalidBidDuration    = errors.New("openrtb: bid duration not accepted")
+	ErrInvalidBidFormat      = errors.New("openrtb: bid markup type not offered by impression")
 )
 
 // ID, ImpID and Price are required; all other optional.
@@ -18,36 +34,45 @@ var (
 // Cid can be used to block ads that were previously identified as inappropriate.
 // Substitution macros may allow a bidder to use a static notice URL for all of its bids.
 type Bid struct {
-	ID             string         `json:"id"`
-	ImpID          string         `json:"impid"`                    // Required string ID of the impression object to which this bid applies.
-	Price          float64        `json:"price"`                    // Bid price in CPM. Suggests using integer math for accounting to avoid rounding errors.
-	AdID           string         `json:"adid,omitempty"`           // References the ad to be served if the bid wins.
-	NURL           string         `json:"nurl,omitempty"`           // Win notice URL.
-	BURL           string         `json:"burl,omitempty"`           // Billing notice URL.
-	LURL           string         `json:"lurl,omitempty"`           // Loss notice URL.
-	AdMarkup       string         `json:"adm,omitempty"`            // Actual ad markup. XHTML if a response to a banner object, or VAST XML if a response to a video object.
-	AdvDomain      []string       `json:"adomain,omitempty"`        // Advertiser’s primary or top-level domain for advertiser checking; or multiple if imp rotating.
-	Bundle         string         `json:"bundle,omitempty"`         // A platform-specific application identifier intended to be unique to the app and independent of the exchange.
-	IURL           string         `json:"iurl,omitempty"`           // Sample image URL.
-	CampaignID     StringOrNumber `json:"cid,omitempty"`            // Campaign ID that appears with the Ad markup.
-	CreativeID     string         `json:"crid,omitempty"`           // Creative ID for reporting content issues or defects. This could also be used as a reference to a creative ID that is posted with an exchange.
-	Tactic         string         `json:"tactic,omitempty"`         // Tactic ID to enable buyers to label bids for reporting to the exchange the tactic through which their bid was submitted.
-	Cat            []string       `json:"cat,omitempty"`            // IAB content categories of the creative. Refer to List 5.1
-	Attr           []int          `json:"attr,omitempty"`           // Array of creative attributes.
-	API            int            `json:"api,omitempty"`            // API required by the markup if applicable
-	Protocol       int            `json:"protocol,omitempty"`       // Video response protocol of the markup if applicable
-	QAGMediaRating int            `json:"qagmediarating,omitempty"` // Creative media rating per IQG guidelines.
-	Language       string         `json:"language,omitempty"`       // Language of the creative using ISO-639-1-alpha-2.
-	DealID         string         `json:"dealid,omitempty"`         // DealID extension of private marketplace deals
-	H              int            `json:"h,omitempty"`              // Height of the ad in pixels.
-	W              int            `json:"w,omitempty"`              // Width of the ad in pixels.
-	WRatio         int            `json:"wratio,omitempty"`         // Relative width of the creative when expressing size as a ratio.
-	HRatio         int            `json:"hratio,omitempty"`         // Relative height of the creative when expressing size as a ratio.
-	Exp            int            `json:"exp,omitempty"`            // Advisory as to the number of seconds the bidder is willing to wait between the auction and the actual impression.
-	Ext            Extension      `json:"ext,omitempty"`
+	ID             string              `json:"id"`
+	ImpID          string              `json:"impid"`                    // Required string ID of the impression object to which this bid applies.
+	Price          float64             `json:"price"`                    // Bid price in CPM. Suggests using integer math for accounting to avoid rounding errors.
+	AdID           string              `json:"adid,omitempty"`           // References the ad to be served if the bid wins.
+	NURL           string              `json:"nurl,omitempty"`           // Win notice URL.
+	BURL           string              `json:"burl,omitempty"`           // Billing notice URL.
+	LURL           string              `json:"lurl,omitempty"`           // Loss notice URL.
+	AdMarkup       string              `json:"adm,omitempty"`            // Actual ad markup. XHTML if a response to a banner object, or VAST XML if a response to a video object.
+	AdvDomain      []string            `json:"adomain,omitempty"`        // Advertiser’s primary or top-level domain for advertiser checking; or multiple if imp rotating.
+	Bundle         string              `json:"bundle,omitempty"`         // A platform-specific application identifier intended to be unique to the app and independent of the exchange.
+	IURL           string              `json:"iurl,omitempty"`           // Sample image URL.
+	CampaignID     StringOrNumber      `json:"cid,omitempty"`            // Campaign ID that appears with the Ad markup.
+	CreativeID     string              `json:"crid,omitempty"`           // Creative ID for reporting content issues or defects. This could also be used as a reference to a creative ID that is posted with an exchange.
+	Tactic         string              `json:"tactic,omitempty"`         // Tactic ID to enable buyers to label bids for reporting to the exchange the tactic through which their bid was submitted.
+	Cat            []string            `json:"cat,omitempty"`            // IAB content categories of the creative. Refer to List 5.1
+	Attr           []CreativeAttribute `json:"attr,omitempty"`           // Array of creative attributes.
+	API            APIFramework        `json:"api,omitempty"`            // API required by the markup if applicable
+	Protocol       Protocol            `json:"protocol,omitempty"`       // Video response protocol of the markup if applicable
+	QAGMediaRating QAGMediaRating      `json:"qagmediarating,omitempty"` // Creative media rating per IQG guidelines.
+	Language       string              `json:"language,omitempty"`       // Language of the creative using ISO-639-1-alpha-2.
+	DealID         string              `json:"dealid,omitempty"`         // DealID extension of private marketplace deals
+	H              int                 `json:"h,omitempty"`              // Height of the ad in pixels.
+	W              int                 `json:"w,omitempty"`              // Width of the ad in pixels.
+	WRatio         int                 `json:"wratio,omitempty"`         // Relative width of the creative when expressing size as a ratio.
+	HRatio         int                 `json:"hratio,omitempty"`         // Relative height of the creative when expressing size as a ratio.
+	Exp            int                 `json:"exp,omitempty"`            // Advisory as to the number of seconds the bidder is willing to wait between the auction and the actual impression.
+	Dur            int                 `json:"dur,omitempty"`            // Duration of the video or audio creative in seconds (Spec 2.6)
+	SlotInPod      SlotPosition        `json:"slotinpod,omitempty"`      // Position of the bid within the pod which the bid is intended for (Spec 2.6)
+	MType          MarkupType          `json:"mtype,omitempty"`          // Type of the creative markup, where 1 = banner, 2 = video, 3 = audio, 4 = native (Spec 2.6)
+	APIs           []APIFramework      `json:"apis,omitempty"`           // List of APIs required by the markup; replaces api (Spec 2.6)
+	Ext            Extension           `json:"ext,omitempty"`
+
+	unknown   []byte
+	parsedExt extCache
 }
 
 func (b *Bid) Reset() {
+	b.unknown = nil
+	b.parsedExt = extCache{}
 	if b.Ext != nil {
 		b.Ext = b.Ext[:0]
 	}
@@ -84,15 +109,247 @@ func (b *Bid) Reset() {
 	b.Price = 0.0
 	b.ImpID = ""
 	b.ID = ""
+	b.Dur = 0
+	b.SlotInPod = 0
+	b.MType = 0
+	if b.APIs != nil {
+		b.APIs = b.APIs[:0]
+	}
+}
+
+// ParsedExt returns the extension of the bid, decoded into the type registered
+// for ExtensionKindBid. The result is cached until Ext changes and is shared
+// between calls, change Ext to modify it. Safe for concurrent use, as long as
+// Ext is not modified at the same time.
+func (bid *Bid) ParsedExt() (interface{}, error) {
+	return bid.parsedExt.decode(ExtensionKindBid, bid.Ext)
 }
 
 // Validate required attributes
 func (bid *Bid) Validate() error {
+	return bid.ValidateAll().First()
+}
+
+// ValidateAll validates the bid and returns all issues found.
+func (bid *Bid) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	bid.validate(v, "")
+	return v.errs
+}
+
+func (bid *Bid) validate(v *Validator, path string) {
 	if bid.ID == "" {
-		return ErrInvalidBidNoID
-	} else if bid.ImpID == "" {
-		return ErrInvalidBidNoImpID
+		v.AddError(PathKey(path, "id"), ErrInvalidBidNoID)
+	}
+	if bid.ImpID == "" {
+		v.AddError(PathKey(path, "impid"), ErrInvalidBidNoImpID)
+	}
+	if bid.MType != 0 && !bid.MType.IsValid() {
+		v.AddError(PathKey(path, "mtype"), ErrInvalidBidMType)
+	}
+	v.since(Version2_5, bid.BURL != "", PathKey(path, "burl"))
+	v.since(Version2_5, bid.LURL != "", PathKey(path, "lurl"))
+	v.deprecated(Version2_6, bid.API != 0, PathKey(path, "api"))
+	v.since(Version2_6, bid.Dur != 0, PathKey(path, "dur"))
+	v.since(Version2_6, bid.SlotInPod != 0, PathKey(path, "slotinpod"))
+	v.since(Version2_6, bid.MType != 0, PathKey(path, "mtype"))
+	v.since(Version2_6, len(bid.APIs) != 0, PathKey(path, "apis"))
+}
+
+// validateAgainst checks the bid against the request it answers. The imp is the
+// impression referenced by the bid or nil if there is none, cur is the response currency.
+func (bid *Bid) validateAgainst(v *Validator, path string, req *BidRequest, imp *Impression, cur string) {
+	bid.validate(v, path)
+
+	for i, d := range bid.AdvDomain {
+		if domainBlocked(req.BAdv, d) {
+			v.AddError(PathIndex(PathKey(path, "adomain"), i), ErrInvalidBidBlockedAdv)
+		}
+	}
+	for i, c := range bid.Cat {
+		if categoryBlocked(req.Bcat, c) {
+			v.AddError(PathIndex(PathKey(path, "cat"), i), ErrInvalidBidBlockedCat)
+		}
+	}
+
+	if imp == nil {
+		if bid.ImpID != "" {
+			v.AddError(PathKey(path, "impid"), ErrInvalidBidUnknownImp)
+		}
+		return
+	}
+
+	mtype := bid.Format(imp)
+	if mtype != 0 && !imp.Offers(mtype) {
+		v.AddError(PathKey(path, "mtype"), ErrInvalidBidFormat)
+	}
+
+	battr := imp.bAttr(mtype)
+	for i, a := range bid.Attr {
+		if containsCreativeAttribute(battr, a) {
+			v.AddError(PathIndex(PathKey(path, "attr"), i), ErrInvalidBidBlockedAttr)
+		}
+	}
+
+	if mtype != 0 {
+		if key, err := bid.misfit(imp, mtype); err != nil {
+			v.AddError(PathKey(path, key), err)
+		}
+	} else if !bid.fitsAny(imp) {
+		// the format is unknown, a bid which fits none of the offered
+		// formats is rejected for all of them
+		for _, mt := range markupTypes {
+			if !imp.Offers(mt) {
+				continue
+			}
+			if key, err := bid.misfit(imp, mt); err != nil {
+				v.AddError(PathKey(path, key), err)
+			}
+		}
+	}
+
+	pmp := imp.Pmp
+	if pmp == nil {
+		pmp = req.Pmp
+	}
+
+	floor, floorCur := imp.BidFloor, imp.BidFloorCurrency
+	if bid.DealID != "" {
+		if deal := pmp.deal(bid.DealID); deal == nil {
+			v.AddError(PathKey(path, "dealid"), ErrInvalidBidUnknownDeal)
+		} else {
+			floor = deal.BidFloor
+			if deal.BidFloorCurrency != "" {
+				floorCur = deal.BidFloorCurrency
+			}
+		}
+	} else if pmp != nil && pmp.Private == 1 {
+		v.AddError(PathKey(path, "dealid"), ErrInvalidBidNoDealID)
 	}
 
+	// floors can only be compared if they are expressed in the response currency
+	if floorCur == "" {
+		floorCur = defaultCurrency
+	}
+	if floor > 0 && strings.EqualFold(floorCur, cur) && bid.Price < floor {
+		v.AddError(PathKey(path, "price"), ErrInvalidBidBelowFloor)
+	}
+}
+
+// misfit checks the bid against the constraints of the offered format of the
+// given markup type. Returns the offending field and error, if any.
+func (bid *Bid) misfit(imp *Impression, mtype MarkupType) (string, error) {
+	switch mtype {
+	case MarkupBanner:
+		if imp.Banner != nil && (bid.W != 0 || bid.H != 0) && !imp.Banner.offersSize(bid.W, bid.H) {
+			return "w", ErrInvalidBidSize
+		}
+	case MarkupVideo:
+		if imp.Video != nil && bid.Dur != 0 && !imp.Video.acceptsDuration(bid.Dur) {
+			return "dur", ErrInvalidBidDuration
+		}
+	}
+	return "", nil
+}
+
+// fitsAny reports whether the bid fits at least one of the offered formats.
+func (bid *Bid) fitsAny(imp *Impression) bool {
+	for _, mt := range markupTypes {
+		if imp.Offers(mt) {
+			if _, err := bid.misfit(imp, mt); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Format determines the markup type the bid targets among the formats offered
+// by the impression. An explicit mtype is returned as is, otherwise the type is
+// inferred from the single offered format, the markup itself and finally the
+// bid size and protocol. Returns 0 if the format cannot be determined.
+func (bid *Bid) Format(imp *Impression) MarkupType {
+	if bid.MType != 0 {
+		return bid.MType
+	}
+
+	switch imp.numFormats() {
+	case 0:
+		return 0
+	case 1:
+		for _, mt := range markupTypes {
+			if imp.Offers(mt) {
+				return mt
+			}
+		}
+	}
+
+	if mtype := sniffMarkup(bid.AdMarkup); mtype != 0 {
+		// VAST markup is used for both, video and audio
+		if mtype == MarkupVideo && imp.Video == nil && imp.Audio != nil {
+			mtype = MarkupAudio
+		}
+		if imp.Offers(mtype) {
+			return mtype
+		}
+	}
+
+	if bid.Protocol != 0 {
+		if imp.Video != nil && containsProtocol(imp.Video.Protocols, bid.Protocol) {
+			return MarkupVideo
+		}
+		if imp.Audio != nil && containsProtocol(imp.Audio.Protocols, bid.Protocol) {
+			return MarkupAudio
+		}
+	}
+	if imp.Banner != nil && (bid.W != 0 || bid.H != 0) && imp.Banner.offersSize(bid.W, bid.H) {
+		return MarkupBanner
+	}
+	return 0
+}
+
+// sniffMarkup guesses the markup type from the ad markup.
+func sniffMarkup(adm string) MarkupType {
+	adm = strings.TrimSpace(adm)
+	switch {
+	case adm == "":
+		return 0
+	case adm[0] == '{' || adm[0] == '"':
+		return MarkupNative
+	case strings.HasPrefix(adm, "<VAST") || (strings.HasPrefix(adm, "<?xml") && strings.Contains(adm, "<VAST")):
+		return MarkupVideo
+	case adm[0] == '<':
+		return MarkupBanner
+	}
+	return 0
+}
+
+// DecodeNativeMarkup decodes the native ad markup into v, typically a
+// *response.Response of the native/response package. Markup may be an object,
+// an escaped JSON string and may be wrapped in a "native" object, as required
+// by Native 1.0.
+func (bid *Bid) DecodeNativeMarkup(v interface{}) error {
+	data := []byte(strings.TrimSpace(bid.AdMarkup))
+	if len(data) != 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+	if len(data) == 0 {
+		return ErrNativeNoMarkup
+	}
+	return decodeNative(data, v)
+}
+
+// EncodeNativeMarkup encodes v, typically a *response.Response of the
+// native/response package, and stores it as the ad markup.
+func (bid *Bid) EncodeNativeMarkup(v interface{}) error {
+	enc, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	bid.AdMarkup = string(enc)
 	return nil
 }
@@ -1,6 +1,8 @@
 package openrtb
 
 import (
+	"testing"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -26,7 +28,7 @@ var _ = Describe("Bid", func() {
 			CampaignID: "52a5516d29e435137c6f6e74",
 			CreativeID: "52a5516d29e435137c6f6e74_1386565997",
 			DealID:     "example_deal",
-			Attr:       []int{},
+			Attr:       []CreativeAttribute{},
 		}))
 	})
 
@@ -34,6 +36,106 @@ var _ = Describe("Bid", func() {
 		Expect((&Bid{}).Validate()).To(Equal(ErrInvalidBidNoID))
 		Expect((&Bid{ID: "BIDID"}).Validate()).To(Equal(ErrInvalidBidNoImpID))
 		Expect(subject.Validate()).NotTo(HaveOccurred())
+		Expect((&Bid{ID: "BIDID", ImpID: "IMPID", MType: 5}).Validate()).To(Equal(ErrInvalidBidMType))
+	})
+
+	It("should decode native markup", func() {
+		type nativeResponse struct {
+			Ver  string `json:"ver"`
+			Link struct {
+				URL string `json:"url"`
+			} `json:"link"`
+		}
+		for _, adm := range []string{
+			`{"ver":"1.1","link":{"url":"http://x"}}`,
+			`{"native":{"ver":"1.1","link":{"url":"http://x"}}}`,
+			`"{\"ver\":\"1.1\",\"link\":{\"url\":\"http://x\"}}"`,
+		} {
+			var nres nativeResponse
+			Expect((&Bid{AdMarkup: adm}).DecodeNativeMarkup(&nres)).To(Succeed(), adm)
+			Expect(nres.Ver).To(Equal("1.1"), adm)
+			Expect(nres.Link.URL).To(Equal("http://x"), adm)
+		}
+
+		var nres nativeResponse
+		Expect((&Bid{}).DecodeNativeMarkup(&nres)).To(Equal(ErrNativeNoMarkup))
+		Expect(subject.DecodeNativeMarkup(&nres)).To(HaveOccurred())
+	})
+
+	It("should encode native markup", func() {
+		Expect(subject.EncodeNativeMarkup(map[string]string{"ver": "1.1"})).To(Succeed())
+		Expect(subject.AdMarkup).To(Equal(`{"ver":"1.1"}`))
+	})
+
+	It("should validate duration against the request", func() {
+		req := &BidRequest{ID: "A", Imp: []Impression{{ID: "1", Video: &Video{RqdDurs: []int{15, 30}}}}}
+		res := &BidResponse{ID: "A", SeatBid: []SeatBid{{Bid: []Bid{
+			{ID: "1", ImpID: "1", Dur: 30, MType: MarkupVideo},
+			{ID: "2", ImpID: "1", Dur: 20, MType: MarkupVideo},
+		}}}}
+		rejs := res.ValidateAgainst(req)
+		Expect(rejs).To(HaveLen(1))
+		Expect(rejs[0].BidID).To(Equal("2"))
+		Expect(rejs[0].Reasons.First()).To(Equal(ErrInvalidBidDuration))
+	})
+
+	It("should determine the targeted format", func() {
+		imp := &Impression{
+			Banner: &Banner{W: 300, H: 250},
+			Video:  &Video{Protocols: []Protocol{VideoProtoVAST3}},
+			Native: &Native{Request: Extension(`"{}"`)},
+		}
+		Expect((&Bid{MType: MarkupAudio}).Format(imp)).To(Equal(MarkupAudio))
+		Expect((&Bid{AdMarkup: `<VAST version="3.0"></VAST>`}).Format(imp)).To(Equal(MarkupVideo))
+		Expect((&Bid{AdMarkup: `<?xml version="1.0"?><VAST version="3.0"></VAST>`}).Format(imp)).To(Equal(MarkupVideo))
+		Expect((&Bid{AdMarkup: ` {"assets":[]}`}).Format(imp)).To(Equal(MarkupNative))
+		Expect((&Bid{AdMarkup: `<div>ad</div>`}).Format(imp)).To(Equal(MarkupBanner))
+		Expect((&Bid{Protocol: VideoProtoVAST3}).Format(imp)).To(Equal(MarkupVideo))
+		Expect((&Bid{W: 300, H: 250}).Format(imp)).To(Equal(MarkupBanner))
+		Expect((&Bid{W: 728, H: 90}).Format(imp)).To(BeZero())
+		Expect((&Bid{}).Format(imp)).To(BeZero())
+
+		Expect((&Bid{}).Format(&Impression{Video: &Video{}})).To(Equal(MarkupVideo))
+		Expect((&Bid{AdMarkup: `<VAST version="3.0"></VAST>`}).Format(&Impression{Banner: &Banner{}, Audio: &Audio{}})).To(Equal(MarkupAudio))
+		Expect((&Bid{}).Format(&Impression{})).To(BeZero())
+	})
+
+	It("should validate multi-format bids against the targeted format", func() {
+		req := &BidRequest{ID: "A", Imp: []Impression{{
+			ID:     "1",
+			Banner: &Banner{W: 300, H: 250, BAttr: []CreativeAttribute{CreativeAttributeAudioAdAutoPlay}},
+			Video:  &Video{MaxDuration: 30},
+		}}}
+		res := &BidResponse{ID: "A", SeatBid: []SeatBid{{Bid: []Bid{
+			{ID: "1", ImpID: "1", MType: MarkupVideo, W: 640, H: 480, Dur: 15, Attr: []CreativeAttribute{CreativeAttributeAudioAdAutoPlay}},
+			{ID: "2", ImpID: "1", MType: MarkupBanner, W: 640, H: 480, Dur: 60},
+			{ID: "3", ImpID: "1", MType: MarkupNative},
+		}}}}
+		rejs := res.ValidateAgainst(req)
+		Expect(rejs).To(HaveLen(2))
+		Expect(rejs[0].BidID).To(Equal("2"))
+		Expect(rejs[0].Reasons.Error()).To(Equal("seatbid[0].bid[1].w: openrtb: bid size not offered"))
+		Expect(rejs[1].BidID).To(Equal("3"))
+		Expect(rejs[1].Reasons.Error()).To(Equal("seatbid[0].bid[2].mtype: openrtb: bid markup type not offered by impression"))
+	})
+
+	It("should require bids of unknown format to fit an offered format", func() {
+		req := &BidRequest{ID: "A", Imp: []Impression{{
+			ID:     "1",
+			Banner: &Banner{W: 300, H: 250},
+			Video:  &Video{MaxDuration: 30},
+		}}}
+		res := &BidResponse{ID: "A", SeatBid: []SeatBid{{Bid: []Bid{
+			{ID: "1", ImpID: "1", W: 728, H: 90, Dur: 15},
+			{ID: "2", ImpID: "1", W: 300, H: 250, Dur: 60},
+			{ID: "3", ImpID: "1", W: 728, H: 90, Dur: 60},
+		}}}}
+		rejs := res.ValidateAgainst(req)
+		Expect(rejs).To(HaveLen(1))
+		Expect(rejs[0].BidID).To(Equal("3"))
+		Expect(rejs[0].Reasons.Error()).To(Equal("seatbid[0].bid[2].w: openrtb: bid size not offered; seatbid[0].bid[2].dur: openrtb: bid duration not accepted"))
+
+		Expect(testing.AllocsPerRun(10, func() { res.SeatBid[0].Bid[0].Format(&req.Imp[0]) })).To(BeZero())
 	})
 
 })
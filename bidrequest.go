@@ -1,6 +1,6 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
 
 import (
 	"errors"
@@ -9,42 +9,50 @@ import (
 
 // Validation errors
 var (
-	ErrInvalidReqNoID     = errors.New("openrtb: request ID missing")
-	ErrInvalidReqNoImps   = errors.New("openrtb: request has no impressions")
-	ErrInvalidReqMultiInv = errors.New("openrtb: request has multiple inventory sources") // has site and app
+	ErrInvalidReqNoID      = errors.New("openrtb: request ID missing")
+	ErrInvalidReqNoImps    = errors.New("openrtb: request has no impressions")
+	ErrInvalidReqMultiInv  = errors.New("openrtb: request has multiple inventory sources") // has site and app
+	ErrInvalidReqMultiLang = errors.New("openrtb: request has wlang and wlangb")
 )
 
 // The top-level bid request object contains a globally unique bid request or auction ID.  This "id"
 // attribute is required as is at least one "imp" (i.e., impression) object.  Other attributes are
 // optional since an exchange may establish default values.
 type BidRequest struct {
-	ID          string       `json:"id"` // Unique ID of the bid request
-	Imp         []Impression `json:"imp,omitempty"`
-	Site        *Site        `json:"site,omitempty"`
-	App         *App         `json:"app,omitempty"`
-	Device      *Device      `json:"device,omitempty"`
-	User        *User        `json:"user,omitempty"`
-	Test        int          `json:"test,omitempty"`    // Indicator of test mode in which auctions are not billable, where 0 = live mode, 1 = test mode
-	AuctionType int          `json:"at"`                // Auction type, where 1 = First Price, 2 = Second Price Plus. Exchange-specific auction types can be defined using values greater than 500.
-	TMax        int          `json:"tmax,omitempty"`    // Maximum amount of time in milliseconds to submit a bid
-	WSeat       []string     `json:"wseat,omitempty"`   // Array of buyer seats allowed to bid on this auction
-	BSeat       []string     `json:"bseat,omitempty"`   // Array of buyer seats blocked to bid on this auction
-	WLang       []string     `json:"wlang,omitempty"`   // Array of languages for creatives using ISO-639-1-alpha-2
-	AllImps     int          `json:"allimps,omitempty"` // Flag to indicate whether exchange can verify that all impressions offered represent all of the impressions available in context, Default: 0
-	Cur         []string     `json:"cur,omitempty"`     // Array of allowed currencies
-	Bcat        []string     `json:"bcat,omitempty"`    // Blocked Advertiser Categories.
-	BAdv        []string     `json:"badv,omitempty"`    // Array of strings of blocked toplevel domains of advertisers
-	BApp        []string     `json:"bapp,omitempty"`    // Block list of applications by their platform-specific exchange-independent application identifiers. On Android, these should be bundle or package names (e.g., com.foo.mygame).  On iOS, these are numeric IDs.
-	Source      *Source      `json:"source,omitempty"`  // A Source object that provides data about the inventory source and which entity makes the final decision
-	Regs        *Regulations `json:"regs,omitempty"`
-	Ext         Extension    `json:"ext,omitempty"`
+	ID          string           `json:"id"` // Unique ID of the bid request
+	Imp         []Impression     `json:"imp,omitempty"`
+	Site        *Site            `json:"site,omitempty"`
+	App         *App             `json:"app,omitempty"`
+	Device      *Device          `json:"device,omitempty"`
+	User        *User            `json:"user,omitempty"`
+	Test        int              `json:"test,omitempty"`    // Indicator of test mode in which auctions are not billable, where 0 = live mode, 1 = test mode
+	AuctionType int              `json:"at"`                // Auction type, where 1 = First Price, 2 = Second Price Plus. Exchange-specific auction types can be defined using values greater than 500.
+	TMax        int              `json:"tmax,omitempty"`    // Maximum amount of time in milliseconds to submit a bid
+	WSeat       []string         `json:"wseat,omitempty"`   // Array of buyer seats allowed to bid on this auction
+	BSeat       []string         `json:"bseat,omitempty"`   // Array of buyer seats blocked to bid on this auction
+	WLang       []string         `json:"wlang,omitempty"`   // Array of languages for creatives using ISO-639-1-alpha-2
+	WLangB      []string         `json:"wlangb,omitempty"`  // Array of languages for creatives using IETF BCP 47; only one of wlang or wlangb should be present (Spec 2.6)
+	AllImps     int              `json:"allimps,omitempty"` // Flag to indicate whether exchange can verify that all impressions offered represent all of the impressions available in context, Default: 0
+	Cur         []string         `json:"cur,omitempty"`     // Array of allowed currencies
+	CatTax      CategoryTaxonomy `json:"cattax,omitempty"`  // The taxonomy in use for bcat, default: 1 = IAB Content Category Taxonomy 1.0 (Spec 2.6)
+	Bcat        []string         `json:"bcat,omitempty"`    // Blocked Advertiser Categories.
+	BAdv        []string         `json:"badv,omitempty"`    // Array of strings of blocked toplevel domains of advertisers
+	BApp        []string         `json:"bapp,omitempty"`    // Block list of applications by their platform-specific exchange-independent application identifiers. On Android, these should be bundle or package names (e.g., com.foo.mygame).  On iOS, these are numeric IDs.
+	Source      *Source          `json:"source,omitempty"`  // A Source object that provides data about the inventory source and which entity makes the final decision
+	Regs        *Regulations     `json:"regs,omitempty"`
+	Ext         Extension        `json:"ext,omitempty"`
 
 	Pmp *Pmp `json:"pmp,omitempty"` // DEPRECATED: kept for backwards compatibility
 
 	TD map[string]float64 `json:"-"` // Time details for local use
+
+	unknown   []byte
+	parsedExt extCache
 }
 
 func (br *BidRequest) Reset() {
+	br.unknown = nil
+	br.parsedExt = extCache{}
 	if br.Bcat != nil {
 		br.Bcat = br.Bcat[:0]
 	}
@@ -53,13 +61,16 @@ func (br *BidRequest) Reset() {
 		br.Ext = br.Ext[:0]
 	}
 	if br.Pmp != nil {
-		br.Pmp.Reset()
+		FreePmp(br.Pmp)
+		br.Pmp = nil
 	}
 	if br.Regs != nil {
-		br.Regs.Reset()
+		FreeRegulations(br.Regs)
+		br.Regs = nil
 	}
 	if br.Source != nil {
-		br.Source.Reset()
+		FreeSource(br.Source)
+		br.Source = nil
 	}
 	if br.BApp != nil {
 		br.BApp = br.BApp[:0]
@@ -73,6 +84,10 @@ func (br *BidRequest) Reset() {
 	if br.WLang != nil {
 		br.WLang = br.WLang[:0]
 	}
+	if br.WLangB != nil {
+		br.WLangB = br.WLangB[:0]
+	}
+	br.CatTax = 0
 	if br.BSeat != nil {
 		br.BSeat = br.BSeat[:0]
 	}
@@ -85,16 +100,20 @@ func (br *BidRequest) Reset() {
 	br.AllImps = 0
 	br.AuctionType = 0
 	if br.User != nil {
-		br.User.Reset()
+		FreeUser(br.User)
+		br.User = nil
 	}
 	if br.Device != nil {
-		br.Device.Reset()
+		FreeDevice(br.Device)
+		br.Device = nil
 	}
 	if br.App != nil {
-		br.App.Reset()
+		FreeApp(br.App)
+		br.App = nil
 	}
 	if br.Site != nil {
-		br.Site.Reset()
+		FreeSite(br.Site)
+		br.Site = nil
 	}
 	if br.Imp != nil {
 		for i := 0; i < len(br.Imp); i++ {
@@ -122,21 +141,120 @@ func FreeBidRequest(br *BidRequest) {
 	bidRequestPool.Put(br)
 }
 
+// ParseBidRequest decodes data into a recycled request, reusing the nested
+// objects and slice capacities of previously freed requests. Callers should
+// release the request via FreeBidRequest when done.
+func ParseBidRequest(data []byte) (*BidRequest, error) {
+	br := NewBidRequest()
+	if err := br.UnmarshalJSON(data); err != nil {
+		FreeBidRequest(br)
+		return nil, err
+	}
+	return br, nil
+}
+
+// ParsedExt returns the extension of the request, decoded into the type registered
+// for ExtensionKindRequest. The result is cached until Ext changes and is shared
+// between calls, change Ext to modify it. Safe for concurrent use, as long as
+// Ext is not modified at the same time.
+func (req *BidRequest) ParsedExt() (interface{}, error) {
+	return req.parsedExt.decode(ExtensionKindRequest, req.Ext)
+}
+
 // Validates the request
 func (req *BidRequest) Validate() error {
+	return req.ValidateAll().First()
+}
+
+// ValidateAll walks the whole request and returns all issues found, each
+// with the JSON path of the offending field. Returns nil if the request is valid.
+func (req *BidRequest) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	req.validate(v, "")
+	return v.errs
+}
+
+// ValidateVersion validates the request against the rules of a specific
+// specification version. Required fields of that version are reported as errors,
+// deprecated fields and fields not yet defined in that version as warnings.
+func (req *BidRequest) ValidateVersion(ver Version) ValidationErrors {
+	v := &Validator{ver: ver}
+	req.validate(v, "")
+	return v.errs
+}
+
+func (req *BidRequest) validate(v *Validator, path string) {
 	if req.ID == "" {
-		return ErrInvalidReqNoID
-	} else if len(req.Imp) == 0 {
-		return ErrInvalidReqNoImps
-	} else if req.Site != nil && req.App != nil {
-		return ErrInvalidReqMultiInv
+		v.AddError(PathKey(path, "id"), ErrInvalidReqNoID)
+	}
+	if len(req.Imp) == 0 {
+		v.AddError(PathKey(path, "imp"), ErrInvalidReqNoImps)
+	}
+	if req.Site != nil && req.App != nil {
+		v.AddError(PathKey(path, "app"), ErrInvalidReqMultiInv)
+	}
+
+	for i := range req.Imp {
+		req.Imp[i].validate(v, PathIndex(PathKey(path, "imp"), i))
+	}
+	if req.Device != nil {
+		req.Device.validate(v, PathKey(path, "device"))
+	}
+	if req.User != nil {
+		req.User.validate(v, PathKey(path, "user"))
+	}
+	if req.Source != nil {
+		req.Source.validate(v, PathKey(path, "source"))
+	}
+	if req.Regs != nil {
+		req.Regs.validate(v, PathKey(path, "regs"))
+	}
+	if req.Pmp != nil {
+		v.deprecated(Version2_3, true, PathKey(path, "pmp"))
+		req.Pmp.validate(v, PathKey(path, "pmp"))
+	}
+	if len(req.WLang) != 0 && len(req.WLangB) != 0 {
+		v.AddWarning(PathKey(path, "wlangb"), ErrInvalidReqMultiLang)
+	}
+	v.since(Version2_5, req.Source != nil, PathKey(path, "source"))
+	v.since(Version2_6, req.CatTax != 0, PathKey(path, "cattax"))
+	v.since(Version2_6, len(req.WLangB) != 0, PathKey(path, "wlangb"))
+}
+
+// Normalize applies specification defaults and migrates deprecated fields to
+// their replacements, so downstream code only has to handle a single canonical
+// shape. Returns the list of changes applied.
+func (req *BidRequest) Normalize() []Change {
+	n := new(normalizer)
+	req.normalizeAll(n, "")
+	return n.changes
+}
+
+func (req *BidRequest) normalizeAll(n *normalizer, path string) {
+	if req.AuctionType == 0 {
+		req.AuctionType = 2
+		n.setDefault(PathKey(path, "at"))
 	}
 
-	for _, imp := range req.Imp {
-		if err := (&imp).Validate(); err != nil {
-			return err
+	for i := range req.Imp {
+		imp := &req.Imp[i]
+		ipath := PathIndex(PathKey(path, "imp"), i)
+		if req.Pmp != nil && imp.mergePmp(req.Pmp) {
+			n.migrate(PathKey(ipath, "pmp"))
 		}
+		imp.normalizeAll(n, ipath)
+	}
+	if req.Pmp != nil && len(req.Imp) != 0 {
+		req.Pmp = nil
 	}
 
-	return nil
+	if req.Site != nil {
+		req.Site.Inventory.normalizeAll(n, PathKey(path, "site"))
+	}
+	if req.App != nil {
+		req.App.Inventory.normalizeAll(n, PathKey(path, "app"))
+	}
+	if req.User != nil {
+		req.User.normalizeAll(n, PathKey(path, "user"))
+	}
 }
@@ -1,6 +1,14 @@
 package openrtb
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -14,7 +22,7 @@ var _ = Describe("BidRequest", func() {
 	})
 
 	It("should parse complex requests", func() {
-		for _, kind := range []string{"exp", "video", "native"} {
+		for _, kind := range []string{"exp", "video", "native", "ctv"} {
 			var req *BidRequest
 			err := fixture("breq."+kind, &req)
 			Expect(err).NotTo(HaveOccurred(), "for %s", kind)
@@ -22,6 +30,111 @@ var _ = Describe("BidRequest", func() {
 		}
 	})
 
+	It("should parse into recycled requests", func() {
+		for _, kind := range []string{"video", "banner", "native", "ctv", "exp", "video"} {
+			data, err := ioutil.ReadFile(filepath.Join("testdata", "breq."+kind+".json"))
+			Expect(err).NotTo(HaveOccurred())
+
+			var exp *BidRequest
+			Expect(json.Unmarshal(data, &exp)).To(Succeed())
+			expJSON, err := json.Marshal(exp)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := ParseBidRequest(data)
+			Expect(err).NotTo(HaveOccurred(), "for %s", kind)
+			Expect(json.Marshal(req)).To(MatchJSON(expJSON), "for %s", kind)
+			for i, imp := range req.Imp {
+				Expect(imp.Formats()).To(Equal(exp.Imp[i].Formats()), "for %s", kind)
+			}
+			FreeBidRequest(req)
+		}
+
+		_, err := ParseBidRequest([]byte(`{"id":`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should allocate little more than strings when parsing recycled requests", func() {
+		if raceEnabled {
+			Skip("allocations are not representative under the race detector")
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join("testdata", "breq.video.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		allocs := testing.AllocsPerRun(100, func() {
+			req, err := ParseBidRequest(data)
+			if err != nil {
+				panic(err)
+			}
+			FreeBidRequest(req)
+		})
+		// the fixture contains 51 string values, each of them decoded into a new string
+		Expect(allocs).To(BeNumerically("<=", 51))
+	})
+
+	It("should not share extensions between recycled requests", func() {
+		ext := `{"padding":"` + strings.Repeat(".", 64) + `"}`
+		req, err := ParseBidRequest([]byte(`{"id":"A","imp":[{"id":"1"},{"id":"2"}],"pmp":{"private_auction":1,"ext":` + ext + `,"deals":[{"id":"D","ext":` + ext + `}]}}`))
+		Expect(err).NotTo(HaveOccurred())
+		req.Normalize()
+		FreeBidRequest(req)
+
+		var wg sync.WaitGroup
+		for n := 0; n < 2; n++ {
+			wg.Add(1)
+			go func(n int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				data := []byte(fmt.Sprintf(`{"id":"B","imp":[`+
+					`{"id":"1","pmp":{"ext":{"n":%[1]d,"imp":1},"deals":[{"id":"D","ext":{"n":%[1]d,"imp":1}}]}},`+
+					`{"id":"2","pmp":{"ext":{"n":%[1]d,"imp":22},"deals":[{"id":"D","ext":{"n":%[1]d,"imp":22}}]}}]}`, n))
+				for i := 0; i < 100; i++ {
+					req, err := ParseBidRequest(data)
+					Expect(err).NotTo(HaveOccurred())
+					for j, imp := range []string{"1", "22"} {
+						exp := fmt.Sprintf(`{"n":%d,"imp":%s}`, n, imp)
+						Expect(string(req.Imp[j].Pmp.Ext)).To(Equal(exp))
+						Expect(string(req.Imp[j].Pmp.Deals[0].Ext)).To(Equal(exp))
+					}
+					FreeBidRequest(req)
+				}
+			}(n)
+		}
+		wg.Wait()
+	})
+
+	It("should release nested objects on reset", func() {
+		subject.Reset()
+		Expect(subject.Site).To(BeNil())
+		Expect(subject.Device).To(BeNil())
+		Expect(subject.User).To(BeNil())
+		Expect(subject.Imp).To(BeEmpty())
+		Expect(subject.Imp[:1][0].Banner).To(BeNil())
+	})
+
+	It("should treat recycled requests like new ones", func() {
+		var req *BidRequest
+		Expect(fixture("breq.banner", &req)).To(Succeed())
+		req.Reset()
+		Expect(json.Marshal(req)).To(MatchJSON(`{"id":"","at":0}`))
+
+		Expect(json.Unmarshal([]byte(`{"id":"A","imp":[{"id":"1","audio":{"mimes":["audio/mp4"]}}],"app":{"id":"B"}}`), req)).To(Succeed())
+		Expect(req.Site).To(BeNil())
+		Expect(req.Imp[0].Banner).To(BeNil())
+		Expect(req.Imp[0].Audio.BAttr).To(BeNil())
+		Expect(req.ValidateAll()).To(BeEmpty())
+		Expect(json.Marshal(req)).To(MatchJSON(`{"id":"A","imp":[{"id":"1","audio":{"mimes":["audio/mp4"],"sequence":1}}],"app":{"id":"B"},"at":0}`))
+
+		for _, kind := range []string{"native", "ctv", "exp", "video", "banner"} {
+			var exp *BidRequest
+			Expect(fixture("breq."+kind, &exp)).To(Succeed())
+			req.Reset()
+			Expect(fixture("breq."+kind, &req)).To(Succeed())
+			Expect(req).To(Equal(exp), "for %s", kind)
+		}
+	})
+
 	It("should parse correctly", func() {
 		Expect(subject).To(Equal(&BidRequest{
 			ID: "1234534625254",
@@ -29,7 +142,7 @@ var _ = Describe("BidRequest", func() {
 				{
 					ID:     "1",
 					Secure: 1,
-					Banner: &Banner{W: 300, H: 250, Pos: AdPosAboveFold, BAttr: []int{CreativeAttributeUserInitiated}},
+					Banner: &Banner{W: 300, H: 250, Pos: AdPosAboveFold, BAttr: []CreativeAttribute{CreativeAttributeUserInitiated}},
 				},
 			},
 			Site: &Site{
@@ -65,6 +178,41 @@ var _ = Describe("BidRequest", func() {
 		}))
 	})
 
+	It("should parse 2.6 ad-pod fields", func() {
+		var req *BidRequest
+		Expect(fixture("breq.ctv", &req)).To(Succeed())
+		Expect(req.CatTax).To(Equal(CatTaxIABContent1_0))
+		Expect(req.WLangB).To(Equal([]string{"en-US"}))
+		Expect(req.Imp[0].SSAI).To(Equal(SSAIServerStitch))
+		Expect(req.Imp[0].Video).To(Equal(&Video{
+			Mimes:        []string{"video/mp4"},
+			Protocols:    []Protocol{VideoProtoVAST3, VideoProtoVAST3Wrapper, VideoProtoVAST4, VideoProtoVAST4Wrapper},
+			Linearity:    VideoLinearityLinear,
+			Sequence:     1,
+			Plcmt:        VideoPlcmtInstream,
+			PodID:        "pod-1",
+			PodDur:       120,
+			RqdDurs:      []int{15, 30},
+			MaxSeq:       6,
+			PodSeq:       PodSeqFirst,
+			MinCPMPerSec: 0.5,
+			W:            1920,
+			H:            1080,
+		}))
+		Expect(req.Imp[1].Qty).To(Equal(&Qty{Multiplier: 12.5, SourceType: QtySourceMRCAccredited, Vendor: "measurement.com"}))
+		Expect(req.Imp[1].DT).To(Equal(1700000000000.0))
+
+		bin, err := json.Marshal(req)
+		Expect(err).NotTo(HaveOccurred())
+		var out *BidRequest
+		Expect(json.Unmarshal(bin, &out)).To(Succeed())
+		Expect(out).To(Equal(req))
+
+		Expect(req.ValidateVersion(Version2_6)).To(BeEmpty())
+		Expect(req.ValidateVersion(Version2_5).Errors()).To(BeEmpty())
+		Expect(req.ValidateVersion(Version2_5)).To(ContainElement(&ValidationError{Path: "imp[0].video.rqddurs", Severity: SeverityWarning, Err: ErrNotInVersion}))
+	})
+
 	It("should validate", func() {
 		Expect((&BidRequest{}).Validate()).To(Equal(ErrInvalidReqNoID))
 		Expect((&BidRequest{ID: "A"}).Validate()).To(Equal(ErrInvalidReqNoImps))
@@ -76,4 +224,134 @@ var _ = Describe("BidRequest", func() {
 		Expect(subject.Validate()).NotTo(HaveOccurred())
 	})
 
+	It("should collect all validation errors", func() {
+		errs := (&BidRequest{
+			Imp: []Impression{
+				{ID: "1", Banner: &Banner{W: 300, H: 250}},
+				{Video: &Video{Mimes: []string{"video/mp4"}, Linearity: VideoLinearityLinear, MinDuration: 1, MaxDuration: 30}},
+			},
+			Site: &Site{},
+			App:  &App{},
+		}).ValidateAll()
+		Expect(errs).To(HaveLen(4))
+		Expect(errs[0].Path).To(Equal("id"))
+		Expect(errs[1].Path).To(Equal("app"))
+		Expect(errs[2].Path).To(Equal("imp[1].id"))
+		Expect(errs[3].Path).To(Equal("imp[1].video.protocols"))
+		Expect(errs[3].Error()).To(Equal("imp[1].video.protocols: openrtb: video protocols missing"))
+		Expect(errs.First()).To(Equal(ErrInvalidReqNoID))
+
+		Expect(subject.ValidateAll()).To(BeNil())
+	})
+
+	It("should validate against a version", func() {
+		req := &BidRequest{
+			ID: "A",
+			Imp: []Impression{
+				{ID: "1", Video: &Video{Mimes: []string{"video/mp4"}, Protocol: VideoProtoVAST2, Placement: VideoPlacementInStream}},
+				{ID: "2", Banner: &Banner{WMax: 300, HMax: 250, Format: []Format{{W: 300, H: 250}}}},
+			},
+			Source: &Source{TransactionID: "T"},
+			Pmp:    &Pmp{Deals: []Deal{{ID: "D", Seats: []string{"S"}}}},
+		}
+		Expect(req.Validate()).To(Equal(ErrInvalidVideoNoLinearity))
+
+		errs := req.ValidateVersion(Version2_3)
+		Expect(errs.Errors()).To(BeEmpty())
+		Expect(paths(errs)).To(Equal([]string{
+			"imp[0].video.minduration",
+			"imp[0].video.maxduration",
+			"imp[0].video.protocol",
+			"imp[0].video.placement",
+			"imp[1].banner.format",
+			"pmp",
+			"pmp.deals[0].seats",
+			"source",
+		}))
+
+		errs = req.ValidateVersion(Version2_5)
+		Expect(errs.Errors()).To(BeEmpty())
+		Expect(paths(errs)).To(Equal([]string{
+			"imp[0].video.minduration",
+			"imp[0].video.maxduration",
+			"imp[0].video.protocol",
+			"imp[1].banner.wmax",
+			"imp[1].banner.hmax",
+			"pmp",
+			"pmp.deals[0].seats",
+		}))
+		Expect(errs[2].Err).To(Equal(ErrDeprecated))
+
+		req.Imp[0].Video.Mimes = nil
+		Expect(req.ValidateVersion(Version2_5).First()).To(Equal(ErrInvalidVideoNoMimes))
+	})
+
+	It("should normalize", func() {
+		req := &BidRequest{
+			ID: "A",
+			Imp: []Impression{
+				{ID: "1", Banner: &Banner{W: 300, H: 250, WMax: 728, HMax: 90}},
+				{ID: "2", BidFloorCurrency: "EUR", Video: &Video{Protocol: VideoProtoVAST2, Protocols: []Protocol{VideoProtoVAST3}, Sequence: 1}},
+				{ID: "3", Audio: &Audio{}, Pmp: &Pmp{Deals: []Deal{{ID: "D2", BidFloorCurrency: "EUR"}}}},
+			},
+			Site: &Site{},
+			User: &User{BuyerID: "B"},
+			Pmp:  &Pmp{Private: 1, Deals: []Deal{{ID: "D1", Seats: []string{"S1"}, WSeat: []string{"S2"}, Ext: Extension(`{"b":1}`)}}, Ext: Extension(`{"a":1}`)},
+		}
+		changes := req.Normalize()
+		Expect(changes).To(Equal([]Change{
+			{Path: "at", Kind: ChangeDefault},
+			{Path: "imp[0].pmp", Kind: ChangeMigrate},
+			{Path: "imp[0].bidfloorcur", Kind: ChangeDefault},
+			{Path: "imp[0].banner.format", Kind: ChangeMigrate},
+			{Path: "imp[0].pmp.deals[0].bidfloorcur", Kind: ChangeDefault},
+			{Path: "imp[0].pmp.deals[0].wseat", Kind: ChangeMigrate},
+			{Path: "imp[1].pmp", Kind: ChangeMigrate},
+			{Path: "imp[1].video.protocols", Kind: ChangeMigrate},
+			{Path: "imp[1].pmp.deals[0].bidfloorcur", Kind: ChangeDefault},
+			{Path: "imp[1].pmp.deals[0].wseat", Kind: ChangeMigrate},
+			{Path: "imp[2].pmp", Kind: ChangeMigrate},
+			{Path: "imp[2].bidfloorcur", Kind: ChangeDefault},
+			{Path: "imp[2].audio.sequence", Kind: ChangeDefault},
+			{Path: "imp[2].pmp.deals[1].bidfloorcur", Kind: ChangeDefault},
+			{Path: "imp[2].pmp.deals[1].wseat", Kind: ChangeMigrate},
+			{Path: "site.privacypolicy", Kind: ChangeDefault},
+			{Path: "user.buyeruid", Kind: ChangeMigrate},
+		}))
+
+		Expect(req.AuctionType).To(Equal(2))
+		Expect(req.Pmp).To(BeNil())
+		Expect(req.Imp[0].Banner.Format).To(Equal([]Format{{W: 300, H: 250}, {W: 728, H: 90}}))
+		Expect(req.Imp[0].Banner.W).To(Equal(0))
+		Expect(req.Imp[0].Banner.WMax).To(Equal(0))
+		Expect(req.Imp[0].Pmp.Private).To(Equal(1))
+		Expect(req.Imp[0].Pmp.Deals[0].WSeat).To(Equal([]string{"S2", "S1"}))
+		Expect(req.Imp[0].Pmp.Deals[0].Seats).To(BeEmpty())
+		Expect(req.Imp[1].BidFloorCurrency).To(Equal("EUR"))
+		Expect(req.Imp[1].Video.Protocols).To(Equal([]Protocol{VideoProtoVAST3, VideoProtoVAST2}))
+		Expect(req.Imp[1].Video.Protocol).To(BeZero())
+		Expect(req.Imp[1].Video.Linearity).To(BeZero())
+		Expect(req.Imp[2].Pmp.Deals).To(HaveLen(2))
+		Expect(req.Imp[2].Pmp.Deals[0].BidFloorCurrency).To(Equal("EUR"))
+		Expect(req.Site.GetPrivacyPolicy()).To(Equal(1))
+		Expect(req.User.BuyerUID).To(Equal("B"))
+		Expect(req.User.BuyerID).To(BeEmpty())
+
+		// merged extensions must not be shared between impressions
+		req.Imp[0].Pmp.Ext[5] = '2'
+		req.Imp[0].Pmp.Deals[0].Ext[5] = '2'
+		Expect(string(req.Imp[1].Pmp.Ext)).To(Equal(`{"a":1}`))
+		Expect(string(req.Imp[1].Pmp.Deals[0].Ext)).To(Equal(`{"b":1}`))
+
+		Expect(req.Normalize()).To(BeEmpty())
+	})
+
 })
+
+func paths(errs ValidationErrors) []string {
+	res := make([]string, 0, len(errs))
+	for _, e := range errs {
+		res = append(res, e.Path)
+	}
+	return res
+}
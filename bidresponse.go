@@ -1,9 +1,10 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
 
 import (
 	"errors"
+	"strings"
 	"sync"
 )
 
@@ -11,6 +12,7 @@ import (
 var (
 	ErrInvalidRespNoID       = errors.New("openrtb: response missing ID")
 	ErrInvalidRespNoSeatBids = errors.New("openrtb: response missing seatbids")
+	ErrInvalidRespCurrency   = errors.New("openrtb: response currency not allowed")
 )
 
 // ID and at least one "seatbid” object is required, which contains a bid on at least one impression.
@@ -23,12 +25,15 @@ type BidResponse struct {
 	BidID      string             `json:"bidid,omitempty"`      // Optional response tracking ID for bidders
 	Currency   string             `json:"cur,omitempty"`        // Bid currency
 	CustomData string             `json:"customdata,omitempty"` // Encoded user features
-	NBR        int                `json:"nbr,omitempty"`        // Reason for not bidding, where 0 = unknown error, 1 = technical error, 2 = invalid request, 3 = known web spider, 4 = suspected Non-Human Traffic, 5 = cloud, data center, or proxy IP, 6 = unsupported device, 7 = blocked publisher or site, 8 = unmatched user
+	NBR        NoBidReason        `json:"nbr,omitempty"`        // Reason for not bidding, see NBR* constants
 	Ext        Extension          `json:"ext,omitempty"`        // Custom specifications in JSon
 	TD         map[string]float64 `json:"-"`                    // time detail logging for local use
+
+	unknown []byte
 }
 
 func (br *BidResponse) Reset() {
+	br.unknown = nil
 	br.NBR = 0
 	br.CustomData = ""
 	br.Currency = ""
@@ -64,19 +69,91 @@ func FreeBidResponse(br *BidResponse) {
 	bidResponsePool.Put(br)
 }
 
+// ParseBidResponse decodes data into a recycled response, reusing the nested
+// objects and slice capacities of previously freed responses. Callers should
+// release the response via FreeBidResponse when done.
+func ParseBidResponse(data []byte) (*BidResponse, error) {
+	br := NewBidResponse()
+	if err := br.UnmarshalJSON(data); err != nil {
+		FreeBidResponse(br)
+		return nil, err
+	}
+	return br, nil
+}
+
 // Validate required attributes
 func (res *BidResponse) Validate() error {
+	return res.ValidateAll().First()
+}
+
+// ValidateAll walks the whole response and returns all issues found, each
+// with the JSON path of the offending field. Returns nil if the response is valid.
+func (res *BidResponse) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	res.validate(v, "")
+	return v.errs
+}
+
+// ValidateVersion validates the response against the rules of a specific
+// specification version.
+func (res *BidResponse) ValidateVersion(ver Version) ValidationErrors {
+	v := &Validator{ver: ver}
+	res.validate(v, "")
+	return v.errs
+}
+
+func (res *BidResponse) validate(v *Validator, path string) {
 	if res.ID == "" {
-		return ErrInvalidRespNoID
-	} else if len(res.SeatBid) == 0 {
-		return ErrInvalidRespNoSeatBids
+		v.AddError(PathKey(path, "id"), ErrInvalidRespNoID)
+	}
+	if len(res.SeatBid) == 0 {
+		v.AddError(PathKey(path, "seatbid"), ErrInvalidRespNoSeatBids)
 	}
 
-	for _, sb := range res.SeatBid {
-		if err := sb.Validate(); err != nil {
-			return err
+	for i := range res.SeatBid {
+		res.SeatBid[i].validate(v, PathIndex(PathKey(path, "seatbid"), i))
+	}
+}
+
+// ValidateAgainst checks all bids against the request the response answers and
+// returns a rejection for every bid that must be dropped. Returns nil if all bids
+// are acceptable.
+func (res *BidResponse) ValidateAgainst(req *BidRequest) []BidRejection {
+	imps := make(map[string]*Impression, len(req.Imp))
+	for i := range req.Imp {
+		imps[req.Imp[i].ID] = &req.Imp[i]
+	}
+
+	cur := res.Currency
+	if cur == "" {
+		cur = defaultCurrency
+	}
+	curAllowed := len(req.Cur) == 0
+	for _, c := range req.Cur {
+		if strings.EqualFold(c, cur) {
+			curAllowed = true
+			break
 		}
 	}
 
-	return nil
+	var rejs []BidRejection
+	for i := range res.SeatBid {
+		sb := &res.SeatBid[i]
+		spath := PathIndex("seatbid", i)
+
+		for j := range sb.Bid {
+			bid := &sb.Bid[j]
+			v := new(Validator)
+			if !curAllowed {
+				v.AddError("cur", ErrInvalidRespCurrency)
+			}
+			sb.validateSeat(v, spath, req)
+			bid.validateAgainst(v, PathIndex(PathKey(spath, "bid"), j), req, imps[bid.ImpID], cur)
+
+			if len(v.errs) != 0 {
+				rejs = append(rejs, BidRejection{SeatBid: i, Bid: j, BidID: bid.ID, Reasons: v.errs})
+			}
+		}
+	}
+	return rejs
 }
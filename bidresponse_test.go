@@ -1,6 +1,10 @@
 package openrtb
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -22,6 +26,45 @@ var _ = Describe("BidResponse", func() {
 		}
 	})
 
+	It("should parse into recycled responses", func() {
+		for _, kind := range []string{"single", "multi", "pmp", "vast", "multi"} {
+			data, err := ioutil.ReadFile(filepath.Join("testdata", "bres."+kind+".json"))
+			Expect(err).NotTo(HaveOccurred())
+
+			var exp *BidResponse
+			Expect(json.Unmarshal(data, &exp)).To(Succeed())
+			expJSON, err := json.Marshal(exp)
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := ParseBidResponse(data)
+			Expect(err).NotTo(HaveOccurred(), "for %s", kind)
+			Expect(json.Marshal(res)).To(MatchJSON(expJSON), "for %s", kind)
+			FreeBidResponse(res)
+		}
+	})
+
+	It("should treat recycled responses like new ones", func() {
+		var res *BidResponse
+		Expect(fixture("bres.single", &res)).To(Succeed())
+		res.Reset()
+		Expect(res.SeatBid).To(BeEmpty())
+		Expect(json.Unmarshal([]byte(`{"id":""}`), res)).To(Succeed())
+		Expect(json.Marshal(res)).To(MatchJSON(`{"id":"","seatbid":null}`))
+
+		Expect(json.Unmarshal([]byte(`{"id":"A","seatbid":[{"bid":[{"id":"1","impid":"1","price":0.1}]}]}`), res)).To(Succeed())
+		Expect(res.SeatBid[0].Bid[0].Attr).To(BeNil())
+		Expect(res.SeatBid[0].Bid[0].AdvDomain).To(BeNil())
+		Expect(res.ValidateAll()).To(BeEmpty())
+
+		for _, kind := range []string{"multi", "pmp", "vast", "single"} {
+			var exp *BidResponse
+			Expect(fixture("bres."+kind, &exp)).To(Succeed())
+			res.Reset()
+			Expect(fixture("bres."+kind, &res)).To(Succeed())
+			Expect(res).To(Equal(exp), "for %s", kind)
+		}
+	})
+
 	It("should parse responses", func() {
 		Expect(subject).To(Equal(&BidResponse{
 			ID: "BID-4-ZIMP-4b309eae-504a-4252-a8a8-4c8ceee9791a",
@@ -38,7 +81,7 @@ var _ = Describe("BidResponse", func() {
 							AdvDomain:  []string{},
 							CampaignID: "529833ce55314b19e8796116",
 							CreativeID: "529833ce55314b19e8796116_1385706446",
-							Attr:       []int{},
+							Attr:       []CreativeAttribute{},
 						},
 					},
 					Seat: "772",
@@ -54,4 +97,87 @@ var _ = Describe("BidResponse", func() {
 		Expect(subject.Validate()).NotTo(HaveOccurred())
 	})
 
+	It("should collect all validation errors", func() {
+		errs := (&BidResponse{
+			ID: "RESPID",
+			SeatBid: []SeatBid{
+				{Bid: []Bid{{ID: "1", ImpID: "1"}, {}}},
+				{},
+			},
+		}).ValidateAll()
+		Expect(errs).To(HaveLen(3))
+		Expect(errs[0].Path).To(Equal("seatbid[0].bid[1].id"))
+		Expect(errs[1].Path).To(Equal("seatbid[0].bid[1].impid"))
+		Expect(errs[2].Path).To(Equal("seatbid[1].bid"))
+		Expect(errs.First()).To(Equal(ErrInvalidBidNoID))
+	})
+
+	It("should validate against request", func() {
+		req := &BidRequest{
+			ID: "REQID",
+			Imp: []Impression{
+				{ID: "1", BidFloor: 1.0, Banner: &Banner{Format: []Format{{W: 300, H: 250}}, BAttr: []CreativeAttribute{CreativeAttributePop}}},
+				{ID: "2", Video: &Video{}, Pmp: &Pmp{Private: 1, Deals: []Deal{{ID: "D1", BidFloor: 5.0}}}},
+			},
+			Cur:   []string{"USD", "EUR"},
+			BAdv:  []string{"blocked.com"},
+			Bcat:  []string{"IAB25"},
+			BSeat: []string{"badseat"},
+		}
+		Expect((&BidResponse{
+			ID: "RESPID",
+			SeatBid: []SeatBid{{Seat: "goodseat", Bid: []Bid{
+				{ID: "A", ImpID: "1", Price: 1.5, W: 300, H: 250, AdvDomain: []string{"ok.com"}, Cat: []string{"IAB1"}},
+				{ID: "B", ImpID: "2", Price: 5.0, DealID: "D1"},
+			}}},
+		}).ValidateAgainst(req)).To(BeNil())
+
+		rejs := (&BidResponse{
+			ID: "RESPID",
+			SeatBid: []SeatBid{
+				{Seat: "goodseat", Bid: []Bid{
+					{ID: "A", ImpID: "1", Price: 0.5, W: 728, H: 90, AdvDomain: []string{"www.blocked.com"}, Cat: []string{"IAB25-3"}, Attr: []CreativeAttribute{CreativeAttributePop}},
+					{ID: "B", ImpID: "2", Price: 5.0, DealID: "D1"},
+					{ID: "C", ImpID: "2", Price: 9.0},
+					{ID: "D", ImpID: "2", Price: 9.0, DealID: "D2"},
+					{ID: "E", ImpID: "3", Price: 9.0},
+				}},
+				{Seat: "badseat", Bid: []Bid{{ID: "F", ImpID: "1", Price: 1.5}}},
+			},
+		}).ValidateAgainst(req)
+		Expect(rejs).To(HaveLen(5))
+
+		Expect(rejs[0].SeatBid).To(Equal(0))
+		Expect(rejs[0].Bid).To(Equal(0))
+		Expect(rejs[0].BidID).To(Equal("A"))
+		Expect(rejs[0].Reasons).To(HaveLen(5))
+		Expect(rejs[0].Reasons[0].Path).To(Equal("seatbid[0].bid[0].adomain[0]"))
+		Expect(rejs[0].Reasons[0].Err).To(Equal(ErrInvalidBidBlockedAdv))
+		Expect(rejs[0].Reasons[1].Err).To(Equal(ErrInvalidBidBlockedCat))
+		Expect(rejs[0].Reasons[2].Err).To(Equal(ErrInvalidBidBlockedAttr))
+		Expect(rejs[0].Reasons[3].Err).To(Equal(ErrInvalidBidSize))
+		Expect(rejs[0].Reasons[4].Path).To(Equal("seatbid[0].bid[0].price"))
+		Expect(rejs[0].Reasons[4].Err).To(Equal(ErrInvalidBidBelowFloor))
+
+		Expect(rejs[1].BidID).To(Equal("C"))
+		Expect(rejs[1].Reasons.First()).To(Equal(ErrInvalidBidNoDealID))
+		Expect(rejs[2].BidID).To(Equal("D"))
+		Expect(rejs[2].Reasons.First()).To(Equal(ErrInvalidBidUnknownDeal))
+		Expect(rejs[3].BidID).To(Equal("E"))
+		Expect(rejs[3].Reasons.First()).To(Equal(ErrInvalidBidUnknownImp))
+		Expect(rejs[4].SeatBid).To(Equal(1))
+		Expect(rejs[4].Reasons[0].Path).To(Equal("seatbid[1].seat"))
+		Expect(rejs[4].Reasons.First()).To(Equal(ErrInvalidSeatBidSeat))
+
+		rejs = (&BidResponse{
+			ID:       "RESPID",
+			Currency: "GBP",
+			SeatBid:  []SeatBid{{Bid: []Bid{{ID: "A", ImpID: "1", Price: 0.5}}}},
+		}).ValidateAgainst(req)
+		Expect(rejs).To(HaveLen(1))
+		Expect(rejs[0].Reasons).To(HaveLen(1))
+		Expect(rejs[0].Reasons[0].Path).To(Equal("cur"))
+		Expect(rejs[0].Reasons.First()).To(Equal(ErrInvalidRespCurrency))
+	})
+
 })
@@ -0,0 +1,74 @@
+package openrtb
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Clone", func() {
+
+	It("should deep copy requests", func() {
+		for _, kind := range []string{"banner", "video", "native", "ctv", "exp"} {
+			var req *BidRequest
+			Expect(fixture("breq."+kind, &req)).To(Succeed(), "for %s", kind)
+
+			clone := req.Clone()
+			Expect(clone).NotTo(BeIdenticalTo(req), "for %s", kind)
+			Expect(clone.Equal(req)).To(BeTrue(), "for %s", kind)
+
+			exp, err := json.Marshal(req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(json.Marshal(clone)).To(MatchJSON(exp), "for %s", kind)
+		}
+	})
+
+	It("should deep copy responses", func() {
+		for _, kind := range []string{"single", "multi", "pmp", "vast"} {
+			var res *BidResponse
+			Expect(fixture("bres."+kind, &res)).To(Succeed(), "for %s", kind)
+
+			clone := res.Clone()
+			Expect(clone.Equal(res)).To(BeTrue(), "for %s", kind)
+		}
+	})
+
+	It("should not share state", func() {
+		var req *BidRequest
+		Expect(fixture("breq.banner", &req)).To(Succeed())
+		exp, err := json.Marshal(req)
+		Expect(err).NotTo(HaveOccurred())
+
+		clone := req.Clone()
+		clone.Imp[0].BidFloor = 99
+		clone.Imp[0].Banner.W = 1
+		clone.Site.Cat[0] = "IAB99"
+		clone.User = nil
+		Expect(clone.Ext.Set("x", 1)).To(Succeed())
+		Expect(clone.Equal(req)).To(BeFalse())
+
+		Expect(json.Marshal(req)).To(MatchJSON(exp))
+	})
+
+	It("should clone nil", func() {
+		var req *BidRequest
+		Expect(req.Clone()).To(BeNil())
+		Expect(req.Equal(nil)).To(BeTrue())
+		Expect(req.Equal(&BidRequest{})).To(BeFalse())
+	})
+
+	It("should compare structurally", func() {
+		a := &Impression{ID: "1", Banner: &Banner{W: 300}, Ext: Extension(`{"a":1}`)}
+		b := &Impression{ID: "1", Banner: &Banner{W: 300}, Ext: Extension(`{"a":1}`), IFrameBuster: []string{}}
+		Expect(a.Equal(b)).To(BeTrue())
+
+		b.Banner.W = 250
+		Expect(a.Equal(b)).To(BeFalse())
+		b.Banner.W = 300
+		b.Ext = Extension(`{"a": 1}`)
+		Expect(a.Equal(b)).To(BeFalse())
+		b.Ext = nil
+		Expect(a.Equal(b)).To(BeFalse())
+	})
+})
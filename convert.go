@@ -0,0 +1,391 @@
+package openrtb
+
+import "strings"
+
+// ConvertTo rewrites the request into the shape of the given specification
+// version: fields are moved to where the target version expects them and
+// fields the target cannot carry are dropped. Returns the list of changes
+// applied; changes of kind ChangeDrop indicate information that was lost.
+func (req *BidRequest) ConvertTo(ver Version) ([]Change, error) {
+	if ver.String() == "" {
+		return nil, ErrUnsupportedVersion
+	}
+
+	n := new(normalizer)
+	if err := convertRequest(n, req, ver); err != nil {
+		return n.changes, err
+	}
+	return n.changes, nil
+}
+
+// ConvertTo rewrites the response into the shape of the given specification
+// version. See BidRequest.ConvertTo for details.
+func (res *BidResponse) ConvertTo(ver Version) ([]Change, error) {
+	if ver.String() == "" {
+		return nil, ErrUnsupportedVersion
+	}
+
+	n := new(normalizer)
+	convertResponse(n, res, ver)
+	return n.changes, nil
+}
+
+func convertRequest(n *normalizer, req *BidRequest, ver Version) error {
+	for i := range req.Imp {
+		imp := &req.Imp[i]
+		path := PathIndex("imp", i)
+
+		// the top-level pmp object was never part of the specification
+		if req.Pmp != nil && imp.mergePmp(req.Pmp) {
+			n.migrate(PathKey(path, "pmp"))
+		}
+		convertImpression(n, imp, path, ver)
+	}
+	if req.Pmp != nil && len(req.Imp) != 0 {
+		req.Pmp = nil
+	}
+
+	if ver < Version2_5 && req.Source != nil {
+		req.Source = nil
+		n.drop("source")
+	}
+	if ver < Version2_6 {
+		if len(req.WLangB) != 0 {
+			// BCP 47 tags start with the ISO-639 language
+			if len(req.WLang) == 0 {
+				for _, tag := range req.WLangB {
+					if lang := strings.SplitN(tag, "-", 2)[0]; !containsString(req.WLang, lang) {
+						req.WLang = append(req.WLang, lang)
+					}
+				}
+				n.migrate("wlang")
+			}
+			req.WLangB = nil
+			n.drop("wlangb")
+		}
+		if req.CatTax != 0 {
+			if req.CatTax != CatTaxIABContent1_0 {
+				n.drop("cattax")
+			}
+			req.CatTax = 0
+		}
+		if req.Device != nil && req.Device.SUA != nil {
+			req.Device.SUA = nil
+			n.drop("device.sua")
+		}
+	}
+
+	if req.Source != nil {
+		if err := convertSource(n, req.Source, "source", ver); err != nil {
+			return err
+		}
+	}
+	if req.Regs != nil {
+		if err := convertRegs(n, req.Regs, "regs", ver); err != nil {
+			return err
+		}
+	}
+	if req.User != nil {
+		if err := convertUser(n, req.User, "user", ver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func convertSource(n *normalizer, s *Source, path string, ver Version) (err error) {
+	if ver >= Version2_6 {
+		if err := s.readExt(); err != nil {
+			return err
+		}
+		s.Ext, err = extMove(n, s.Ext, "schain", path)
+		return err
+	}
+
+	if s.SChain != nil {
+		if s.Ext, err = extStore(n, s.Ext, "schain", s.SChain, path); err != nil {
+			return err
+		}
+		s.SChain = nil
+	}
+	return nil
+}
+
+func convertRegs(n *normalizer, r *Regulations, path string, ver Version) (err error) {
+	if ver >= Version2_6 {
+		if err := r.readExt(); err != nil {
+			return err
+		}
+		for _, key := range []string{"gdpr", "us_privacy", "gpp", "gpp_sid"} {
+			if r.Ext, err = extMove(n, r.Ext, key, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if r.GDPR != nil {
+		if r.Ext, err = extStore(n, r.Ext, "gdpr", *r.GDPR, path); err != nil {
+			return err
+		}
+		r.GDPR = nil
+	}
+	if r.USPrivacy != "" {
+		if r.Ext, err = extStore(n, r.Ext, "us_privacy", r.USPrivacy, path); err != nil {
+			return err
+		}
+		r.USPrivacy = ""
+	}
+	if r.GPP != "" {
+		if r.Ext, err = extStore(n, r.Ext, "gpp", r.GPP, path); err != nil {
+			return err
+		}
+		r.GPP = ""
+	}
+	if len(r.GPPSID) != 0 {
+		if r.Ext, err = extStore(n, r.Ext, "gpp_sid", r.GPPSID, path); err != nil {
+			return err
+		}
+		r.GPPSID = nil
+	}
+	return nil
+}
+
+func convertUser(n *normalizer, u *User, path string, ver Version) (err error) {
+	if ver >= Version2_6 {
+		if err := u.readExt(); err != nil {
+			return err
+		}
+		for _, key := range []string{"consent", "eids"} {
+			if u.Ext, err = extMove(n, u.Ext, key, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if u.Consent != "" {
+		if u.Ext, err = extStore(n, u.Ext, "consent", u.Consent, path); err != nil {
+			return err
+		}
+		u.Consent = ""
+	}
+	if len(u.EIDs) != 0 {
+		if u.Ext, err = extStore(n, u.Ext, "eids", u.EIDs, path); err != nil {
+			return err
+		}
+		u.EIDs = nil
+	}
+	return nil
+}
+
+// extMove removes a key, which has already been read into the first-class
+// field of the same name, from the extension of the object at path.
+func extMove(n *normalizer, ext Extension, key, path string) (Extension, error) {
+	if !ext.has(key) {
+		return ext, nil
+	}
+	ext, err := ext.del(key)
+	if err == nil {
+		n.migrate(PathKey(path, key))
+	}
+	return ext, err
+}
+
+// extStore stores the value of a first-class field under key in the
+// extension of the object at path.
+func extStore(n *normalizer, ext Extension, key string, v interface{}, path string) (Extension, error) {
+	ext, err := ext.set(key, v)
+	if err == nil {
+		n.migrate(PathKey(PathKey(path, "ext"), key))
+	}
+	return ext, err
+}
+
+func convertImpression(n *normalizer, imp *Impression, path string, ver Version) {
+	if imp.Banner != nil {
+		convertBanner(n, imp.Banner, PathKey(path, "banner"), ver)
+	}
+	if imp.Video != nil {
+		convertVideo(n, imp.Video, PathKey(path, "video"), ver)
+	}
+	if ver < Version2_4 && imp.Audio != nil {
+		imp.Audio = nil
+		n.drop(PathKey(path, "audio"))
+	}
+	if ver < Version2_6 {
+		if imp.Rwdd != 0 {
+			imp.Rwdd = 0
+			n.drop(PathKey(path, "rwdd"))
+		}
+		if imp.SSAI != 0 {
+			imp.SSAI = 0
+			n.drop(PathKey(path, "ssai"))
+		}
+		if imp.Qty != nil {
+			imp.Qty = nil
+			n.drop(PathKey(path, "qty"))
+		}
+		if imp.DT != 0 {
+			imp.DT = 0
+			n.drop(PathKey(path, "dt"))
+		}
+	}
+	if imp.Pmp != nil {
+		for i := range imp.Pmp.Deals {
+			imp.Pmp.Deals[i].migrateSeats(n, PathIndex(PathKey(path, "pmp.deals"), i))
+		}
+	}
+}
+
+func convertBanner(n *normalizer, bn *Banner, path string, ver Version) {
+	if ver >= Version2_4 {
+		// size ranges were replaced by formats
+		if bn.WMax != 0 || bn.HMax != 0 || bn.WMin != 0 || bn.HMin != 0 {
+			bn.normalizeAll(n, path)
+		}
+		return
+	}
+	if len(bn.Format) == 0 {
+		return
+	}
+
+	// formats are not available, fall back to an exact size or a size range
+	if bn.W == 0 && bn.H == 0 {
+		bn.W, bn.H = bn.Format[0].W, bn.Format[0].H
+		n.migrate(PathKey(path, "w"))
+	}
+	if len(bn.Format) > 1 {
+		bn.WMin, bn.HMin = bn.W, bn.H
+		bn.WMax, bn.HMax = bn.W, bn.H
+		for _, f := range bn.Format {
+			if f.W < bn.WMin {
+				bn.WMin = f.W
+			}
+			if f.H < bn.HMin {
+				bn.HMin = f.H
+			}
+			if f.W > bn.WMax {
+				bn.WMax = f.W
+			}
+			if f.H > bn.HMax {
+				bn.HMax = f.H
+			}
+		}
+		n.migrate(PathKey(path, "wmax"))
+	}
+	bn.Format = nil
+	n.drop(PathKey(path, "format"))
+}
+
+func convertVideo(n *normalizer, v *Video, path string, ver Version) {
+	v.migrateProtocol(n, path)
+	if ver < Version2_5 && v.Placement != 0 {
+		v.Placement = 0
+		n.drop(PathKey(path, "placement"))
+	}
+	if ver >= Version2_6 {
+		return
+	}
+
+	// required durations can only be expressed as a range
+	if len(v.RqdDurs) != 0 {
+		if v.MinDuration == 0 && v.MaxDuration == 0 {
+			v.MinDuration, v.MaxDuration = v.RqdDurs[0], v.RqdDurs[0]
+			for _, d := range v.RqdDurs {
+				if d < v.MinDuration {
+					v.MinDuration = d
+				}
+				if d > v.MaxDuration {
+					v.MaxDuration = d
+				}
+			}
+			n.migrate(PathKey(path, "minduration"))
+		}
+		v.RqdDurs = nil
+		n.drop(PathKey(path, "rqddurs"))
+	}
+	if v.Plcmt != 0 {
+		v.Plcmt = 0
+		n.drop(PathKey(path, "plcmt"))
+	}
+	if v.PodID != "" {
+		v.PodID = ""
+		n.drop(PathKey(path, "podid"))
+	}
+	if v.PodDur != 0 {
+		v.PodDur = 0
+		n.drop(PathKey(path, "poddur"))
+	}
+	if v.MaxSeq != 0 {
+		v.MaxSeq = 0
+		n.drop(PathKey(path, "maxseq"))
+	}
+	if v.PodSeq != 0 {
+		v.PodSeq = 0
+		n.drop(PathKey(path, "podseq"))
+	}
+	if v.SlotInPod != 0 {
+		v.SlotInPod = 0
+		n.drop(PathKey(path, "slotinpod"))
+	}
+	if v.MinCPMPerSec != 0 {
+		v.MinCPMPerSec = 0
+		n.drop(PathKey(path, "mincpmpersec"))
+	}
+}
+
+func convertResponse(n *normalizer, res *BidResponse, ver Version) {
+	for i := range res.SeatBid {
+		sb := &res.SeatBid[i]
+		for j := range sb.Bid {
+			convertBid(n, &sb.Bid[j], PathIndex(PathKey(PathIndex("seatbid", i), "bid"), j), ver)
+		}
+	}
+}
+
+func convertBid(n *normalizer, bid *Bid, path string, ver Version) {
+	if ver >= Version2_6 {
+		if bid.API != 0 {
+			if !containsAPIFramework(bid.APIs, bid.API) {
+				bid.APIs = append(bid.APIs, bid.API)
+			}
+			bid.API = 0
+			n.migrate(PathKey(path, "apis"))
+		}
+		return
+	}
+
+	if len(bid.APIs) != 0 {
+		if bid.API == 0 {
+			bid.API = bid.APIs[0]
+			n.migrate(PathKey(path, "api"))
+		}
+		if len(bid.APIs) > 1 || bid.APIs[0] != bid.API {
+			n.drop(PathKey(path, "apis"))
+		}
+		bid.APIs = nil
+	}
+	if bid.Dur != 0 {
+		bid.Dur = 0
+		n.drop(PathKey(path, "dur"))
+	}
+	if bid.SlotInPod != 0 {
+		bid.SlotInPod = 0
+		n.drop(PathKey(path, "slotinpod"))
+	}
+	if bid.MType != 0 {
+		bid.MType = 0
+		n.drop(PathKey(path, "mtype"))
+	}
+	if ver < Version2_5 {
+		if bid.BURL != "" {
+			bid.BURL = ""
+			n.drop(PathKey(path, "burl"))
+		}
+		if bid.LURL != "" {
+			bid.LURL = ""
+			n.drop(PathKey(path, "lurl"))
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package openrtb
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConvertTo", func() {
+	var req *BidRequest
+
+	BeforeEach(func() {
+		req = &BidRequest{
+			ID: "A",
+			Imp: []Impression{
+				{ID: "1", Banner: &Banner{Format: []Format{{W: 300, H: 250}, {W: 728, H: 90}, {W: 320, H: 50}}}},
+				{ID: "2", Video: &Video{Protocol: VideoProtoVAST2, Placement: VideoPlacementInStream}},
+				{ID: "3", Audio: &Audio{Mimes: []string{"audio/mp4"}}},
+			},
+			Source: &Source{TransactionID: "T"},
+			Pmp:    &Pmp{Deals: []Deal{{ID: "D1", Seats: []string{"S1"}}}},
+		}
+	})
+
+	It("should downgrade requests", func() {
+		changes, err := req.ConvertTo(Version2_3)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]Change{
+			{Path: "imp[0].pmp", Kind: ChangeMigrate},
+			{Path: "imp[0].banner.w", Kind: ChangeMigrate},
+			{Path: "imp[0].banner.wmax", Kind: ChangeMigrate},
+			{Path: "imp[0].banner.format", Kind: ChangeDrop},
+			{Path: "imp[0].pmp.deals[0].wseat", Kind: ChangeMigrate},
+			{Path: "imp[1].pmp", Kind: ChangeMigrate},
+			{Path: "imp[1].video.protocols", Kind: ChangeMigrate},
+			{Path: "imp[1].video.placement", Kind: ChangeDrop},
+			{Path: "imp[1].pmp.deals[0].wseat", Kind: ChangeMigrate},
+			{Path: "imp[2].pmp", Kind: ChangeMigrate},
+			{Path: "imp[2].audio", Kind: ChangeDrop},
+			{Path: "imp[2].pmp.deals[0].wseat", Kind: ChangeMigrate},
+			{Path: "source", Kind: ChangeDrop},
+		}))
+		Expect(req.Imp[0].Banner).To(Equal(&Banner{W: 300, H: 250, WMin: 300, HMin: 50, WMax: 728, HMax: 250}))
+		Expect(req.Imp[1].Video.Protocols).To(Equal([]Protocol{VideoProtoVAST2}))
+		Expect(req.Imp[2].Audio).To(BeNil())
+		Expect(req.Source).To(BeNil())
+		Expect(req.Pmp).To(BeNil())
+		for _, e := range req.ValidateVersion(Version2_3) {
+			Expect(e.Err).NotTo(Equal(ErrNotInVersion), "for %s", e.Path)
+		}
+	})
+
+	It("should upgrade requests", func() {
+		req.Imp[0].Banner = &Banner{WMax: 728, HMax: 90}
+		changes, err := req.ConvertTo(Version2_6)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(ContainElement(Change{Path: "imp[0].banner.format", Kind: ChangeMigrate}))
+		for _, c := range changes {
+			Expect(c.Kind).NotTo(Equal(ChangeDrop), "for %s", c.Path)
+		}
+		Expect(req.Imp[0].Banner).To(Equal(&Banner{Format: []Format{{W: 728, H: 90}}}))
+		Expect(req.Imp[1].Video.Placement).To(Equal(VideoPlacementInStream))
+		Expect(req.Source).NotTo(BeNil())
+	})
+
+	It("should downgrade 2.6 ad-pod fields", func() {
+		var ctv *BidRequest
+		Expect(fixture("breq.ctv", &ctv)).To(Succeed())
+		changes, err := ctv.ConvertTo(Version2_5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]Change{
+			{Path: "imp[0].video.minduration", Kind: ChangeMigrate},
+			{Path: "imp[0].video.rqddurs", Kind: ChangeDrop},
+			{Path: "imp[0].video.plcmt", Kind: ChangeDrop},
+			{Path: "imp[0].video.podid", Kind: ChangeDrop},
+			{Path: "imp[0].video.poddur", Kind: ChangeDrop},
+			{Path: "imp[0].video.maxseq", Kind: ChangeDrop},
+			{Path: "imp[0].video.podseq", Kind: ChangeDrop},
+			{Path: "imp[0].video.mincpmpersec", Kind: ChangeDrop},
+			{Path: "imp[0].ssai", Kind: ChangeDrop},
+			{Path: "imp[1].qty", Kind: ChangeDrop},
+			{Path: "imp[1].dt", Kind: ChangeDrop},
+			{Path: "wlang", Kind: ChangeMigrate},
+			{Path: "wlangb", Kind: ChangeDrop},
+		}))
+		Expect(ctv.Imp[0].Video.MinDuration).To(Equal(15))
+		Expect(ctv.Imp[0].Video.MaxDuration).To(Equal(30))
+		Expect(ctv.WLang).To(Equal([]string{"en"}))
+		Expect(ctv.CatTax).To(BeZero())
+		Expect(ctv.ValidateVersion(Version2_5)).To(BeEmpty())
+	})
+
+	It("should move privacy signals", func() {
+		req := &BidRequest{
+			ID:   "A",
+			Regs: &Regulations{Coppa: 1, GDPR: iptr(1), USPrivacy: "1YNN", Ext: Extension(`{"other":1}`)},
+			User: &User{ID: "U", Consent: "CO-TCF"},
+		}
+		changes, err := req.ConvertTo(Version2_5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]Change{
+			{Path: "regs.ext.gdpr", Kind: ChangeMigrate},
+			{Path: "regs.ext.us_privacy", Kind: ChangeMigrate},
+			{Path: "user.ext.consent", Kind: ChangeMigrate},
+		}))
+		Expect(req.Regs).To(Equal(&Regulations{Coppa: 1, Ext: Extension(`{"gdpr":1,"other":1,"us_privacy":"1YNN"}`)}))
+		Expect(req.User).To(Equal(&User{ID: "U", Ext: Extension(`{"consent":"CO-TCF"}`)}))
+		Expect(req.ValidateVersion(Version2_5).Warnings()).To(BeEmpty())
+
+		changes, err = req.ConvertTo(Version2_6)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]Change{
+			{Path: "regs.gdpr", Kind: ChangeMigrate},
+			{Path: "regs.us_privacy", Kind: ChangeMigrate},
+			{Path: "user.consent", Kind: ChangeMigrate},
+		}))
+		Expect(req.Regs).To(Equal(&Regulations{Coppa: 1, GDPR: iptr(1), USPrivacy: "1YNN", Ext: Extension(`{"other":1}`)}))
+		Expect(req.User).To(Equal(&User{ID: "U", Consent: "CO-TCF"}))
+
+		req.User.Ext = Extension(`[]`)
+		_, err = req.ConvertTo(Version2_5)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should move the supply chain", func() {
+		schain := &SupplyChain{Complete: 1, Ver: "1.0", Nodes: []SupplyChainNode{{ASI: "exchange1.com", SID: "1234", HP: 1}}}
+		req.Source = &Source{TransactionID: "T", SChain: schain}
+		changes, err := req.ConvertTo(Version2_5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(ContainElement(Change{Path: "source.ext.schain", Kind: ChangeMigrate}))
+		Expect(req.Source.SChain).To(BeNil())
+		Expect(req.Source.Ext).To(Equal(Extension(`{"schain":{"complete":1,"nodes":[{"asi":"exchange1.com","sid":"1234","hp":1}],"ver":"1.0"}}`)))
+
+		changes, err = req.ConvertTo(Version2_6)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(ContainElement(Change{Path: "source.schain", Kind: ChangeMigrate}))
+		Expect(req.Source).To(Equal(&Source{TransactionID: "T", SChain: schain}))
+	})
+
+	It("should move extended identifiers", func() {
+		eids := []EID{{Source: "id5-sync.com", UIDs: []UID{{ID: "X", AType: AgentTypeDevice}}}}
+		req.User = &User{ID: "U", EIDs: eids}
+		changes, err := req.ConvertTo(Version2_5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(ContainElement(Change{Path: "user.ext.eids", Kind: ChangeMigrate}))
+		Expect(req.User).To(Equal(&User{ID: "U", Ext: Extension(`{"eids":[{"source":"id5-sync.com","uids":[{"id":"X","atype":1}]}]}`)}))
+
+		changes, err = req.ConvertTo(Version2_6)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(ContainElement(Change{Path: "user.eids", Kind: ChangeMigrate}))
+		Expect(req.User).To(Equal(&User{ID: "U", EIDs: eids}))
+	})
+
+	It("should convert responses", func() {
+		res := &BidResponse{ID: "A", SeatBid: []SeatBid{{Bid: []Bid{{ID: "1", ImpID: "1", BURL: "http://b", LURL: "http://l"}}}}}
+		Expect(res.ConvertTo(Version2_5)).To(BeEmpty())
+		Expect(res.ConvertTo(Version2_4)).To(Equal([]Change{
+			{Path: "seatbid[0].bid[0].burl", Kind: ChangeDrop},
+			{Path: "seatbid[0].bid[0].lurl", Kind: ChangeDrop},
+		}))
+		Expect(res.SeatBid[0].Bid[0].BURL).To(BeEmpty())
+
+		res.SeatBid[0].Bid[0].API = APIFrameworkMRAID2
+		Expect(res.ConvertTo(Version2_6)).To(Equal([]Change{
+			{Path: "seatbid[0].bid[0].apis", Kind: ChangeMigrate},
+		}))
+		Expect(res.SeatBid[0].Bid[0].APIs).To(Equal([]APIFramework{APIFrameworkMRAID2}))
+
+		res.SeatBid[0].Bid[0].MType = MarkupVideo
+		Expect(res.ConvertTo(Version2_5)).To(Equal([]Change{
+			{Path: "seatbid[0].bid[0].api", Kind: ChangeMigrate},
+			{Path: "seatbid[0].bid[0].mtype", Kind: ChangeDrop},
+		}))
+		Expect(res.SeatBid[0].Bid[0].API).To(Equal(APIFrameworkMRAID2))
+	})
+
+	It("should reject unsupported versions", func() {
+		_, err := req.ConvertTo(VersionUnspecified)
+		Expect(err).To(Equal(ErrUnsupportedVersion))
+	})
+
+})
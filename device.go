@@ -1,45 +1,66 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
+
+import (
+	"errors"
+	"net"
+	"sync"
+)
+
+// Validation errors
+var (
+	ErrInvalidDeviceIP        = errors.New("openrtb: device IPv4 address invalid")
+	ErrInvalidDeviceIPv6      = errors.New("openrtb: device IPv6 address invalid")
+	ErrInvalidDeviceType      = errors.New("openrtb: device type invalid")
+	ErrInvalidDeviceConnType  = errors.New("openrtb: device connection type invalid")
+	ErrInvalidDeviceSUASource = errors.New("openrtb: device user agent source invalid")
+	ErrInvalidDeviceSUAMobile = errors.New("openrtb: device user agent mobile flag invalid")
+	ErrInvalidDeviceSUABrand  = errors.New("openrtb: device user agent brand missing")
+)
 
 // The "device" object provides information pertaining to the device including its hardware,
 // platform, location, and carrier. This device can refer to a mobile handset, a desktop computer,
 // set top box or other digital device.
 type Device struct {
-	UA         string    `json:"ua,omitempty"`             // User agent
-	Geo        *Geo      `json:"geo,omitempty"`            // Location of the device assumed to be the user’s current location
-	DNT        int       `json:"dnt,omitempty"`            // "1": Do not track
-	LMT        int       `json:"lmt,omitempty"`            // "1": Limit Ad Tracking
-	IP         string    `json:"ip,omitempty"`             // IPv4
-	IPv6       string    `json:"ipv6,omitempty"`           // IPv6
-	DeviceType int       `json:"devicetype,omitempty"`     // The general type of device.
-	Make       string    `json:"make,omitempty"`           // Device make
-	Model      string    `json:"model,omitempty"`          // Device model
-	OS         string    `json:"os,omitempty"`             // Device OS
-	OSVer      string    `json:"osv,omitempty"`            // Device OS version
-	HwVer      string    `json:"hwv,omitempty"`            // Hardware version of the device (e.g., "5S" for iPhone 5S).
-	H          int       `json:"h,omitempty"`              // Physical height of the screen in pixels.
-	W          int       `json:"w,omitempty"`              // Physical width of the screen in pixels.
-	PPI        int       `json:"ppi,omitempty"`            // Screen size as pixels per linear inch.
-	PxRatio    float64   `json:"pxratio,omitempty"`        // The ratio of physical pixels to device independent pixels.
-	JS         int       `json:"js,omitempty"`             // Javascript status ("0": Disabled, "1": Enabled)
-	GeoFetch   int       `json:"geofetch,omitempty"`       // Indicates if the geolocation API will be available to JavaScript code running in the banner,
-	FlashVer   string    `json:"flashver,omitempty"`       // Flash version
-	Language   string    `json:"language,omitempty"`       // Browser language
-	Carrier    string    `json:"carrier,omitempty"`        // Carrier or ISP derived from the IP address
-	MCCMNC     string    `json:"mccmnc,omitempty"`         // Mobile carrier as the concatenated MCC-MNC code (e.g., "310-005" identifies Verizon Wireless CDMA in the USA).
-	ConnType   int       `json:"connectiontype,omitempty"` // Network connection type.
-	IFA        string    `json:"ifa,omitempty"`            // Native identifier for advertisers
-	IDSHA1     string    `json:"didsha1,omitempty"`        // SHA1 hashed device ID
-	IDMD5      string    `json:"didmd5,omitempty"`         // MD5 hashed device ID
-	PIDSHA1    string    `json:"dpidsha1,omitempty"`       // SHA1 hashed platform device ID
-	PIDMD5     string    `json:"dpidmd5,omitempty"`        // MD5 hashed platform device ID
-	MacSHA1    string    `json:"macsha1,omitempty"`        // SHA1 hashed device ID; IMEI when available, else MEID or ESN
-	MacMD5     string    `json:"macmd5,omitempty"`         // MD5 hashed device ID; IMEI when available, else MEID or ESN
-	Ext        Extension `json:"ext,omitempty"`
+	UA         string         `json:"ua,omitempty"`             // User agent
+	SUA        *UserAgent     `json:"sua,omitempty"`            // Structured user agent information, preferred over ua (Spec 2.6)
+	Geo        *Geo           `json:"geo,omitempty"`            // Location of the device assumed to be the user’s current location
+	DNT        int            `json:"dnt,omitempty"`            // "1": Do not track
+	LMT        int            `json:"lmt,omitempty"`            // "1": Limit Ad Tracking
+	IP         string         `json:"ip,omitempty"`             // IPv4
+	IPv6       string         `json:"ipv6,omitempty"`           // IPv6
+	DeviceType DeviceType     `json:"devicetype,omitempty"`     // The general type of device.
+	Make       string         `json:"make,omitempty"`           // Device make
+	Model      string         `json:"model,omitempty"`          // Device model
+	OS         string         `json:"os,omitempty"`             // Device OS
+	OSVer      string         `json:"osv,omitempty"`            // Device OS version
+	HwVer      string         `json:"hwv,omitempty"`            // Hardware version of the device (e.g., "5S" for iPhone 5S).
+	H          int            `json:"h,omitempty"`              // Physical height of the screen in pixels.
+	W          int            `json:"w,omitempty"`              // Physical width of the screen in pixels.
+	PPI        int            `json:"ppi,omitempty"`            // Screen size as pixels per linear inch.
+	PxRatio    float64        `json:"pxratio,omitempty"`        // The ratio of physical pixels to device independent pixels.
+	JS         int            `json:"js,omitempty"`             // Javascript status ("0": Disabled, "1": Enabled)
+	GeoFetch   int            `json:"geofetch,omitempty"`       // Indicates if the geolocation API will be available to JavaScript code running in the banner,
+	FlashVer   string         `json:"flashver,omitempty"`       // Flash version
+	Language   string         `json:"language,omitempty"`       // Browser language
+	Carrier    string         `json:"carrier,omitempty"`        // Carrier or ISP derived from the IP address
+	MCCMNC     string         `json:"mccmnc,omitempty"`         // Mobile carrier as the concatenated MCC-MNC code (e.g., "310-005" identifies Verizon Wireless CDMA in the USA).
+	ConnType   ConnectionType `json:"connectiontype,omitempty"` // Network connection type.
+	IFA        string         `json:"ifa,omitempty"`            // Native identifier for advertisers
+	IDSHA1     string         `json:"didsha1,omitempty"`        // SHA1 hashed device ID
+	IDMD5      string         `json:"didmd5,omitempty"`         // MD5 hashed device ID
+	PIDSHA1    string         `json:"dpidsha1,omitempty"`       // SHA1 hashed platform device ID
+	PIDMD5     string         `json:"dpidmd5,omitempty"`        // MD5 hashed platform device ID
+	MacSHA1    string         `json:"macsha1,omitempty"`        // SHA1 hashed device ID; IMEI when available, else MEID or ESN
+	MacMD5     string         `json:"macmd5,omitempty"`         // MD5 hashed device ID; IMEI when available, else MEID or ESN
+	Ext        Extension      `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 func (d *Device) Reset() {
+	d.unknown = nil
 	d.MacMD5 = ""
 	d.MacSHA1 = ""
 	if d.Ext != nil {
@@ -63,8 +84,14 @@ func (d *Device) Reset() {
 	d.JS = 0
 	d.GeoFetch = 0
 	d.UA = ""
+	d.Language = ""
+	if d.SUA != nil {
+		FreeUserAgent(d.SUA)
+		d.SUA = nil
+	}
 	if d.Geo != nil {
-		d.Geo.Reset()
+		FreeGeo(d.Geo)
+		d.Geo = nil
 	}
 	d.DNT = 0
 	d.LMT = 0
@@ -76,3 +103,67 @@ func (d *Device) Reset() {
 	d.OS = ""
 	d.OSVer = ""
 }
+
+var devicePool = sync.Pool{
+	New: func() interface{} {
+		return new(Device)
+	},
+}
+
+func NewDevice() *Device {
+	return devicePool.Get().(*Device)
+}
+
+func FreeDevice(d *Device) {
+	if d == nil {
+		return
+	}
+	d.Reset()
+	devicePool.Put(d)
+}
+
+// Validates the object
+func (d *Device) Validate() error {
+	return d.ValidateAll().First()
+}
+
+// ValidateAll validates the object and returns all issues found.
+func (d *Device) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	d.validate(v, "")
+	return v.errs
+}
+
+func (d *Device) validate(v *Validator, path string) {
+	if d.IP != "" {
+		if ip := net.ParseIP(d.IP); ip == nil || ip.To4() == nil {
+			v.AddError(PathKey(path, "ip"), ErrInvalidDeviceIP)
+		}
+	}
+	if d.IPv6 != "" {
+		if ip := net.ParseIP(d.IPv6); ip == nil || ip.To4() != nil {
+			v.AddError(PathKey(path, "ipv6"), ErrInvalidDeviceIPv6)
+		}
+	}
+	if !d.DeviceType.IsValid() {
+		v.AddError(PathKey(path, "devicetype"), ErrInvalidDeviceType)
+	}
+	if !d.ConnType.IsValid() {
+		v.AddError(PathKey(path, "connectiontype"), ErrInvalidDeviceConnType)
+	}
+	if d.Geo != nil {
+		d.Geo.validate(v, PathKey(path, "geo"))
+	}
+	if d.SUA != nil {
+		d.SUA.validate(v, PathKey(path, "sua"))
+	}
+
+	v.since(Version2_6, d.SUA != nil, PathKey(path, "sua"))
+	v.deprecated(Version2_6, d.FlashVer != "", PathKey(path, "flashver"))
+	v.deprecated(Version2_6, d.IDSHA1 != "", PathKey(path, "didsha1"))
+	v.deprecated(Version2_6, d.IDMD5 != "", PathKey(path, "didmd5"))
+	v.deprecated(Version2_6, d.PIDSHA1 != "", PathKey(path, "dpidsha1"))
+	v.deprecated(Version2_6, d.PIDMD5 != "", PathKey(path, "dpidmd5"))
+	v.deprecated(Version2_6, d.MacSHA1 != "", PathKey(path, "macsha1"))
+	v.deprecated(Version2_6, d.MacMD5 != "", PathKey(path, "macmd5"))
+}
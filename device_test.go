@@ -41,4 +41,23 @@ var _ = Describe("Device", func() {
 			DeviceType: DeviceTypeMobile,
 		}))
 	})
+
+	It("should validate", func() {
+		Expect(subject.Validate()).NotTo(HaveOccurred())
+		Expect((&Device{IP: "::1"}).Validate()).To(Equal(ErrInvalidDeviceIP))
+		Expect((&Device{IPv6: "10.0.0.1"}).Validate()).To(Equal(ErrInvalidDeviceIPv6))
+		Expect((&Device{DeviceType: 9}).Validate()).To(Equal(ErrInvalidDeviceType))
+		Expect((&Device{ConnType: -1}).Validate()).To(Equal(ErrInvalidDeviceConnType))
+
+		errs := (&Device{Geo: &Geo{Lat: 91, Country: "US"}}).ValidateAll()
+		Expect(errs).To(HaveLen(2))
+		Expect(errs[0].Path).To(Equal("geo.lat"))
+		Expect(errs[1].Path).To(Equal("geo.country"))
+
+		errs = (&Device{SUA: &UserAgent{Browsers: []BrandVersion{{Version: []string{"1"}}}, Mobile: iptr(2), Source: 4}}).ValidateAll()
+		Expect(errs).To(HaveLen(3))
+		Expect(errs[0].Err).To(Equal(ErrInvalidDeviceSUASource))
+		Expect(errs[1].Err).To(Equal(ErrInvalidDeviceSUAMobile))
+		Expect(errs[2].Path).To(Equal("sua.browsers[0].brand"))
+	})
 })
@@ -0,0 +1,107 @@
+package openrtb
+
+//go:generate go run gen_ffjson.go
+
+// Extended identifiers support in the OpenRTB specification allows buyers to use audience data in
+// real-time bidding. An EID carries the user IDs issued by a single source, e.g. an identity
+// provider such as UID2, ID5 or LiveRamp.
+type EID struct {
+	Source string    `json:"source"`        // Source or technology provider responsible for the set of included IDs, expressed as a top-level domain.
+	UIDs   []UID     `json:"uids"`          // Array of extended ID UID objects from the given source.
+	Ext    Extension `json:"ext,omitempty"` // Placeholder for advertising-system specific extensions to this object.
+
+	unknown []byte
+}
+
+func (e *EID) Reset() {
+	e.unknown = nil
+	e.Source = ""
+	if e.UIDs != nil {
+		for i := 0; i < len(e.UIDs); i++ {
+			(&e.UIDs[i]).Reset()
+		}
+		e.UIDs = e.UIDs[:0]
+	}
+	if e.Ext != nil {
+		e.Ext = e.Ext[:0]
+	}
+}
+
+func (e *EID) validate(v *Validator, path string) {
+	if e.Source == "" {
+		v.AddError(PathKey(path, "source"), ErrInvalidUserEIDSource)
+	}
+	if len(e.UIDs) == 0 {
+		v.AddError(PathKey(path, "uids"), ErrInvalidUserEIDUID)
+	}
+}
+
+func (e *EID) hasUID(id string) bool {
+	for _, uid := range e.UIDs {
+		if uid.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// UID is a single user identifier provided as part of extended identifiers.
+type UID struct {
+	ID    string    `json:"id"`              // The identifier for the user.
+	AType AgentType `json:"atype,omitempty"` // Type of user agent the ID is from, see AgentType*.
+	Ext   Extension `json:"ext,omitempty"`   // Placeholder for advertising-system specific extensions to this object.
+
+	unknown []byte
+}
+
+func (u *UID) Reset() {
+	u.unknown = nil
+	u.ID = ""
+	u.AType = 0
+	if u.Ext != nil {
+		u.Ext = u.Ext[:0]
+	}
+}
+
+// MergeEIDs merges extended identifiers received from several upstreams
+// into a single list with one entry per source. User IDs are de-duplicated
+// by value; the first occurrence wins.
+func MergeEIDs(lists ...[]EID) []EID {
+	var merged []EID
+	for _, eids := range lists {
+		for _, eid := range eids {
+			pos := -1
+			for i := range merged {
+				if merged[i].Source == eid.Source {
+					pos = i
+					break
+				}
+			}
+			if pos < 0 {
+				merged = append(merged, EID{Source: eid.Source, Ext: append(Extension(nil), eid.Ext...)})
+				pos = len(merged) - 1
+			}
+
+			target := &merged[pos]
+			for _, uid := range eid.UIDs {
+				if !target.hasUID(uid.ID) {
+					uid.Ext = append(Extension(nil), uid.Ext...)
+					target.UIDs = append(target.UIDs, uid)
+				}
+			}
+		}
+	}
+	return merged
+}
+
+// FilterEIDs returns the extended identifiers whose source is included in
+// allowed, e.g. to restrict the sources that may be forwarded to a bidder.
+func FilterEIDs(eids []EID, allowed []string) []EID {
+	var filtered []EID
+	for _, eid := range eids {
+		if containsString(allowed, eid.Source) {
+			filtered = append(filtered, eid)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,35 @@
+package openrtb
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EID", func() {
+	a := []EID{
+		{Source: "id5-sync.com", UIDs: []UID{{ID: "ID5*A", AType: AgentTypeDevice}}},
+		{Source: "uidapi.com", UIDs: []UID{{ID: "U2", AType: AgentTypePerson}}},
+	}
+	b := []EID{
+		{Source: "uidapi.com", UIDs: []UID{{ID: "U2", AType: AgentTypeOther}, {ID: "U3", AType: AgentTypePerson}}},
+		{Source: "liveramp.com", UIDs: []UID{{ID: "LR", AType: AgentTypePerson}}},
+	}
+
+	It("should merge lists", func() {
+		Expect(MergeEIDs(a, b)).To(Equal([]EID{
+			{Source: "id5-sync.com", UIDs: []UID{{ID: "ID5*A", AType: AgentTypeDevice}}},
+			{Source: "uidapi.com", UIDs: []UID{{ID: "U2", AType: AgentTypePerson}, {ID: "U3", AType: AgentTypePerson}}},
+			{Source: "liveramp.com", UIDs: []UID{{ID: "LR", AType: AgentTypePerson}}},
+		}))
+		Expect(MergeEIDs()).To(BeNil())
+		Expect(a[1].UIDs).To(HaveLen(1))
+	})
+
+	It("should filter by source", func() {
+		Expect(FilterEIDs(b, []string{"liveramp.com", "id5-sync.com"})).To(Equal([]EID{
+			{Source: "liveramp.com", UIDs: []UID{{ID: "LR", AType: AgentTypePerson}}},
+		}))
+		Expect(FilterEIDs(b, nil)).To(BeNil())
+	})
+
+})
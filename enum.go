@@ -0,0 +1,868 @@
+package openrtb
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidEnumName is returned when an enumeration name cannot be parsed
+var ErrInvalidEnumName = errors.New("openrtb: unknown enumeration name")
+
+// enumNames maps the values of an enumeration to their names.
+type enumNames map[int]string
+
+func (m enumNames) name(typ string, n int) string {
+	if s, ok := m[n]; ok {
+		return s
+	}
+	return typ + "(" + strconv.Itoa(n) + ")"
+}
+
+func (m enumNames) valid(n int) bool {
+	_, ok := m[n]
+	return ok
+}
+
+// parse looks up a value by name, ignoring case.
+func (m enumNames) parse(s string) (int, error) {
+	for n, name := range m {
+		if strings.EqualFold(name, s) {
+			return n, nil
+		}
+	}
+	return 0, ErrInvalidEnumName
+}
+
+// MarshalNamed returns the JSON encoding of v, like json.Marshal, but renders
+// values of enumeration types by name, e.g. {"devicetype":"Mobile"}. Values
+// without a name are rendered as numbers. The output is meant for logs and
+// debugging only; it is not valid OpenRTB.
+func MarshalNamed(v interface{}) ([]byte, error) {
+	return json.Marshal(namedValue(reflect.ValueOf(v)))
+}
+
+func namedValue(rv reflect.Value) interface{} {
+	if !rv.IsValid() {
+		return nil
+	}
+	if names, ok := enumTypes[rv.Type()]; ok {
+		if s, ok := names[int(rv.Int())]; ok {
+			return s
+		}
+		return rv.Int()
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return namedValue(rv.Elem())
+	case reflect.Struct:
+		m := make(map[string]interface{}, rv.NumField())
+		namedFields(rv, m)
+		return m
+	case reflect.Slice:
+		if rv.IsNil() {
+			return nil
+		}
+		if m, ok := rv.Interface().(json.Marshaler); ok {
+			return m
+		}
+		fallthrough
+	case reflect.Array:
+		list := make([]interface{}, rv.Len())
+		for i := range list {
+			list[i] = namedValue(rv.Index(i))
+		}
+		return list
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil
+		}
+		m := make(map[string]interface{}, rv.Len())
+		for _, key := range rv.MapKeys() {
+			m[key.String()] = namedValue(rv.MapIndex(key))
+		}
+		return m
+	}
+	return rv.Interface()
+}
+
+// namedFields adds the JSON fields of a struct to m, flattening embedded structs.
+func namedFields(rv reflect.Value, m map[string]interface{}) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" || (sf.PkgPath != "" && !sf.Anonymous) {
+			continue
+		}
+
+		name, opts := tag, ""
+		if n := strings.IndexByte(tag, ','); n > -1 {
+			name, opts = tag[:n], tag[n+1:]
+		}
+
+		fv := rv.Field(i)
+		if sf.Anonymous && name == "" && fv.Kind() == reflect.Struct {
+			namedFields(fv, m)
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		if strings.Contains(opts, "omitempty") && isEmptyValue(fv) {
+			continue
+		}
+		m[name] = namedValue(fv)
+	}
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
+
+// enumTypes holds the names of all enumeration types, used by MarshalNamed.
+var enumTypes = map[reflect.Type]enumNames{
+	reflect.TypeOf(BannerType(0)):          bannerTypeNames,
+	reflect.TypeOf(CreativeAttribute(0)):   creativeAttributeNames,
+	reflect.TypeOf(AdPosition(0)):          adPositionNames,
+	reflect.TypeOf(ExpandableDirection(0)): expandableDirectionNames,
+	reflect.TypeOf(APIFramework(0)):        apiFrameworkNames,
+	reflect.TypeOf(VideoLinearity(0)):      videoLinearityNames,
+	reflect.TypeOf(Protocol(0)):            protocolNames,
+	reflect.TypeOf(PlaybackMethod(0)):      playbackMethodNames,
+	reflect.TypeOf(VideoPlacement(0)):      videoPlacementNames,
+	reflect.TypeOf(ProductionQuality(0)):   productionQualityNames,
+	reflect.TypeOf(CompanionType(0)):       companionTypeNames,
+	reflect.TypeOf(ContentDelivery(0)):     contentDeliveryNames,
+	reflect.TypeOf(ContentContext(0)):      contentContextNames,
+	reflect.TypeOf(QAGMediaRating(0)):      qagMediaRatingNames,
+	reflect.TypeOf(LocationType(0)):        locationTypeNames,
+	reflect.TypeOf(LocationService(0)):     locationServiceNames,
+	reflect.TypeOf(DeviceType(0)):          deviceTypeNames,
+	reflect.TypeOf(ConnectionType(0)):      connectionTypeNames,
+	reflect.TypeOf(NoBidReason(0)):         noBidReasonNames,
+	reflect.TypeOf(LossReason(0)):          lossReasonNames,
+	reflect.TypeOf(CategoryTaxonomy(0)):    categoryTaxonomyNames,
+	reflect.TypeOf(VideoPlcmt(0)):          videoPlcmtNames,
+	reflect.TypeOf(PodSequence(0)):         podSequenceNames,
+	reflect.TypeOf(SlotPosition(0)):        slotPositionNames,
+	reflect.TypeOf(MarkupType(0)):          markupTypeNames,
+	reflect.TypeOf(SSAIType(0)):            ssaiTypeNames,
+	reflect.TypeOf(QtySource(0)):           qtySourceNames,
+	reflect.TypeOf(FeedType(0)):            feedTypeNames,
+	reflect.TypeOf(VolumeNormalization(0)): volumeNormalizationNames,
+	reflect.TypeOf(UASource(0)):            uaSourceNames,
+	reflect.TypeOf(AgentType(0)):           agentTypeNames,
+}
+
+var bannerTypeNames = enumNames{
+	int(BannerTypeXHTMLText): "XHTMLText",
+	int(BannerTypeXHTML):     "XHTML",
+	int(BannerTypeJS):        "JS",
+	int(BannerTypeFrame):     "Frame",
+}
+
+// String returns the name of the banner type, e.g. "XHTMLText".
+func (b BannerType) String() string { return bannerTypeNames.name("BannerType", int(b)) }
+
+// IsValid returns true if the banner type is defined by the specification.
+func (b BannerType) IsValid() bool { return bannerTypeNames.valid(int(b)) }
+
+// ParseBannerType parses a banner type from its name, e.g. "XHTMLText".
+func ParseBannerType(s string) (BannerType, error) {
+	n, err := bannerTypeNames.parse(s)
+	return BannerType(n), err
+}
+
+var creativeAttributeNames = enumNames{
+	int(CreativeAttributeAudioAdAutoPlay):                 "AudioAdAutoPlay",
+	int(CreativeAttributeAudioAdUserInitiated):            "AudioAdUserInitiated",
+	int(CreativeAttributeExpandableAuto):                  "ExpandableAuto",
+	int(CreativeAttributeExpandableUserInitiatedClick):    "ExpandableUserInitiatedClick",
+	int(CreativeAttributeExpandableUserInitiatedRollover): "ExpandableUserInitiatedRollover",
+	int(CreativeAttributeInBannerVideoAdAutoPlay):         "InBannerVideoAdAutoPlay",
+	int(CreativeAttributeInBannerVideoAdUserInitiated):    "InBannerVideoAdUserInitiated",
+	int(CreativeAttributePop):                             "Pop",
+	int(CreativeAttributeProvocativeOrSuggestiveImagery):  "ProvocativeOrSuggestiveImagery",
+	int(CreativeAttributeExtremeAnimation):                "ExtremeAnimation",
+	int(CreativeAttributeSurveys):                         "Surveys",
+	int(CreativeAttributeTextOnly):                        "TextOnly",
+	int(CreativeAttributeUserInitiated):                   "UserInitiated",
+	int(CreativeAttributeWindowsDialogOrAlert):            "WindowsDialogOrAlert",
+	int(CreativeAttributeHasAudioWithPlayer):              "HasAudioWithPlayer",
+	int(CreativeAttributeAdProvidesSkipButton):            "AdProvidesSkipButton",
+	int(CreativeAttributeAdobeFlash):                      "AdobeFlash",
+}
+
+// String returns the name of the creative attribute, e.g. "AudioAdAutoPlay".
+func (c CreativeAttribute) String() string {
+	return creativeAttributeNames.name("CreativeAttribute", int(c))
+}
+
+// IsValid returns true if the creative attribute is defined by the specification.
+func (c CreativeAttribute) IsValid() bool { return creativeAttributeNames.valid(int(c)) }
+
+// ParseCreativeAttribute parses a creative attribute from its name, e.g. "AudioAdAutoPlay".
+func ParseCreativeAttribute(s string) (CreativeAttribute, error) {
+	n, err := creativeAttributeNames.parse(s)
+	return CreativeAttribute(n), err
+}
+
+var adPositionNames = enumNames{
+	int(AdPosUnknown):      "Unknown",
+	int(AdPosAboveFold):    "AboveFold",
+	int(AdPosMaybeVisible): "MaybeVisible",
+	int(AdPosBelowFold):    "BelowFold",
+	int(AdPosHeader):       "Header",
+	int(AdPosFooter):       "Footer",
+	int(AdPosSidebar):      "Sidebar",
+	int(AdPosFullscreen):   "Fullscreen",
+}
+
+// String returns the name of the ad position, e.g. "AboveFold".
+func (a AdPosition) String() string { return adPositionNames.name("AdPosition", int(a)) }
+
+// IsValid returns true if the ad position is defined by the specification.
+func (a AdPosition) IsValid() bool { return adPositionNames.valid(int(a)) }
+
+// ParseAdPosition parses an ad position from its name, e.g. "AboveFold".
+func ParseAdPosition(s string) (AdPosition, error) {
+	n, err := adPositionNames.parse(s)
+	return AdPosition(n), err
+}
+
+var expandableDirectionNames = enumNames{
+	int(ExpDirLeft):           "Left",
+	int(ExpDirRight):          "Right",
+	int(ExpDirUp):             "Up",
+	int(ExpDirDown):           "Down",
+	int(ExpDirFullScreen):     "FullScreen",
+	int(ExpDirResizeMinimize): "ResizeMinimize",
+}
+
+// String returns the name of the expandable direction, e.g. "Left".
+func (e ExpandableDirection) String() string {
+	return expandableDirectionNames.name("ExpandableDirection", int(e))
+}
+
+// IsValid returns true if the expandable direction is defined by the specification.
+func (e ExpandableDirection) IsValid() bool { return expandableDirectionNames.valid(int(e)) }
+
+// ParseExpandableDirection parses an expandable direction from its name, e.g. "Left".
+func ParseExpandableDirection(s string) (ExpandableDirection, error) {
+	n, err := expandableDirectionNames.parse(s)
+	return ExpandableDirection(n), err
+}
+
+var apiFrameworkNames = enumNames{
+	int(APIFrameworkVPAID1):   "VPAID1",
+	int(APIFrameworkVPAID2):   "VPAID2",
+	int(APIFrameworkMRAID1):   "MRAID1",
+	int(APIFrameworkORMMA):    "ORMMA",
+	int(APIFrameworkMRAID2):   "MRAID2",
+	int(APIFrameworkMRAID3):   "MRAID3",
+	int(APIFrameworkOMID1):    "OMID1",
+	int(APIFrameworkSIMID1):   "SIMID1",
+	int(APIFrameworkSIMID1_1): "SIMID1_1",
+}
+
+// String returns the name of the API framework, e.g. "VPAID1".
+func (a APIFramework) String() string { return apiFrameworkNames.name("APIFramework", int(a)) }
+
+// IsValid returns true if the API framework is defined by the specification
+// or in the exchange-specific range of 500 and above.
+func (a APIFramework) IsValid() bool { return apiFrameworkNames.valid(int(a)) || a >= 500 }
+
+// ParseAPIFramework parses an API framework from its name, e.g. "VPAID1".
+func ParseAPIFramework(s string) (APIFramework, error) {
+	n, err := apiFrameworkNames.parse(s)
+	return APIFramework(n), err
+}
+
+var videoLinearityNames = enumNames{
+	int(VideoLinearityLinear):    "Linear",
+	int(VideoLinearityNonLinear): "NonLinear",
+}
+
+// String returns the name of the video linearity, e.g. "Linear".
+func (v VideoLinearity) String() string { return videoLinearityNames.name("VideoLinearity", int(v)) }
+
+// IsValid returns true if the video linearity is defined by the specification.
+func (v VideoLinearity) IsValid() bool { return videoLinearityNames.valid(int(v)) }
+
+// ParseVideoLinearity parses a video linearity from its name, e.g. "Linear".
+func ParseVideoLinearity(s string) (VideoLinearity, error) {
+	n, err := videoLinearityNames.parse(s)
+	return VideoLinearity(n), err
+}
+
+var protocolNames = enumNames{
+	int(VideoProtoVAST1):            "VAST1",
+	int(VideoProtoVAST2):            "VAST2",
+	int(VideoProtoVAST3):            "VAST3",
+	int(VideoProtoVAST1Wrapper):     "VAST1Wrapper",
+	int(VideoProtoVAST2Wrapper):     "VAST2Wrapper",
+	int(VideoProtoVAST3Wrapper):     "VAST3Wrapper",
+	int(VideoProtoVAST4):            "VAST4",
+	int(VideoProtoVAST4Wrapper):     "VAST4Wrapper",
+	int(AudioProtocolDAAST1):        "DAAST1",
+	int(AudioProtocolDAAST1Wrapper): "DAAST1Wrapper",
+	int(VideoProtoVAST41):           "VAST41",
+	int(VideoProtoVAST41Wrapper):    "VAST41Wrapper",
+	int(VideoProtoVAST42):           "VAST42",
+	int(VideoProtoVAST42Wrapper):    "VAST42Wrapper",
+}
+
+// String returns the name of the protocol, e.g. "VAST1".
+func (p Protocol) String() string { return protocolNames.name("Protocol", int(p)) }
+
+// IsValid returns true if the protocol is defined by the specification
+// or in the exchange-specific range of 500 and above.
+func (p Protocol) IsValid() bool { return protocolNames.valid(int(p)) || p >= 500 }
+
+// ParseProtocol parses a protocol from its name, e.g. "VAST1".
+func ParseProtocol(s string) (Protocol, error) {
+	n, err := protocolNames.parse(s)
+	return Protocol(n), err
+}
+
+var playbackMethodNames = enumNames{
+	int(VideoPlaybackAutoSoundOn):      "AutoSoundOn",
+	int(VideoPlaybackAutoSoundOff):     "AutoSoundOff",
+	int(VideoPlaybackClickToPlay):      "ClickToPlay",
+	int(VideoPlaybackMouseOver):        "MouseOver",
+	int(VideoPlaybackViewportSoundOn):  "ViewportSoundOn",
+	int(VideoPlaybackViewportSoundOff): "ViewportSoundOff",
+}
+
+// String returns the name of the playback method, e.g. "AutoSoundOn".
+func (p PlaybackMethod) String() string { return playbackMethodNames.name("PlaybackMethod", int(p)) }
+
+// IsValid returns true if the playback method is defined by the specification.
+func (p PlaybackMethod) IsValid() bool { return playbackMethodNames.valid(int(p)) }
+
+// ParsePlaybackMethod parses a playback method from its name, e.g. "AutoSoundOn".
+func ParsePlaybackMethod(s string) (PlaybackMethod, error) {
+	n, err := playbackMethodNames.parse(s)
+	return PlaybackMethod(n), err
+}
+
+var videoPlacementNames = enumNames{
+	int(VideoPlacementInStream):     "InStream",
+	int(VideoPlacementInBanner):     "InBanner",
+	int(VideoPlacementInArticle):    "InArticle",
+	int(VideoPlacementInFeed):       "InFeed",
+	int(VideoPlacementInterstitial): "Interstitial",
+}
+
+// String returns the name of the video placement, e.g. "InStream".
+func (v VideoPlacement) String() string { return videoPlacementNames.name("VideoPlacement", int(v)) }
+
+// IsValid returns true if the video placement is defined by the specification.
+func (v VideoPlacement) IsValid() bool { return videoPlacementNames.valid(int(v)) }
+
+// ParseVideoPlacement parses a video placement from its name, e.g. "InStream".
+func ParseVideoPlacement(s string) (VideoPlacement, error) {
+	n, err := videoPlacementNames.parse(s)
+	return VideoPlacement(n), err
+}
+
+var productionQualityNames = enumNames{
+	int(VideoQualityUnknown):      "Unknown",
+	int(VideoQualityProfessional): "Professional",
+	int(VideoQualityProsumer):     "Prosumer",
+	int(VideoQualityUGC):          "UGC",
+}
+
+// String returns the name of the production quality, e.g. "Professional".
+func (p ProductionQuality) String() string {
+	return productionQualityNames.name("ProductionQuality", int(p))
+}
+
+// IsValid returns true if the production quality is defined by the specification.
+func (p ProductionQuality) IsValid() bool { return productionQualityNames.valid(int(p)) }
+
+// ParseProductionQuality parses a production quality from its name, e.g. "Professional".
+func ParseProductionQuality(s string) (ProductionQuality, error) {
+	n, err := productionQualityNames.parse(s)
+	return ProductionQuality(n), err
+}
+
+var companionTypeNames = enumNames{
+	int(VASTCompanionStatic): "Static",
+	int(VASTCompanionHTML):   "HTML",
+	int(VASTCompanionIFrame): "IFrame",
+}
+
+// String returns the name of the companion type, e.g. "Static".
+func (c CompanionType) String() string { return companionTypeNames.name("CompanionType", int(c)) }
+
+// IsValid returns true if the companion type is defined by the specification.
+func (c CompanionType) IsValid() bool { return companionTypeNames.valid(int(c)) }
+
+// ParseCompanionType parses a companion type from its name, e.g. "Static".
+func ParseCompanionType(s string) (CompanionType, error) {
+	n, err := companionTypeNames.parse(s)
+	return CompanionType(n), err
+}
+
+var contentDeliveryNames = enumNames{
+	int(ContentDeliveryStreaming):   "Streaming",
+	int(ContentDeliveryProgressive): "Progressive",
+	int(ContentDeliveryDownload):    "Download",
+}
+
+// String returns the name of the content delivery, e.g. "Streaming".
+func (c ContentDelivery) String() string { return contentDeliveryNames.name("ContentDelivery", int(c)) }
+
+// IsValid returns true if the content delivery is defined by the specification.
+func (c ContentDelivery) IsValid() bool { return contentDeliveryNames.valid(int(c)) }
+
+// ParseContentDelivery parses a content delivery from its name, e.g. "Streaming".
+func ParseContentDelivery(s string) (ContentDelivery, error) {
+	n, err := contentDeliveryNames.parse(s)
+	return ContentDelivery(n), err
+}
+
+var contentContextNames = enumNames{
+	int(ContextVideo):       "Video",
+	int(ContextGame):        "Game",
+	int(ContextMusic):       "Music",
+	int(ContextApplication): "Application",
+	int(ContextText):        "Text",
+	int(ContextOther):       "Other",
+	int(ContextUnknown):     "Unknown",
+}
+
+// String returns the name of the content context, e.g. "Video".
+func (c ContentContext) String() string { return contentContextNames.name("ContentContext", int(c)) }
+
+// IsValid returns true if the content context is defined by the specification.
+func (c ContentContext) IsValid() bool { return contentContextNames.valid(int(c)) }
+
+// ParseContentContext parses a content context from its name, e.g. "Video".
+func ParseContentContext(s string) (ContentContext, error) {
+	n, err := contentContextNames.parse(s)
+	return ContentContext(n), err
+}
+
+var qagMediaRatingNames = enumNames{
+	int(QAGAll):    "All",
+	int(QAGOver12): "Over12",
+	int(QAGMature): "Mature",
+}
+
+// String returns the name of the QAG media rating, e.g. "All".
+func (q QAGMediaRating) String() string { return qagMediaRatingNames.name("QAGMediaRating", int(q)) }
+
+// IsValid returns true if the QAG media rating is defined by the specification.
+func (q QAGMediaRating) IsValid() bool { return qagMediaRatingNames.valid(int(q)) }
+
+// ParseQAGMediaRating parses a QAG media rating from its name, e.g. "All".
+func ParseQAGMediaRating(s string) (QAGMediaRating, error) {
+	n, err := qagMediaRatingNames.parse(s)
+	return QAGMediaRating(n), err
+}
+
+var locationTypeNames = enumNames{
+	int(LocationTypeGPS):  "GPS",
+	int(LocationTypeIP):   "IP",
+	int(LocationTypeUser): "User",
+}
+
+// String returns the name of the location type, e.g. "GPS".
+func (l LocationType) String() string { return locationTypeNames.name("LocationType", int(l)) }
+
+// IsValid returns true if the location type is defined by the specification.
+func (l LocationType) IsValid() bool { return locationTypeNames.valid(int(l)) }
+
+// ParseLocationType parses a location type from its name, e.g. "GPS".
+func ParseLocationType(s string) (LocationType, error) {
+	n, err := locationTypeNames.parse(s)
+	return LocationType(n), err
+}
+
+var locationServiceNames = enumNames{
+	int(LocationServiceIP2Location): "IP2Location",
+	int(LocationServiceNeustar):     "Neustar",
+	int(LocationServiceMaxMind):     "MaxMind",
+	int(LocationServiceNetAcuity):   "NetAcuity",
+}
+
+// String returns the name of the location service, e.g. "IP2Location".
+func (l LocationService) String() string { return locationServiceNames.name("LocationService", int(l)) }
+
+// IsValid returns true if the location service is defined by the specification.
+func (l LocationService) IsValid() bool { return locationServiceNames.valid(int(l)) }
+
+// ParseLocationService parses a location service from its name, e.g. "IP2Location".
+func ParseLocationService(s string) (LocationService, error) {
+	n, err := locationServiceNames.parse(s)
+	return LocationService(n), err
+}
+
+var deviceTypeNames = enumNames{
+	int(DeviceTypeUnknown):   "Unknown",
+	int(DeviceTypeMobile):    "Mobile",
+	int(DeviceTypePC):        "PC",
+	int(DeviceTypeTV):        "TV",
+	int(DeviceTypePhone):     "Phone",
+	int(DeviceTypeTablet):    "Tablet",
+	int(DeviceTypeConnected): "Connected",
+	int(DeviceTypeSetTopBox): "SetTopBox",
+	int(DeviceTypeOOH):       "OOH",
+}
+
+// String returns the name of the device type, e.g. "Mobile".
+func (d DeviceType) String() string { return deviceTypeNames.name("DeviceType", int(d)) }
+
+// IsValid returns true if the device type is defined by the specification.
+func (d DeviceType) IsValid() bool { return deviceTypeNames.valid(int(d)) }
+
+// ParseDeviceType parses a device type from its name, e.g. "Mobile".
+func ParseDeviceType(s string) (DeviceType, error) {
+	n, err := deviceTypeNames.parse(s)
+	return DeviceType(n), err
+}
+
+var connectionTypeNames = enumNames{
+	int(ConnTypeUnknown):  "Unknown",
+	int(ConnTypeEthernet): "Ethernet",
+	int(ConnTypeWIFI):     "WIFI",
+	int(ConnTypeCell):     "Cell",
+	int(ConnTypeCell2G):   "Cell2G",
+	int(ConnTypeCell3G):   "Cell3G",
+	int(ConnTypeCell4G):   "Cell4G",
+	int(ConnTypeCell5G):   "Cell5G",
+}
+
+// String returns the name of the connection type, e.g. "Ethernet".
+func (c ConnectionType) String() string { return connectionTypeNames.name("ConnectionType", int(c)) }
+
+// IsValid returns true if the connection type is defined by the specification.
+func (c ConnectionType) IsValid() bool { return connectionTypeNames.valid(int(c)) }
+
+// ParseConnectionType parses a connection type from its name, e.g. "Ethernet".
+func ParseConnectionType(s string) (ConnectionType, error) {
+	n, err := connectionTypeNames.parse(s)
+	return ConnectionType(n), err
+}
+
+var noBidReasonNames = enumNames{
+	int(NBRUnknownError):       "UnknownError",
+	int(NBRTechnicalError):     "TechnicalError",
+	int(NBRInvalidRequest):     "InvalidRequest",
+	int(NBRKnownSpider):        "KnownSpider",
+	int(NBRSuspectedNonHuman):  "SuspectedNonHuman",
+	int(NBRProxyIP):            "ProxyIP",
+	int(NBRUnsupportedDevice):  "UnsupportedDevice",
+	int(NBRBlockedSite):        "BlockedSite",
+	int(NBRUnmatchedUser):      "UnmatchedUser",
+	int(NBRDailyUserCapMet):    "DailyUserCapMet",
+	int(NBRDailyDomainCapMet):  "DailyDomainCapMet",
+	int(NBRAdsTxtUnavailable):  "AdsTxtUnavailable",
+	int(NBRAdsTxtViolation):    "AdsTxtViolation",
+	int(NBRAdsCertUnavailable): "AdsCertUnavailable",
+	int(NBRAdsCertViolation):   "AdsCertViolation",
+	int(NBRInsufficientTime):   "InsufficientTime",
+	int(NBRIncompleteSChain):   "IncompleteSChain",
+	int(NBRBlockedSChainNode):  "BlockedSChainNode",
+}
+
+// String returns the name of the no-bid reason, e.g. "TechnicalError".
+func (n NoBidReason) String() string { return noBidReasonNames.name("NoBidReason", int(n)) }
+
+// IsValid returns true if the no-bid reason is defined by the specification
+// or in the exchange-specific range of 500 and above.
+func (n NoBidReason) IsValid() bool { return noBidReasonNames.valid(int(n)) || n >= 500 }
+
+// ParseNoBidReason parses a no-bid reason from its name, e.g. "TechnicalError".
+func ParseNoBidReason(s string) (NoBidReason, error) {
+	n, err := noBidReasonNames.parse(s)
+	return NoBidReason(n), err
+}
+
+var lossReasonNames = enumNames{
+	int(LossBidWon):               "BidWon",
+	int(LossInternalError):        "InternalError",
+	int(LossExpired):              "Expired",
+	int(LossInvalidResponse):      "InvalidResponse",
+	int(LossInvalidDealID):        "InvalidDealID",
+	int(LossInvalidAuctionID):     "InvalidAuctionID",
+	int(LossInvalidAdvDomain):     "InvalidAdvDomain",
+	int(LossMissingMarkup):        "MissingMarkup",
+	int(LossMissingCreativeID):    "MissingCreativeID",
+	int(LossMissingPrice):         "MissingPrice",
+	int(LossMissingApprovalData):  "MissingApprovalData",
+	int(LossBelowAuctionFloor):    "BelowAuctionFloor",
+	int(LossBelowDealFloor):       "BelowDealFloor",
+	int(LossLostToHigherBid):      "LostToHigherBid",
+	int(LossLostToDeal):           "LostToDeal",
+	int(LossSeatBlocked):          "SeatBlocked",
+	int(LossCreativeFiltered):     "CreativeFiltered",
+	int(LossCreativePending):      "CreativePending",
+	int(LossCreativeDisapproved):  "CreativeDisapproved",
+	int(LossCreativeSize):         "CreativeSize",
+	int(LossCreativeFormat):       "CreativeFormat",
+	int(LossCreativeAdvExcluded):  "CreativeAdvExcluded",
+	int(LossCreativeAppExcluded):  "CreativeAppExcluded",
+	int(LossCreativeNotSecure):    "CreativeNotSecure",
+	int(LossCreativeLangExcluded): "CreativeLangExcluded",
+	int(LossCreativeCatExcluded):  "CreativeCatExcluded",
+	int(LossCreativeAttrExcluded): "CreativeAttrExcluded",
+	int(LossCreativeTypeExcluded): "CreativeTypeExcluded",
+	int(LossCreativeAnimation):    "CreativeAnimation",
+	int(LossCreativeNotInDeal):    "CreativeNotInDeal",
+}
+
+// String returns the name of the loss reason, e.g. "InternalError".
+func (l LossReason) String() string { return lossReasonNames.name("LossReason", int(l)) }
+
+// IsValid returns true if the loss reason is defined by the specification
+// or in the exchange-specific range of 1000 and above.
+func (l LossReason) IsValid() bool { return lossReasonNames.valid(int(l)) || l >= 1000 }
+
+// ParseLossReason parses a loss reason from its name, e.g. "InternalError".
+func ParseLossReason(s string) (LossReason, error) {
+	n, err := lossReasonNames.parse(s)
+	return LossReason(n), err
+}
+
+var categoryTaxonomyNames = enumNames{
+	int(CatTaxIABContent1_0):  "IABContent1_0",
+	int(CatTaxIABContent2_0):  "IABContent2_0",
+	int(CatTaxIABProduct1_0):  "IABProduct1_0",
+	int(CatTaxIABAudience1_1): "IABAudience1_1",
+	int(CatTaxIABContent2_1):  "IABContent2_1",
+	int(CatTaxIABContent2_2):  "IABContent2_2",
+	int(CatTaxIABContent3_0):  "IABContent3_0",
+	int(CatTaxIABProduct2_0):  "IABProduct2_0",
+}
+
+// String returns the name of the category taxonomy, e.g. "IABContent1_0".
+func (c CategoryTaxonomy) String() string {
+	return categoryTaxonomyNames.name("CategoryTaxonomy", int(c))
+}
+
+// IsValid returns true if the category taxonomy is defined by the specification
+// or in the exchange-specific range of 500 and above.
+func (c CategoryTaxonomy) IsValid() bool { return categoryTaxonomyNames.valid(int(c)) || c >= 500 }
+
+// ParseCategoryTaxonomy parses a category taxonomy from its name, e.g. "IABContent1_0".
+func ParseCategoryTaxonomy(s string) (CategoryTaxonomy, error) {
+	n, err := categoryTaxonomyNames.parse(s)
+	return CategoryTaxonomy(n), err
+}
+
+var videoPlcmtNames = enumNames{
+	int(VideoPlcmtInstream):     "Instream",
+	int(VideoPlcmtAccompanying): "Accompanying",
+	int(VideoPlcmtInterstitial): "Interstitial",
+	int(VideoPlcmtStandalone):   "Standalone",
+}
+
+// String returns the name of the video placement subtype, e.g. "Instream".
+func (v VideoPlcmt) String() string { return videoPlcmtNames.name("VideoPlcmt", int(v)) }
+
+// IsValid returns true if the video placement subtype is defined by the specification.
+func (v VideoPlcmt) IsValid() bool { return videoPlcmtNames.valid(int(v)) }
+
+// ParseVideoPlcmt parses a video placement subtype from its name, e.g. "Instream".
+func ParseVideoPlcmt(s string) (VideoPlcmt, error) {
+	n, err := videoPlcmtNames.parse(s)
+	return VideoPlcmt(n), err
+}
+
+var podSequenceNames = enumNames{
+	int(PodSeqLast):  "Last",
+	int(PodSeqAny):   "Any",
+	int(PodSeqFirst): "First",
+}
+
+// String returns the name of the pod sequence, e.g. "First".
+func (p PodSequence) String() string { return podSequenceNames.name("PodSequence", int(p)) }
+
+// IsValid returns true if the pod sequence is defined by the specification.
+func (p PodSequence) IsValid() bool { return podSequenceNames.valid(int(p)) }
+
+// ParsePodSequence parses a pod sequence from its name, e.g. "First".
+func ParsePodSequence(s string) (PodSequence, error) {
+	n, err := podSequenceNames.parse(s)
+	return PodSequence(n), err
+}
+
+var slotPositionNames = enumNames{
+	int(SlotInPodLast):        "Last",
+	int(SlotInPodAny):         "Any",
+	int(SlotInPodFirst):       "First",
+	int(SlotInPodFirstOrLast): "FirstOrLast",
+}
+
+// String returns the name of the slot position, e.g. "First".
+func (s SlotPosition) String() string { return slotPositionNames.name("SlotPosition", int(s)) }
+
+// IsValid returns true if the slot position is defined by the specification.
+func (s SlotPosition) IsValid() bool { return slotPositionNames.valid(int(s)) }
+
+// ParseSlotPosition parses a slot position from its name, e.g. "First".
+func ParseSlotPosition(s string) (SlotPosition, error) {
+	n, err := slotPositionNames.parse(s)
+	return SlotPosition(n), err
+}
+
+var markupTypeNames = enumNames{
+	int(MarkupBanner): "Banner",
+	int(MarkupVideo):  "Video",
+	int(MarkupAudio):  "Audio",
+	int(MarkupNative): "Native",
+}
+
+// String returns the name of the markup type, e.g. "Banner".
+func (m MarkupType) String() string { return markupTypeNames.name("MarkupType", int(m)) }
+
+// IsValid returns true if the markup type is defined by the specification.
+func (m MarkupType) IsValid() bool { return markupTypeNames.valid(int(m)) }
+
+// ParseMarkupType parses a markup type from its name, e.g. "Banner".
+func ParseMarkupType(s string) (MarkupType, error) {
+	n, err := markupTypeNames.parse(s)
+	return MarkupType(n), err
+}
+
+var ssaiTypeNames = enumNames{
+	int(SSAIUnknown):      "Unknown",
+	int(SSAIClientSide):   "ClientSide",
+	int(SSAIServerStitch): "ServerStitch",
+	int(SSAIServerAll):    "ServerAll",
+}
+
+// String returns the name of the SSAI type, e.g. "ClientSide".
+func (s SSAIType) String() string { return ssaiTypeNames.name("SSAIType", int(s)) }
+
+// IsValid returns true if the SSAI type is defined by the specification.
+func (s SSAIType) IsValid() bool { return ssaiTypeNames.valid(int(s)) }
+
+// ParseSSAIType parses an SSAI type from its name, e.g. "ClientSide".
+func ParseSSAIType(s string) (SSAIType, error) {
+	n, err := ssaiTypeNames.parse(s)
+	return SSAIType(n), err
+}
+
+var qtySourceNames = enumNames{
+	int(QtySourceMRCAccredited): "MRCAccredited",
+	int(QtySourcePublisher):     "Publisher",
+	int(QtySourceExchange):      "Exchange",
+}
+
+// String returns the name of the quantity source, e.g. "MRCAccredited".
+func (q QtySource) String() string { return qtySourceNames.name("QtySource", int(q)) }
+
+// IsValid returns true if the quantity source is defined by the specification.
+func (q QtySource) IsValid() bool { return qtySourceNames.valid(int(q)) }
+
+// ParseQtySource parses a quantity source from its name, e.g. "MRCAccredited".
+func ParseQtySource(s string) (QtySource, error) {
+	n, err := qtySourceNames.parse(s)
+	return QtySource(n), err
+}
+
+var feedTypeNames = enumNames{
+	int(FeedMusicService): "MusicService",
+	int(FeedBroadcast):    "Broadcast",
+	int(FeedPodcast):      "Podcast",
+	int(FeedCatchUpRadio): "CatchUpRadio",
+	int(FeedWebRadio):     "WebRadio",
+	int(FeedVideoGame):    "VideoGame",
+	int(FeedTextToSpeech): "TextToSpeech",
+}
+
+// String returns the name of the feed type, e.g. "MusicService".
+func (f FeedType) String() string { return feedTypeNames.name("FeedType", int(f)) }
+
+// IsValid returns true if the feed type is defined by the specification.
+func (f FeedType) IsValid() bool { return feedTypeNames.valid(int(f)) }
+
+// ParseFeedType parses a feed type from its name, e.g. "MusicService".
+func ParseFeedType(s string) (FeedType, error) {
+	n, err := feedTypeNames.parse(s)
+	return FeedType(n), err
+}
+
+var volumeNormalizationNames = enumNames{
+	int(NVolNone):     "None",
+	int(NVolAverage):  "Average",
+	int(NVolPeak):     "Peak",
+	int(NVolLoudness): "Loudness",
+	int(NVolCustom):   "Custom",
+}
+
+// String returns the name of the volume normalization, e.g. "Average".
+func (v VolumeNormalization) String() string {
+	return volumeNormalizationNames.name("VolumeNormalization", int(v))
+}
+
+// IsValid returns true if the volume normalization is defined by the specification.
+func (v VolumeNormalization) IsValid() bool { return volumeNormalizationNames.valid(int(v)) }
+
+// ParseVolumeNormalization parses a volume normalization from its name, e.g. "Average".
+func ParseVolumeNormalization(s string) (VolumeNormalization, error) {
+	n, err := volumeNormalizationNames.parse(s)
+	return VolumeNormalization(n), err
+}
+
+var uaSourceNames = enumNames{
+	int(UASourceUnknown):     "Unknown",
+	int(UASourceLowEntropy):  "LowEntropy",
+	int(UASourceHighEntropy): "HighEntropy",
+	int(UASourceUserAgent):   "UserAgent",
+}
+
+// String returns the name of the user agent source, e.g. "LowEntropy".
+func (u UASource) String() string { return uaSourceNames.name("UASource", int(u)) }
+
+// IsValid returns true if the user agent source is defined by the specification.
+func (u UASource) IsValid() bool { return uaSourceNames.valid(int(u)) }
+
+// ParseUASource parses a user agent source from its name, e.g. "LowEntropy".
+func ParseUASource(s string) (UASource, error) {
+	n, err := uaSourceNames.parse(s)
+	return UASource(n), err
+}
+
+var agentTypeNames = enumNames{
+	int(AgentTypeDevice): "Device",
+	int(AgentTypePerson): "Person",
+	int(AgentTypeOther):  "Other",
+}
+
+// String returns the name of the agent type, e.g. "Device".
+func (a AgentType) String() string { return agentTypeNames.name("AgentType", int(a)) }
+
+// IsValid returns true if the agent type is defined by the specification.
+func (a AgentType) IsValid() bool { return agentTypeNames.valid(int(a)) }
+
+// ParseAgentType parses an agent type from its name, e.g. "Device".
+func ParseAgentType(s string) (AgentType, error) {
+	n, err := agentTypeNames.parse(s)
+	return AgentType(n), err
+}
@@ -0,0 +1,57 @@
+package openrtb
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Enumerations", func() {
+
+	It("should have names", func() {
+		Expect(DeviceTypeMobile.String()).To(Equal("Mobile"))
+		Expect(APIFrameworkOMID1.String()).To(Equal("OMID1"))
+		Expect(NBRBlockedSChainNode.String()).To(Equal("BlockedSChainNode"))
+		Expect(LossLostToHigherBid.String()).To(Equal("LostToHigherBid"))
+		Expect(ConnectionType(99).String()).To(Equal("ConnectionType(99)"))
+	})
+
+	It("should validate", func() {
+		Expect(ConnTypeCell5G.IsValid()).To(BeTrue())
+		Expect(ConnectionType(8).IsValid()).To(BeFalse())
+		Expect(CreativeAttribute(0).IsValid()).To(BeFalse())
+		Expect(PodSeqLast.IsValid()).To(BeTrue())
+		Expect(NoBidReason(18).IsValid()).To(BeFalse())
+		Expect(NoBidReason(501).IsValid()).To(BeTrue())
+		Expect(LossReason(214).IsValid()).To(BeFalse())
+		Expect(LossReason(1001).IsValid()).To(BeTrue())
+	})
+
+	It("should parse names", func() {
+		Expect(ParseProtocol("VAST42Wrapper")).To(Equal(VideoProtoVAST42Wrapper))
+		Expect(ParseDeviceType("settopbox")).To(Equal(DeviceTypeSetTopBox))
+
+		_, err := ParseAPIFramework("VPAID3")
+		Expect(err).To(Equal(ErrInvalidEnumName))
+	})
+
+	It("should encode numbers on the wire", func() {
+		bin, err := json.Marshal(&Device{DeviceType: DeviceTypeTablet, ConnType: ConnTypeWIFI})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(bin)).To(Equal(`{"devicetype":5,"connectiontype":2}`))
+	})
+
+	It("should encode names for logs", func() {
+		bin, err := MarshalNamed(&BidRequest{
+			ID:     "R",
+			Imp:    []Impression{{ID: "1", Banner: &Banner{W: 300, H: 250, BAttr: []CreativeAttribute{CreativeAttributePop, 99}}}},
+			Site:   &Site{Inventory: Inventory{ID: "S"}},
+			Device: &Device{DeviceType: DeviceTypeTablet},
+			Ext:    Extension(`{"x":1}`),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(bin)).To(Equal(`{"at":0,"device":{"devicetype":"Tablet"},"ext":{"x":1},"id":"R","imp":[{"banner":{"battr":["Pop",99],"h":250,"w":300},"id":"1"}],"site":{"id":"S"}}`))
+	})
+
+})
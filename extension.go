@@ -1,25 +1,274 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
 
-import "errors"
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// Extension errors
+var (
+	ErrExtensionNotFound  = errors.New("openrtb: extension path not found")
+	ErrExtensionNotObject = errors.New("openrtb: extension path is not an object")
+	ErrExtensionInvalid   = errors.New("openrtb: extension is not valid JSON")
+	ErrExtensionTooLarge  = errors.New("openrtb: extension exceeds maximum size")
+	ErrExtensionTooDeep   = errors.New("openrtb: extension exceeds maximum depth")
+)
 
 // Extension is a raw encoded JSON value.
 // It implements Marshaler and Unmarshaler, defined in encoding/json package,
 // works similarly to Extension,
 // but does not need to be used as pointer for proper JSON marshaling.
+//
+// Reset methods recycle the buffers of extensions, objects returned to a pool
+// must therefore not share them. Functions of this package which copy
+// extensions between objects copy their buffers too.
 type Extension []byte
 
 // MarshalJSON returns e as the JSON encoding of e.
+// Fails if e is invalid and the ValidateExtensions option is set.
 func (e Extension) MarshalJSON() ([]byte, error) {
+	if jsonOptions().ValidateExtensions && len(e) != 0 && !json.Valid(e) {
+		return nil, ErrExtensionInvalid
+	}
 	return e, nil
 }
 
-// UnmarshalJSON sets *e to a copy of data.
+// UnmarshalJSON sets *e to a copy of data, compacted and limited according
+// to the options set via SetOptions.
 func (e *Extension) UnmarshalJSON(data []byte) error {
 	if e == nil {
 		return errors.New("openrtb.Extension: UnmarshalJSON on nil pointer")
 	}
-	*e = append((*e)[0:0], data...)
+	opts := jsonOptions()
+	if max := opts.MaxExtensionSize; max > 0 && len(data) > max {
+		*e = (*e)[:0]
+		return ErrExtensionTooLarge
+	}
+	if max := opts.MaxExtensionDepth; max > 0 && nestingDepth(data) > max {
+		return ErrExtensionTooDeep
+	}
+
+	if opts.CompactExtensions {
+		buf := bytes.NewBuffer((*e)[:0])
+		if err := json.Compact(buf, data); err != nil {
+			return err
+		}
+		*e = buf.Bytes()
+	} else {
+		*e = append((*e)[:0], data...)
+	}
+
+	if max := opts.MaxExtensionSize; max > 0 && len(*e) > max {
+		*e = (*e)[:0]
+		return ErrExtensionTooLarge
+	}
+	return nil
+}
+
+// fields decodes the extension as a JSON object.
+func (e Extension) fields() (map[string]json.RawMessage, error) {
+	m := make(map[string]json.RawMessage)
+	if len(e) == 0 {
+		return m, nil
+	}
+	if err := json.Unmarshal(e, &m); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		m = make(map[string]json.RawMessage)
+	}
+	return m, nil
+}
+
+// has reports whether the extension is a JSON object containing key.
+func (e Extension) has(key string) bool {
+	m, err := e.fields()
+	if err != nil {
+		return false
+	}
+	_, ok := m[key]
+	return ok
+}
+
+// set returns a copy of the extension with key set to the JSON encoding of v.
+func (e Extension) set(key string, v interface{}) (Extension, error) {
+	m, err := e.fields()
+	if err != nil {
+		return e, err
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return e, err
+	}
+	m[key] = raw
+	return extensionOf(m)
+}
+
+// del returns a copy of the extension without key. Returns nil if no other keys remain.
+func (e Extension) del(key string) (Extension, error) {
+	m, err := e.fields()
+	if err != nil {
+		return e, err
+	}
+	delete(m, key)
+	return extensionOf(m)
+}
+
+func extensionOf(m map[string]json.RawMessage) (Extension, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(m)
+	return Extension(b), err
+}
+
+// Get decodes the value at path into v. Paths are dot separated object keys,
+// e.g. "prebid.bidder.appnexus.placement_id"; an empty path addresses the
+// whole extension. Returns ErrExtensionNotFound if the path does not exist.
+func (e Extension) Get(path string, v interface{}) error {
+	start, end, err := e.lookup(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(e[start:end], v)
+}
+
+// Set sets the value at path to the JSON encoding of v, creating
+// intermediate objects as required. Other members keep their order and
+// formatting.
+func (e *Extension) Set(path string, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if path == "" {
+		*e = raw
+		return nil
+	}
+
+	data := []byte(*e)
+	if e.empty() {
+		data = []byte("{}")
+	}
+
+	keys := strings.Split(path, ".")
+	start := skipSpace(data, 0)
+	for n, key := range keys {
+		m, found, end, err := findMember(data, start, key)
+		if err != nil {
+			return err
+		}
+		if found && n == len(keys)-1 {
+			*e = splice(data, m.value, m.end, raw)
+			return nil
+		}
+		if found {
+			start = m.value
+			continue
+		}
+
+		// insert the remaining keys as nested objects
+		var ins []byte
+		if skipSpace(data, start+1) != end {
+			ins = append(ins, ',')
+		}
+		for _, k := range keys[n:] {
+			ins = appendKey(ins, k)
+			ins = append(ins, ':', '{')
+		}
+		ins = append(ins[:len(ins)-1], raw...)
+		for range keys[n+1:] {
+			ins = append(ins, '}')
+		}
+		*e = splice(data, end, end, ins)
+		return nil
+	}
+	return nil
+}
+
+// Delete removes the value at path. Deleting a path which does not exist is
+// not an error. The extension is set to nil when no members remain.
+func (e *Extension) Delete(path string) error {
+	if e.empty() {
+		return nil
+	}
+	if path == "" {
+		*e = nil
+		return nil
+	}
+
+	data := []byte(*e)
+	keys := strings.Split(path, ".")
+	start := skipSpace(data, 0)
+	for n, key := range keys {
+		m, found, _, err := findMember(data, start, key)
+		if err == ErrExtensionNotObject && n != 0 {
+			return nil
+		} else if err != nil {
+			return err
+		} else if !found {
+			return nil
+		}
+		if n != len(keys)-1 {
+			start = m.value
+			continue
+		}
+
+		switch {
+		case m.next != -1:
+			data = splice(data, m.start, m.next, nil)
+		case m.prev != -1:
+			data = splice(data, m.prev, m.end, nil)
+		default:
+			data = splice(data, m.start, m.end, nil)
+		}
+	}
+
+	if emptyObject(data) {
+		data = nil
+	}
+	*e = data
 	return nil
 }
+
+// Decode decodes the whole extension into v. Empty extensions leave v unchanged.
+func (e Extension) Decode(v interface{}) error {
+	if e.empty() {
+		return nil
+	}
+	return json.Unmarshal(e, v)
+}
+
+// empty reports whether the extension holds no value.
+func (e Extension) empty() bool {
+	b := bytes.TrimSpace(e)
+	return len(b) == 0 || string(b) == "null"
+}
+
+// lookup returns the position of the raw value at path.
+func (e Extension) lookup(path string) (int, int, error) {
+	if e.empty() {
+		return 0, 0, ErrExtensionNotFound
+	}
+
+	start, end := skipSpace(e, 0), len(e)
+	if path == "" {
+		return start, end, nil
+	}
+	for n, key := range strings.Split(path, ".") {
+		m, found, _, err := findMember(e, start, key)
+		if err == ErrExtensionNotObject && n != 0 {
+			return 0, 0, ErrExtensionNotFound
+		} else if err != nil {
+			return 0, 0, err
+		} else if !found {
+			return 0, 0, ErrExtensionNotFound
+		}
+		start, end = m.value, m.end
+	}
+	return start, end, nil
+}
@@ -26,4 +26,127 @@ var _ = Describe("Extension", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(subject).To(Equal(Extension(`{"foo":"bar"}`)))
 	})
+
+	It("should get values by path", func() {
+		subject := Extension(`{"prebid": {"bidder": {"appnexus": {"placement_id": 123}}, "de\u0062ug": true}, "n": [1, 2]}`)
+
+		var id int
+		Expect(subject.Get("prebid.bidder.appnexus.placement_id", &id)).To(Succeed())
+		Expect(id).To(Equal(123))
+
+		var debug bool
+		Expect(subject.Get("prebid.debug", &debug)).To(Succeed())
+		Expect(debug).To(BeTrue())
+
+		var n []int
+		Expect(subject.Get("n", &n)).To(Succeed())
+		Expect(n).To(Equal([]int{1, 2}))
+
+		Expect(subject.Get("prebid.bidder.rubicon", &id)).To(Equal(ErrExtensionNotFound))
+		Expect(subject.Get("n.x", &id)).To(Equal(ErrExtensionNotFound))
+		Expect(Extension(nil).Get("prebid", &id)).To(Equal(ErrExtensionNotFound))
+		Expect(Extension(`[]`).Get("prebid", &id)).To(Equal(ErrExtensionNotObject))
+		Expect(Extension(`{"a":`).Get("a", &id)).To(Equal(ErrExtensionInvalid))
+	})
+
+	It("should set values by path", func() {
+		subject := Extension(`{"b": 1, "prebid": {"bidder": {}}}`)
+		Expect(subject.Set("prebid.bidder.appnexus.placement_id", 123)).To(Succeed())
+		Expect(string(subject)).To(Equal(`{"b": 1, "prebid": {"bidder": {"appnexus":{"placement_id":123}}}}`))
+
+		Expect(subject.Set("b", "x")).To(Succeed())
+		Expect(subject.Set("a", []int{1})).To(Succeed())
+		Expect(string(subject)).To(Equal(`{"b": "x", "prebid": {"bidder": {"appnexus":{"placement_id":123}}},"a":[1]}`))
+
+		Expect(subject.Set("b.c", 1)).To(Equal(ErrExtensionNotObject))
+
+		var empty Extension
+		Expect(empty.Set("a.b", true)).To(Succeed())
+		Expect(string(empty)).To(Equal(`{"a":{"b":true}}`))
+		Expect(empty.Set("", map[string]int{"c": 1})).To(Succeed())
+		Expect(string(empty)).To(Equal(`{"c":1}`))
+
+		shared := empty
+		Expect(empty.Set("", 2)).To(Succeed())
+		Expect(string(empty)).To(Equal(`2`))
+		Expect(string(shared)).To(Equal(`{"c":1}`))
+	})
+
+	It("should delete values by path", func() {
+		subject := Extension(`{"a": 1, "b": {"c": 2, "d": 3}, "e": 4}`)
+		Expect(subject.Delete("b.d")).To(Succeed())
+		Expect(string(subject)).To(Equal(`{"a": 1, "b": {"c": 2}, "e": 4}`))
+		Expect(subject.Delete("a")).To(Succeed())
+		Expect(string(subject)).To(Equal(`{"b": {"c": 2}, "e": 4}`))
+		Expect(subject.Delete("e")).To(Succeed())
+		Expect(string(subject)).To(Equal(`{"b": {"c": 2}}`))
+		Expect(subject.Delete("x.y")).To(Succeed())
+		Expect(subject.Delete("b.c.d")).To(Succeed())
+		Expect(subject.Delete("b.c")).To(Succeed())
+		Expect(string(subject)).To(Equal(`{"b": {}}`))
+		Expect(subject.Delete("b")).To(Succeed())
+		Expect(subject).To(BeNil())
+	})
+
+	It("should decode", func() {
+		var v struct{ A int }
+		Expect(Extension(`{"a":1}`).Decode(&v)).To(Succeed())
+		Expect(v.A).To(Equal(1))
+		Expect(Extension(`null`).Decode(&v)).To(Succeed())
+		Expect(v.A).To(Equal(1))
+	})
+
+	Describe("with options", func() {
+		AfterEach(func() {
+			setOptions(JSONOptions{})
+		})
+
+		It("should compact on decode", func() {
+			setOptions(JSONOptions{CompactExtensions: true})
+
+			var imp Impression
+			Expect(json.Unmarshal([]byte(`{"id":"1","ext":{ "a" : [ 1, 2 ],
+				"b": " x y " }}`), &imp)).To(Succeed())
+			Expect(string(imp.Ext)).To(Equal(`{"a":[1,2],"b":" x y "}`))
+
+			var subject Extension
+			Expect(json.Unmarshal([]byte(`{"a":`), &subject)).NotTo(Succeed())
+		})
+
+		It("should limit size on decode", func() {
+			setOptions(JSONOptions{CompactExtensions: true, MaxExtensionSize: 9})
+
+			var subject Extension
+			Expect(subject.UnmarshalJSON([]byte(`{"a":123}`))).To(Succeed())
+			Expect(subject.UnmarshalJSON([]byte(`{"a":1234}`))).To(Equal(ErrExtensionTooLarge))
+			Expect(subject).To(BeEmpty())
+			Expect(subject.UnmarshalJSON([]byte(`{ "a": 1 }`))).To(Equal(ErrExtensionTooLarge))
+
+			var imp Impression
+			err := json.Unmarshal([]byte(`{"id":"1","ext":{"a":"long value"}}`), &imp)
+			Expect(err).To(MatchError(ContainSubstring(ErrExtensionTooLarge.Error())))
+		})
+
+		It("should limit depth on decode", func() {
+			setOptions(JSONOptions{MaxExtensionDepth: 2})
+
+			var subject Extension
+			Expect(subject.UnmarshalJSON([]byte(`{"a":[1],"b":"[[[{{{"}`))).To(Succeed())
+			Expect(subject.UnmarshalJSON([]byte(`{"a":[{}]}`))).To(Equal(ErrExtensionTooDeep))
+		})
+
+		It("should validate on encode", func() {
+			imp := &Impression{ID: "1", Ext: Extension(`{"a":`)}
+			Expect(imp.MarshalJSON()).To(ContainSubstring(`"ext":{"a":}`))
+
+			setOptions(JSONOptions{ValidateExtensions: true})
+			_, err := imp.MarshalJSON()
+			Expect(err).To(Equal(ErrExtensionInvalid))
+			_, err = json.Marshal(imp)
+			Expect(err).To(MatchError(ContainSubstring(ErrExtensionInvalid.Error())))
+
+			imp.Ext = Extension(`{"a":1}`)
+			Expect(json.Marshal(imp)).To(MatchJSON(`{"id":"1","ext":{"a":1}}`))
+		})
+	})
 })
@@ -0,0 +1,283 @@
+//go:build ignore
+// +build ignore
+
+// gen_clone generates deep Clone and structural Equal methods for all
+// objects which are (de-)serialized by ffjson.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const output = "clone_gen.go"
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && !strings.HasSuffix(name, "_ffjson.go") && name != output
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	g := &generator{
+		types: make(map[string]ast.Expr),
+		funcs: make(map[string]bool),
+	}
+	for _, pkg := range pkgs {
+		if pkg.Name != "openrtb" {
+			continue
+		}
+
+		var names []string
+		for name := range pkg.Files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			g.scan(pkg.Files[name])
+		}
+	}
+
+	src, err := format.Source(g.generate())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type generator struct {
+	types   map[string]ast.Expr // underlying types of all named types
+	funcs   map[string]bool     // names of package-level functions
+	objects []string            // objects to generate methods for
+	buf     bytes.Buffer
+}
+
+func (g *generator) scan(file *ast.File) {
+	generated := false
+	for _, cg := range file.Comments {
+		for _, c := range cg.List {
+			if strings.HasPrefix(c.Text, "//go:generate go run gen_ffjson.go") {
+				generated = true
+			}
+		}
+	}
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				g.funcs[d.Name.Name] = true
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				g.types[ts.Name.Name] = ts.Type
+				if generated && ts.Name.IsExported() {
+					g.objects = append(g.objects, ts.Name.Name)
+				}
+			}
+		}
+	}
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) generate() []byte {
+	g.printf("// Code generated by gen_clone.go; DO NOT EDIT.\n\npackage openrtb\n\nimport \"bytes\"\n\n")
+	for _, name := range g.objects {
+		if st := g.structOf(name); st != nil {
+			g.clone(name, st)
+			g.equal(name, st)
+		}
+	}
+	return g.buf.Bytes()
+}
+
+// structOf returns the struct type of a named type, following definitions
+// such as "type Publisher ThirdParty".
+func (g *generator) structOf(name string) *ast.StructType {
+	switch t := g.types[name].(type) {
+	case *ast.StructType:
+		return t
+	case *ast.Ident:
+		return g.structOf(t.Name)
+	}
+	return nil
+}
+
+type kind int
+
+const (
+	kindScalar kind = iota
+	kindBytes
+	kindStruct
+)
+
+// kindOf classifies a type expression.
+func (g *generator) kindOf(expr ast.Expr) kind {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if g.structOf(t.Name) != nil {
+			return kindStruct
+		}
+		if u, ok := g.types[t.Name]; ok {
+			return g.kindOf(u)
+		}
+		return kindScalar
+	case *ast.ArrayType:
+		if isBytes(t) {
+			return kindBytes
+		}
+	}
+	log.Fatalf("unsupported type %s", exprString(expr))
+	return 0
+}
+
+func isBytes(t *ast.ArrayType) bool {
+	id, ok := t.Elt.(*ast.Ident)
+	return ok && t.Len == nil && (id.Name == "byte" || id.Name == "uint8")
+}
+
+type field struct {
+	name string
+	typ  ast.Expr
+}
+
+func fields(st *ast.StructType) []field {
+	var out []field
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 {
+			out = append(out, field{name: exprString(f.Type), typ: f.Type})
+		}
+		for _, n := range f.Names {
+			if n.Name != "_" {
+				out = append(out, field{name: n.Name, typ: f.Type})
+			}
+		}
+	}
+	return out
+}
+
+func (g *generator) alloc(name string) string {
+	if g.funcs["New"+name] {
+		return "New" + name + "()"
+	}
+	return "new(" + name + ")"
+}
+
+func (g *generator) clone(name string, st *ast.StructType) {
+	g.printf("\n// Clone returns a deep copy of the object.\n")
+	g.printf("func (x *%s) Clone() *%s {\nif x == nil {\nreturn nil\n}\nc := %s\nx.cloneInto(c)\nreturn c\n}\n\n", name, name, g.alloc(name))
+	g.printf("func (x *%s) cloneInto(c *%s) {\n", name, name)
+	for _, f := range fields(st) {
+		if exprString(f.typ) == "extCache" {
+			g.printf("c.%s = extCache{}\n", f.name)
+			continue
+		}
+
+		switch t := f.typ.(type) {
+		case *ast.StarExpr:
+			elem := exprString(t.X)
+			if g.kindOf(t.X) == kindStruct {
+				g.printf("if x.%s == nil {\n", f.name)
+				if g.funcs["Free"+elem] {
+					g.printf("Free%s(c.%s)\n", elem, f.name)
+				}
+				g.printf("c.%s = nil\n} else {\nif c.%s == nil {\nc.%s = %s\n}\nx.%s.cloneInto(c.%s)\n}\n", f.name, f.name, f.name, g.alloc(elem), f.name, f.name)
+			} else {
+				g.printf("if x.%s == nil {\nc.%s = nil\n} else {\nv := *x.%s\nc.%s = &v\n}\n", f.name, f.name, f.name, f.name)
+			}
+		case *ast.ArrayType:
+			typ := exprString(t)
+			g.printf("if x.%s == nil {\nc.%s = nil\n} else {\n", f.name, f.name)
+			g.printf("if c.%s == nil || cap(c.%s) < len(x.%s) {\nc.%s = make(%s, len(x.%s))\n} else {\nc.%s = c.%s[:len(x.%s)]\n}\n", f.name, f.name, f.name, f.name, typ, f.name, f.name, f.name, f.name)
+			if t.Len == nil && g.kindOf(t.Elt) == kindStruct {
+				g.printf("for i := range x.%s {\nx.%s[i].cloneInto(&c.%s[i])\n}\n", f.name, f.name, f.name)
+			} else {
+				g.printf("copy(c.%s, x.%s)\n", f.name, f.name)
+			}
+			g.printf("}\n")
+		case *ast.MapType:
+			g.printf("if x.%s == nil {\nc.%s = nil\n} else {\nc.%s = make(%s, len(x.%s))\nfor k, v := range x.%s {\nc.%s[k] = v\n}\n}\n", f.name, f.name, f.name, exprString(t), f.name, f.name, f.name)
+		default:
+			switch g.kindOf(t) {
+			case kindStruct:
+				g.printf("x.%s.cloneInto(&c.%s)\n", f.name, f.name)
+			case kindBytes:
+				g.printf("if x.%s == nil {\nc.%s = nil\n} else {\nc.%s = append(c.%s[:0:0], x.%s...)\n}\n", f.name, f.name, f.name, f.name, f.name)
+			default:
+				g.printf("c.%s = x.%s\n", f.name, f.name)
+			}
+		}
+	}
+	g.printf("}\n")
+}
+
+func (g *generator) equal(name string, st *ast.StructType) {
+	g.printf("\n// Equal reports whether x and y are structurally equal. Nil and empty\n// slices are considered equal, extensions are compared byte by byte.\n")
+	g.printf("func (x *%s) Equal(y *%s) bool {\nif x == nil || y == nil {\nreturn x == y\n}\n", name, name)
+	for _, f := range fields(st) {
+		if exprString(f.typ) == "extCache" {
+			continue
+		}
+
+		switch t := f.typ.(type) {
+		case *ast.StarExpr:
+			if g.kindOf(t.X) == kindStruct {
+				g.printf("if !x.%s.Equal(y.%s) {\nreturn false\n}\n", f.name, f.name)
+			} else {
+				g.printf("if (x.%s == nil) != (y.%s == nil) || (x.%s != nil && *x.%s != *y.%s) {\nreturn false\n}\n", f.name, f.name, f.name, f.name, f.name)
+			}
+		case *ast.ArrayType:
+			if isBytes(t) {
+				g.printf("if !bytes.Equal(x.%s, y.%s) {\nreturn false\n}\n", f.name, f.name)
+				continue
+			}
+			g.printf("if len(x.%s) != len(y.%s) {\nreturn false\n}\nfor i := range x.%s {\n", f.name, f.name, f.name)
+			if g.kindOf(t.Elt) == kindStruct {
+				g.printf("if !x.%s[i].Equal(&y.%s[i]) {\nreturn false\n}\n}\n", f.name, f.name)
+			} else {
+				g.printf("if x.%s[i] != y.%s[i] {\nreturn false\n}\n}\n", f.name, f.name)
+			}
+		case *ast.MapType:
+			g.printf("if len(x.%s) != len(y.%s) {\nreturn false\n}\nfor k, v := range x.%s {\nif w, ok := y.%s[k]; !ok || w != v {\nreturn false\n}\n}\n", f.name, f.name, f.name, f.name)
+		default:
+			switch g.kindOf(t) {
+			case kindStruct:
+				g.printf("if !x.%s.Equal(&y.%s) {\nreturn false\n}\n", f.name, f.name)
+			case kindBytes:
+				g.printf("if !bytes.Equal(x.%s, y.%s) {\nreturn false\n}\n", f.name, f.name)
+			default:
+				g.printf("if x.%s != y.%s {\nreturn false\n}\n", f.name, f.name)
+			}
+		}
+	}
+	g.printf("return true\n}\n")
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, token.NewFileSet(), expr); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
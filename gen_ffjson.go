@@ -0,0 +1,827 @@
+//go:build ignore
+// +build ignore
+
+// gen_ffjson generates the ffjson encoders and decoders for the objects of a
+// source file. The output follows the upstream ffjson generator, but decoders
+// additionally:
+//
+//   - allocate nested objects through their New<Type> pool constructors,
+//   - reuse the elements and backing arrays of recycled slices,
+//   - reset slices which are absent from the input to nil,
+//   - retain unknown members of objects with an "unknown []byte" field.
+//
+// It is run by go generate and processes the file named by $GOFILE:
+//
+//	//go:generate go run gen_ffjson.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const directive = "//go:generate go run gen_ffjson.go"
+
+func main() {
+	source := os.Getenv("GOFILE")
+	if source == "" {
+		log.Fatal("gen_ffjson must be run by go generate")
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && !strings.HasSuffix(name, "_ffjson.go")
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	g := &generator{
+		types:     make(map[string]ast.Expr),
+		methods:   make(map[string]map[string]bool),
+		pooled:    make(map[string]bool),
+		generated: make(map[string]bool),
+		imports:   make(map[string]bool),
+	}
+	var objects []string
+	for _, pkg := range pkgs {
+		if _, ok := pkg.Files[source]; !ok {
+			continue
+		}
+
+		g.pkgName = pkg.Name
+		for name, file := range pkg.Files {
+			g.scan(file, name == source)
+		}
+		for _, name := range g.declared {
+			if g.eligible(name) {
+				objects = append(objects, name)
+			}
+		}
+	}
+	if g.pkgName == "" {
+		log.Fatalf("%s not found", source)
+	}
+	sort.Strings(objects)
+
+	src, err := g.generate(source, objects)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(strings.TrimSuffix(source, ".go")+"_ffjson.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type generator struct {
+	pkgName   string
+	types     map[string]ast.Expr        // underlying types of all named types
+	methods   map[string]map[string]bool // hand-written methods by receiver type
+	pooled    map[string]bool            // types with a New<Type> pool constructor
+	generated map[string]bool            // types declared in files with a generate directive
+	declared  []string                   // types declared in the source file
+	imports   map[string]bool
+	q         string // pending output of the encoder
+}
+
+func (g *generator) scan(file *ast.File, source bool) {
+	generated := false
+	for _, cg := range file.Comments {
+		for _, c := range cg.List {
+			if strings.HasPrefix(c.Text, directive) {
+				generated = true
+			}
+		}
+	}
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv != nil {
+				recv := d.Recv.List[0].Type
+				if star, ok := recv.(*ast.StarExpr); ok {
+					recv = star.X
+				}
+				if id, ok := recv.(*ast.Ident); ok {
+					if g.methods[id.Name] == nil {
+						g.methods[id.Name] = make(map[string]bool)
+					}
+					g.methods[id.Name][d.Name.Name] = true
+				}
+			} else if strings.HasPrefix(d.Name.Name, "New") && d.Type.Params.NumFields() == 0 && d.Type.Results.NumFields() == 1 {
+				if star, ok := d.Type.Results.List[0].Type.(*ast.StarExpr); ok {
+					if id, ok := star.X.(*ast.Ident); ok && id.Name == d.Name.Name[3:] {
+						g.pooled[id.Name] = true
+					}
+				}
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				g.types[ts.Name.Name] = ts.Type
+				g.generated[ts.Name.Name] = generated
+				if source {
+					g.declared = append(g.declared, ts.Name.Name)
+				}
+			}
+		}
+	}
+}
+
+// eligible reports whether methods are generated for the named type.
+func (g *generator) eligible(name string) bool {
+	return g.generated[name] && g.structOf(name) != nil &&
+		!g.methods[name]["MarshalJSON"] && !g.methods[name]["UnmarshalJSON"]
+}
+
+// structOf returns the struct type of a named type, following definitions
+// such as "type Publisher ThirdParty".
+func (g *generator) structOf(name string) *ast.StructType {
+	switch t := g.types[name].(type) {
+	case *ast.StructType:
+		return t
+	case *ast.Ident:
+		return g.structOf(t.Name)
+	}
+	return nil
+}
+
+// canEncode reports whether t has a MarshalJSONBuf method.
+func (g *generator) canEncode(t *typ) bool {
+	return t.name != "" && (g.eligible(t.name) || g.methods[t.name]["MarshalJSONBuf"])
+}
+
+// canDecode reports whether t has an UnmarshalJSONFFLexer method.
+func (g *generator) canDecode(t *typ) bool {
+	return t.name != "" && (g.eligible(t.name) || g.methods[t.name]["UnmarshalJSONFFLexer"])
+}
+
+func (g *generator) hasMethod(t *typ, method string) bool {
+	return t.name != "" && g.methods[t.name][method]
+}
+
+// keepsUnknown reports whether the named type retains members it does not model.
+func (g *generator) keepsUnknown(name string) bool {
+	for _, f := range g.structOf(name).Fields.List {
+		for _, n := range f.Names {
+			if n.Name == "unknown" && exprString(f.Type) == "[]byte" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// --------------------------------------------------------------------
+
+// typ describes a resolved type, named after reflect's kinds.
+type typ struct {
+	name string // name of named and predeclared types
+	kind string // e.g. "int", "string", "slice", "struct"
+	elem *typ   // element type of slices and pointers
+}
+
+var basicKinds = map[string]string{
+	"bool": "bool", "string": "string",
+	"int": "int", "int8": "int8", "int16": "int16", "int32": "int32", "int64": "int64", "rune": "int32",
+	"uint": "uint", "uint8": "uint8", "uint16": "uint16", "uint32": "uint32", "uint64": "uint64", "byte": "uint8",
+	"float32": "float32", "float64": "float64",
+}
+
+func (g *generator) resolve(expr ast.Expr) *typ {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if kind, ok := basicKinds[t.Name]; ok {
+			return &typ{name: kind, kind: kind}
+		}
+		u, ok := g.types[t.Name]
+		if !ok {
+			log.Fatalf("unknown type %s", t.Name)
+		}
+		r := *g.resolve(u)
+		r.name = t.Name
+		return &r
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return &typ{kind: "slice", elem: g.resolve(t.Elt)}
+		}
+	case *ast.StarExpr:
+		return &typ{kind: "ptr", elem: g.resolve(t.X)}
+	case *ast.StructType:
+		return &typ{kind: "struct"}
+	case *ast.MapType:
+		return &typ{kind: "map"}
+	}
+	log.Fatalf("unsupported type %s", exprString(expr))
+	return nil
+}
+
+// String returns the type like reflect.Type.String.
+func (g *generator) String(t *typ) string {
+	switch {
+	case t.name != "" && basicKinds[t.name] == t.name:
+		return t.name
+	case t.name != "":
+		return g.pkgName + "." + t.name
+	case t.kind == "slice":
+		return "[]" + g.String(t.elem)
+	case t.kind == "ptr":
+		return "*" + g.String(t.elem)
+	}
+	return t.kind
+}
+
+// typeName returns the type as written in the generated code.
+func (g *generator) typeName(t *typ) string {
+	switch {
+	case t.name != "":
+		return t.name
+	case t.kind == "slice":
+		return "[]" + g.typeName(t.elem)
+	case t.kind == "ptr":
+		return "*" + g.typeName(t.elem)
+	}
+	log.Fatalf("unnamed %s type", t.kind)
+	return ""
+}
+
+func (t *typ) isInt() bool {
+	switch t.kind {
+	case "int", "int8", "int16", "int32", "int64":
+		return true
+	}
+	return false
+}
+
+func (t *typ) isUint() bool {
+	switch t.kind {
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+	return false
+}
+
+func (t *typ) isFloat() bool {
+	return t.kind == "float32" || t.kind == "float64"
+}
+
+type field struct {
+	Name      string
+	JSONName  string
+	Typ       *typ // elem type if pointer
+	Pointer   bool
+	OmitEmpty bool
+}
+
+// fields returns the JSON fields of the named type in encoding/json order,
+// including the fields of embedded structs.
+func (g *generator) fields(name string) []field {
+	var out []field
+	seen := make(map[string]bool)
+	next := []*ast.StructType{g.structOf(name)}
+	for len(next) != 0 {
+		current := next
+		next = nil
+		for _, st := range current {
+			for _, f := range st.Fields.List {
+				tag := ""
+				if f.Tag != nil {
+					s, _ := strconv.Unquote(f.Tag.Value)
+					tag = reflect.StructTag(s).Get("json")
+				}
+				jsonName, opts := tag, ""
+				if i := strings.Index(tag, ","); i >= 0 {
+					jsonName, opts = tag[:i], tag[i+1:]
+				}
+
+				names := f.Names
+				if len(names) == 0 {
+					id, ok := f.Type.(*ast.Ident)
+					if !ok {
+						log.Fatalf("unsupported embedded field %s", exprString(f.Type))
+					}
+					if tag != "-" && jsonName == "" && g.structOf(id.Name) != nil {
+						next = append(next, g.structOf(id.Name))
+						continue
+					}
+					names = []*ast.Ident{id}
+				}
+
+				for _, n := range names {
+					if !n.IsExported() || tag == "-" {
+						continue
+					}
+
+					fname := jsonName
+					if fname == "" {
+						fname = n.Name
+					}
+					if seen[fname] {
+						continue
+					}
+					seen[fname] = true
+
+					fd := field{Name: n.Name, JSONName: fname, Typ: g.resolve(f.Type)}
+					for _, o := range strings.Split(opts, ",") {
+						if o == "omitempty" {
+							fd.OmitEmpty = true
+						}
+					}
+					if fd.Typ.kind == "ptr" {
+						fd.Pointer = true
+						fd.Typ = fd.Typ.elem
+					}
+					out = append(out, fd)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// --------------------------------------------------------------------
+
+func (g *generator) qWrite(s string) { g.q += s }
+
+func (g *generator) flush() string {
+	if g.q == "" {
+		return ""
+	}
+	s := g.q
+	g.q = ""
+	if len(s) == 1 {
+		return "buf.WriteByte('" + s + "')\n"
+	}
+	return "buf.WriteString(`" + s + "`)\n"
+}
+
+func (g *generator) innerValue(name string, t *typ, ptr bool) string {
+	out := ""
+	if t.kind != "bool" && t.kind != "map" && t.kind != "struct" {
+		out += g.flush()
+	}
+
+	mbuf := g.canEncode(t)
+	if mbuf || g.hasMethod(t, "MarshalJSON") {
+		out += g.flush()
+		out += "\n{\n\n"
+		if mbuf {
+			out += "err = " + name + ".MarshalJSONBuf(buf)\nif err != nil {\nreturn err\n}\n"
+		} else {
+			out += "obj, err = " + name + ".MarshalJSON()\nif err != nil {\nreturn err\n}\nbuf.Write(obj)\n"
+		}
+		out += "\n}\n"
+		return out
+	}
+
+	ptname := name
+	if ptr {
+		ptname = "*" + name
+	}
+	switch {
+	case t.isInt():
+		out += "fflib.FormatBits2(buf, uint64(" + ptname + "), 10, " + ptname + " < 0)\n"
+	case t.isUint():
+		out += "fflib.FormatBits2(buf, uint64(" + ptname + "), 10, false)\n"
+	case t.kind == "float32":
+		out += "fflib.AppendFloat(buf, float64(" + ptname + "), 'g', -1, 32)\n"
+	case t.kind == "float64":
+		out += "fflib.AppendFloat(buf, float64(" + ptname + "), 'g', -1, 64)\n"
+	case t.kind == "bool":
+		out += "if " + ptname + " {\nbuf.WriteString(`true`)\n} else {\nbuf.WriteString(`false`)\n}\n"
+	case t.kind == "string":
+		out += "fflib.WriteJsonString(buf, string(" + ptname + "))\n"
+	case t.kind == "slice":
+		out += "if " + name + " != nil {\n"
+		out += "buf.WriteString(`[`)\n"
+		out += "for i, v := range " + name + " {\n"
+		out += "if i != 0 {\nbuf.WriteString(`,`)\n}\n"
+		out += g.innerValue("v", t.elem, false)
+		out += "}\n"
+		out += "buf.WriteString(`]`)\n"
+		out += "} else {\nbuf.WriteString(`null`)\n}\n"
+	case t.kind == "struct":
+		out += "/* Struct fall back. type=" + g.String(t) + " kind=" + t.kind + " */\n"
+		out += g.flush()
+		if ptr {
+			out += "err = buf.Encode(" + name + ")\n"
+		} else {
+			out += "err = buf.Encode(&" + name + ")\n"
+		}
+		out += "if err != nil {\nreturn err\n}\n"
+	default:
+		log.Fatalf("unsupported kind for encode: %s", t.kind)
+	}
+	return out
+}
+
+func (g *generator) omitEmpty(f field) string {
+	ptname := "mj." + f.Name
+	if f.Pointer {
+		return "if true {\n"
+	}
+	switch {
+	case f.Typ.kind == "map" || f.Typ.kind == "slice" || f.Typ.kind == "string":
+		return "if len(" + ptname + ") != 0 {\n"
+	case f.Typ.isInt() || f.Typ.isUint() || f.Typ.isFloat():
+		return "if " + ptname + " != 0 {\n"
+	case f.Typ.kind == "bool":
+		return "if " + ptname + " != false {\n"
+	}
+	return "if true {\n"
+}
+
+func (g *generator) encodeField(f field) string {
+	out := ""
+	if f.OmitEmpty {
+		out += g.flush()
+		if f.Pointer {
+			out += "if mj." + f.Name + " != nil {\n"
+		}
+		out += g.omitEmpty(f)
+	}
+	if f.Pointer && !f.OmitEmpty {
+		out += "if mj." + f.Name + " != nil {\n"
+	}
+	g.qWrite(`"` + f.JSONName + `":`)
+	saved := g.q
+	out += g.innerValue("mj."+f.Name, f.Typ, f.Pointer)
+	g.qWrite(",")
+	if f.Pointer && !f.OmitEmpty {
+		out += "} else {\n"
+		g.q = saved
+		out += g.flush()
+		out += "buf.WriteString(`null`)\n"
+		out += "}\n"
+	}
+	if f.OmitEmpty {
+		out += g.flush()
+		if f.Pointer {
+			out += "}\n"
+		}
+		out += "}\n"
+	}
+	return out
+}
+
+func (g *generator) encoder(name string) string {
+	fields := g.fields(name)
+	out := "func (mj *" + name + ") MarshalJSON() ([]byte, error) {\n"
+	out += "var buf fflib.Buffer\n"
+	out += "if mj == nil {\nbuf.WriteString(\"null\")\nreturn buf.Bytes(), nil\n}\n"
+	out += "err := mj.MarshalJSONBuf(&buf)\nif err != nil {\nreturn nil, err\n}\nreturn buf.Bytes(), nil\n}\n"
+	out += "func (mj *" + name + ") MarshalJSONBuf(buf fflib.EncodingBuffer) error {\n"
+	out += "if mj == nil {\nbuf.WriteString(\"null\")\nreturn nil\n}\n"
+	out += "var err error\nvar obj []byte\n_ = obj\n_ = err\n"
+
+	conditional := len(fields) > 0 && fields[len(fields)-1].OmitEmpty
+	g.qWrite("{")
+	if conditional || len(fields) == 0 {
+		g.qWrite(" ")
+	}
+	for _, f := range fields {
+		out += g.encodeField(f)
+	}
+	if conditional {
+		out += g.flush()
+		if g.keepsUnknown(name) {
+			out += "if len(mj.unknown) != 0 {\nbuf.Write(mj.unknown)\nbuf.WriteByte(',')\n}\n"
+		}
+		out += "buf.Rewind(1)\n"
+	} else if len(g.q) > 0 {
+		g.q = g.q[:len(g.q)-1]
+		if g.keepsUnknown(name) {
+			out += g.flush()
+			out += "if len(mj.unknown) != 0 {\nbuf.WriteByte(',')\nbuf.Write(mj.unknown)\n}\n"
+		}
+	}
+	g.qWrite("}")
+	out += g.flush()
+	out += "return nil\n}\n"
+	return out
+}
+
+// --------------------------------------------------------------------
+
+func foldFunc(s string) string {
+	nonLetter, special := false, false
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		if b >= utf8.RuneSelf {
+			return "bytes.EqualFold"
+		}
+		upper := b & ^byte(0x20)
+		if upper < 'A' || upper > 'Z' {
+			nonLetter = true
+		} else if upper == 'K' || upper == 'S' {
+			special = true
+		}
+	}
+	if special {
+		return "fflib.EqualFoldRight"
+	}
+	if nonLetter {
+		return "fflib.AsciiEqualFold"
+	}
+	return "fflib.SimpleLetterEqualFold"
+}
+
+func allowTokens(name string, tokens ...string) string {
+	out := "{\nif "
+	for i, t := range tokens {
+		if i != 0 {
+			out += " && "
+		}
+		out += "tok != fflib." + t
+	}
+	out += " {\nreturn fs.WrapErr(fmt.Errorf(\"cannot unmarshal %s into Go value for " + name + "\", tok))\n}\n}\n"
+	return out
+}
+
+// alloc returns the expression allocating a new value of t, using the pool
+// constructor if available.
+func (g *generator) alloc(t *typ) string {
+	if g.pooled[t.name] {
+		return "New" + t.name + "()"
+	}
+	return "new(" + g.typeName(t) + ")"
+}
+
+func (g *generator) handleField(name string, t *typ, ptr bool) string {
+	out := fmt.Sprintf("/* handler: %s type=%s kind=%s quoted=%t*/\n\n", name, g.String(t), t.kind, false)
+
+	umlx := g.canDecode(t)
+	if umlx || g.hasMethod(t, "UnmarshalJSON") {
+		out += "{\nif tok == fflib.FFTok_null {\n\n"
+		if ptr {
+			out += name + " = nil\n\n"
+		}
+		out += "state = fflib.FFParse_after_value\ngoto mainparse\n}\n\n"
+		if !umlx {
+			out += "tbuf, err := fs.CaptureField(tok)\nif err != nil {\nreturn fs.WrapErr(err)\n}\n\n"
+		}
+		if ptr {
+			out += "if " + name + " == nil {\n" + name + " = " + g.alloc(t) + "\n}\n\n"
+		}
+		if umlx {
+			out += "err = " + name + ".UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)\nif err != nil {\nreturn err\n}\nstate = fflib.FFParse_after_value\n}\n"
+		} else {
+			out += "err = " + name + ".UnmarshalJSON(tbuf)\nif err != nil {\nreturn fs.WrapErr(err)\n}\nstate = fflib.FFParse_after_value\n}\n"
+		}
+		return out
+	}
+
+	switch {
+	case t.isInt():
+		out += allowTokens(t.name, "FFTok_integer", "FFTok_null")
+		out += g.numberHandler(name, t, ptr, "fflib.ParseInt(fs.Output.Bytes(), 10, 64)")
+	case t.isUint():
+		out += allowTokens(t.name, "FFTok_integer", "FFTok_null")
+		out += g.numberHandler(name, t, ptr, "fflib.ParseUint(fs.Output.Bytes(), 10, 64)")
+	case t.isFloat():
+		out += allowTokens(t.name, "FFTok_double", "FFTok_integer", "FFTok_null")
+		out += g.numberHandler(name, t, ptr, "fflib.ParseFloat(fs.Output.Bytes(), "+strings.TrimPrefix(t.kind, "float")+")")
+	case t.kind == "bool":
+		out += "{\nif tok != fflib.FFTok_bool && tok != fflib.FFTok_null {\nreturn fs.WrapErr(fmt.Errorf(\"cannot unmarshal %s into Go value for " + t.name + "\", tok))\n}\n}\n\n"
+		out += "{\nif tok == fflib.FFTok_null {\n\n"
+		if ptr {
+			out += name + " = nil\n\n"
+		}
+		out += "} else {\ntmpb := fs.Output.Bytes()\n\n"
+		out += "if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {\n\n"
+		if ptr {
+			out += "tval := true\n" + name + " = &tval\n\n"
+		} else {
+			out += name + " = true\n\n"
+		}
+		out += "} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {\n\n"
+		if ptr {
+			out += "tval := false\n" + name + " = &tval\n\n"
+		} else {
+			out += name + " = false\n\n"
+		}
+		out += "} else {\nerr = errors.New(\"unexpected bytes for true/false value\")\nreturn fs.WrapErr(err)\n}\n\n}\n}\n"
+		g.imports[`"errors"`] = true
+	case t.kind == "string":
+		out += "{\n\n"
+		out += allowTokens(t.name, "FFTok_string", "FFTok_null")
+		out += "\nif tok == fflib.FFTok_null {\n\n"
+		if ptr {
+			out += name + " = nil\n\n"
+		}
+		out += "} else {\n\n"
+		if ptr {
+			out += "var tval " + g.typeName(t) + "\noutBuf := fs.Output.Bytes()\n\n"
+			out += "tval = " + g.typeName(t) + "(string(outBuf))\n" + name + " = &tval\n\n"
+		} else {
+			out += "outBuf := fs.Output.Bytes()\n\n"
+			out += name + " = " + g.typeName(t) + "(string(outBuf))\n\n"
+		}
+		out += "}\n}\n"
+	case t.kind == "slice":
+		tmp := "tmp_" + strings.Replace(name, ".", "__", -1)
+		elem, eptr := t.elem, false
+		if elem.kind == "ptr" {
+			elem, eptr = elem.elem, true
+		}
+
+		out += "{\n\n"
+		out += allowTokens(t.name, "FFTok_left_brace", "FFTok_null")
+		out += "\nif tok == fflib.FFTok_null {\n" + name + " = nil\n} else {\n\n"
+		if eptr {
+			out += "if " + name + " == nil {\n" + name + " = []*" + g.typeName(elem) + "{}\n} else {\n" + name + " = " + name + "[:0]\n}\n\n"
+		} else {
+			out += "if " + name + " == nil {\n" + name + " = []" + g.typeName(elem) + "{}\n} else {\n" + name + " = " + name + "[:0]\n}\n\n"
+		}
+		out += "wantVal := true\n\nfor {\n\n"
+		if eptr {
+			out += "var " + tmp + " *" + g.typeName(elem) + "\n\n"
+		} else {
+			out += "var " + tmp + " " + g.typeName(elem) + "\n\n"
+			// recycle the element left behind by a previous decode
+			if elem.kind == "struct" && g.hasMethod(elem, "Reset") {
+				next := name + "[:len(" + name + ")+1][len(" + name + ")]"
+				out += "if len(" + name + ") < cap(" + name + ") {\n" + next + ".Reset()\n" + tmp + " = " + next + "\n}\n\n"
+			}
+		}
+		out += "tok = fs.Scan()\nif tok == fflib.FFTok_error {\ngoto tokerror\n}\nif tok == fflib.FFTok_right_brace {\nbreak\n}\n\n"
+		out += "if tok == fflib.FFTok_comma {\nif wantVal == true {\n// TODO(pquerna): this isn't an ideal error message, this handles\n// things like [,,,] as an array value.\nreturn fs.WrapErr(fmt.Errorf(\"wanted value token, but got token: %v\", tok))\n}\ncontinue\n} else {\nwantVal = true\n}\n\n"
+		out += g.handleField(tmp, elem, eptr)
+		out += "\n" + name + " = append(" + name + ", " + tmp + ")\n\nwantVal = false\n}\n}\n}\n"
+	default:
+		g.imports[`"encoding/json"`] = true
+		out += "{\n/* Falling back. type=" + g.String(t) + " kind=" + t.kind + " */\n"
+		out += "tbuf, err := fs.CaptureField(tok)\nif err != nil {\nreturn fs.WrapErr(err)\n}\n\n"
+		out += "err = json.Unmarshal(tbuf, &" + name + ")\nif err != nil {\nreturn fs.WrapErr(err)\n}\n}\n"
+	}
+	return out
+}
+
+func (g *generator) numberHandler(name string, t *typ, ptr bool, parse string) string {
+	out := "\n{\n\nif tok == fflib.FFTok_null {\n\n"
+	if ptr {
+		out += name + " = nil\n\n"
+	}
+	out += "} else {\n\n"
+	out += "tval, err := " + parse + "\n\nif err != nil {\nreturn fs.WrapErr(err)\n}\n\n"
+	if ptr {
+		out += "ttypval := " + g.typeName(t) + "(tval)\n" + name + " = &ttypval\n\n"
+	} else {
+		out += name + " = " + g.typeName(t) + "(tval)\n\n"
+	}
+	out += "}\n}\n"
+	return out
+}
+
+func (g *generator) decoder(name string) string {
+	fields := g.fields(name)
+	out := "const (\nffj_t_" + name + "base = iota\nffj_t_" + name + "no_such_key\n"
+	for _, f := range fields {
+		out += "\nffj_t_" + name + "_" + f.Name + "\n"
+	}
+	out += ")\n\n"
+	for _, f := range fields {
+		out += "var ffj_key_" + name + "_" + f.Name + " = []byte(" + strconv.Quote(f.JSONName) + ")\n\n"
+	}
+	out += "func (uj *" + name + ") UnmarshalJSON(input []byte) error {\nfs := fflib.NewFFLexer(input)\nreturn uj.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)\n}\n\n"
+	out += "func (uj *" + name + ") UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {\n"
+	out += "var err error = nil\ncurrentKey := ffj_t_" + name + "base\n_ = currentKey\ntok := fflib.FFTok_init\nwantedTok := fflib.FFTok_init\n\n"
+
+	// slices absent from the input are reset to nil, so recycled values
+	// decode exactly like freshly allocated ones
+	seen := make(map[string]int)
+	for _, f := range fields {
+		if !f.Pointer && f.Typ.kind == "slice" {
+			seen[f.Name] = len(seen)
+		}
+	}
+	if len(seen) != 0 {
+		out += "var ffjSeen [" + strconv.Itoa(len(seen)) + "]bool\n\n"
+	}
+	unknown, keepKey := "", ""
+	if g.keepsUnknown(name) {
+		out += "var ffjUnknownKey []byte\n\n"
+		keepKey = "if jsonOptions().KeepUnknown {\nffjUnknownKey = append(ffjUnknownKey[:0], kn...)\n}\n"
+		unknown = "if jsonOptions().KeepUnknown {\ntbuf, err := fs.CaptureField(tok)\nif err != nil {\nreturn fs.WrapErr(err)\n}\nuj.unknown = appendMember(uj.unknown, ffjUnknownKey, tbuf)\nstate = fflib.FFParse_after_value\ngoto mainparse\n}\n"
+	}
+	out += "mainparse:\nfor {\ntok = fs.Scan()\n//	println(fmt.Sprintf(\"debug: tok: %v  state: %v\", tok, state))\nif tok == fflib.FFTok_error {\ngoto tokerror\n}\n\nswitch state {\n\n"
+	out += "case fflib.FFParse_map_start:\nif tok != fflib.FFTok_left_bracket {\nwantedTok = fflib.FFTok_left_bracket\ngoto wrongtokenerror\n}\nstate = fflib.FFParse_want_key\ncontinue\n\n"
+	out += "case fflib.FFParse_after_value:\nif tok == fflib.FFTok_comma {\nstate = fflib.FFParse_want_key\n} else if tok == fflib.FFTok_right_bracket {\ngoto done\n} else {\nwantedTok = fflib.FFTok_comma\ngoto wrongtokenerror\n}\n\n"
+	out += "case fflib.FFParse_want_key:\n// json {} ended. goto exit. woo.\nif tok == fflib.FFTok_right_bracket {\ngoto done\n}\nif tok != fflib.FFTok_string {\nwantedTok = fflib.FFTok_string\ngoto wrongtokenerror\n}\n\n"
+	out += "kn := fs.Output.Bytes()\nif len(kn) <= 0 {\n// \"\" case. hrm.\n" + keepKey + "currentKey = ffj_t_" + name + "no_such_key\nstate = fflib.FFParse_want_colon\ngoto mainparse\n} else {\nswitch kn[0] {\n\n"
+
+	groups := make(map[byte][]field)
+	var keys []int
+	for _, f := range fields {
+		b := f.JSONName[0]
+		if _, ok := groups[b]; !ok {
+			keys = append(keys, int(b))
+		}
+		groups[b] = append(groups[b], f)
+	}
+	sort.Ints(keys)
+	for _, k := range keys {
+		out += "case '" + string(rune(k)) + "':\n\n"
+		for i, f := range groups[byte(k)] {
+			if i != 0 {
+				out += "\n\n} else "
+			}
+			out += "if bytes.Equal(ffj_key_" + name + "_" + f.Name + ", kn) {\ncurrentKey = ffj_t_" + name + "_" + f.Name + "\nstate = fflib.FFParse_want_colon\ngoto mainparse"
+		}
+		out += "\n}\n\n"
+	}
+	out += "}\n\n"
+	for i := len(fields) - 1; i >= 0; i-- {
+		f := fields[i]
+		out += "if " + foldFunc(f.JSONName) + "(ffj_key_" + name + "_" + f.Name + ", kn) {\ncurrentKey = ffj_t_" + name + "_" + f.Name + "\nstate = fflib.FFParse_want_colon\ngoto mainparse\n}\n\n"
+	}
+	out += keepKey + "currentKey = ffj_t_" + name + "no_such_key\nstate = fflib.FFParse_want_colon\ngoto mainparse\n}\n\n"
+	out += "case fflib.FFParse_want_colon:\nif tok != fflib.FFTok_colon {\nwantedTok = fflib.FFTok_colon\ngoto wrongtokenerror\n}\nstate = fflib.FFParse_want_value\ncontinue\n"
+	out += "case fflib.FFParse_want_value:\n\n"
+	out += "if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {\nswitch currentKey {\n\n"
+	for _, f := range fields {
+		out += "case ffj_t_" + name + "_" + f.Name + ":\ngoto handle_" + f.Name + "\n\n"
+	}
+	out += "case ffj_t_" + name + "no_such_key:\n" + unknown + "err = fs.SkipField(tok)\nif err != nil {\nreturn fs.WrapErr(err)\n}\nstate = fflib.FFParse_after_value\ngoto mainparse\n}\n} else {\ngoto wantedvalue\n}\n}\n}\n\n"
+	for _, f := range fields {
+		out += "handle_" + f.Name + ":\n\n"
+		if i, ok := seen[f.Name]; ok {
+			out += "ffjSeen[" + strconv.Itoa(i) + "] = true\n\n"
+		}
+		out += g.handleField("uj."+f.Name, f.Typ, f.Pointer)
+		out += "\nstate = fflib.FFParse_after_value\ngoto mainparse\n\n"
+	}
+	out += "wantedvalue:\nreturn fs.WrapErr(fmt.Errorf(\"wanted value token, but got token: %v\", tok))\n"
+	out += "wrongtokenerror:\nreturn fs.WrapErr(fmt.Errorf(\"ffjson: wanted token: %v, but got token: %v output=%s\", wantedTok, tok, fs.Output.String()))\n"
+	out += "tokerror:\nif fs.BigError != nil {\nreturn fs.WrapErr(fs.BigError)\n}\nerr = fs.Error.ToError()\nif err != nil {\nreturn fs.WrapErr(err)\n}\npanic(\"ffjson-generated: unreachable, please report bug.\")\ndone:\n\n"
+	for _, f := range fields {
+		if i, ok := seen[f.Name]; ok {
+			out += "if !ffjSeen[" + strconv.Itoa(i) + "] && len(uj." + f.Name + ") == 0 {\nuj." + f.Name + " = nil\n}\n"
+		}
+	}
+	out += "\nreturn nil\n}\n"
+	return out
+}
+
+// generate renders the output file.
+func (g *generator) generate(source string, objects []string) ([]byte, error) {
+	body := ""
+	for i, name := range objects {
+		if i != 0 {
+			body += "\n"
+		}
+		body += g.encoder(name)
+		body += "\n"
+		body += g.decoder(name)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_ffjson.go; DO NOT EDIT.\n// source: " + source + "\n\npackage " + g.pkgName + "\n\n")
+	if len(objects) == 0 {
+		buf.WriteString("import ()\n")
+	} else {
+		imps := []string{`"bytes"`, `"fmt"`, `fflib "github.com/pquerna/ffjson/fflib/v1"`}
+		for k := range g.imports {
+			imps = append(imps, k)
+		}
+		sort.Slice(imps, func(i, j int) bool {
+			return strings.Trim(strings.TrimPrefix(imps[i], "fflib "), `"`) < strings.Trim(strings.TrimPrefix(imps[j], "fflib "), `"`)
+		})
+		buf.WriteString("import (\n")
+		for _, imp := range imps {
+			buf.WriteString("\t" + imp + "\n")
+		}
+		buf.WriteString(")\n\n")
+		buf.WriteString(body)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), err
+	}
+	return bytes.Replace(src, []byte("// println(fmt.Sprintf(\"debug"), []byte("//\tprintln(fmt.Sprintf(\"debug"), -1), nil
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, token.NewFileSet(), expr); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
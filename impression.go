@@ -1,15 +1,18 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
 
 import (
 	"errors"
+	"sync"
 )
 
 // Validation errors
 var (
 	ErrInvalidImpNoID        = errors.New("openrtb: impression ID missing")
-	ErrInvalidImpMultiAssets = errors.New("openrtb: impression has multiple assets") // at least two out of Banner, Video, Native
+	ErrInvalidImpMultiAssets = errors.New("openrtb: impression has multiple assets") // DEPRECATED multi-format impressions are valid and no longer rejected
+	ErrInvalidImpSSAI        = errors.New("openrtb: impression SSAI type invalid")
+	ErrInvalidImpQty         = errors.New("openrtb: impression quantity multiplier missing")
 )
 
 // The "imp" object describes the ad position or impression being auctioned.  A single bid request
@@ -19,6 +22,8 @@ var (
 // restricting involvement to specific subsets of seats within bidders.
 // The presence of Banner, Video, and/or Native objects
 // subordinate to the Imp object indicates the type of impression being offered.
+// Multiple objects may be present, in which case any given bid must conform to
+// one of the offered types.
 type Impression struct {
 	ID                string         `json:"id"` // A unique identifier for this impression
 	Banner            *Banner        `json:"banner,omitempty"`
@@ -35,10 +40,58 @@ type Impression struct {
 	Secure            NumberOrString `json:"secure,omitempty"`            // Flag to indicate whether the impression requires secure HTTPS URL creative assets and markup.
 	Exp               int            `json:"exp,omitempty"`               // Advisory as to the number of seconds that may elapse between the auction and the actual impression.
 	IFrameBuster      []string       `json:"iframebuster,omitempty"`      // Array of names for supportediframe busters.
+	Rwdd              int            `json:"rwdd,omitempty"`              // Indicates whether the user receives a reward for viewing the creative, where 0 = no, 1 = yes (Spec 2.6)
+	SSAI              SSAIType       `json:"ssai,omitempty"`              // Indicates if server-side ad insertion (e.g., stitching an ad into an audio or video stream) is in use (Spec 2.6)
+	Qty               *Qty           `json:"qty,omitempty"`               // Impression multiplier, e.g. for digital out-of-home inventory (Spec 2.6)
+	DT                float64        `json:"dt,omitempty"`                // Timestamp in milliseconds when the impression will be fulfilled, e.g. for DOOH (Spec 2.6)
 	Ext               Extension      `json:"ext,omitempty"`
+
+	unknown   []byte
+	parsedExt extCache
+}
+
+// Qty object is used to describe the quantity of impressions an impression
+// opportunity represents, e.g. the number of viewers of a DOOH screen (Spec 2.6).
+type Qty struct {
+	Multiplier float64   `json:"multiplier,omitempty"` // Quantity of billable events which will be deemed to have occurred if this item is purchased.
+	SourceType QtySource `json:"sourcetype,omitempty"` // Source of the quantity measurement.
+	Vendor     string    `json:"vendor,omitempty"`     // Top-level business domain of the measurement vendor, if sourcetype is 1.
+	Ext        Extension `json:"ext,omitempty"`
+
+	unknown []byte
+}
+
+func (q *Qty) Reset() {
+	q.unknown = nil
+	q.Multiplier = 0.0
+	q.SourceType = 0
+	q.Vendor = ""
+	if q.Ext != nil {
+		q.Ext = q.Ext[:0]
+	}
+}
+
+var qtyPool = sync.Pool{
+	New: func() interface{} {
+		return new(Qty)
+	},
+}
+
+func NewQty() *Qty {
+	return qtyPool.Get().(*Qty)
+}
+
+func FreeQty(q *Qty) {
+	if q == nil {
+		return
+	}
+	q.Reset()
+	qtyPool.Put(q)
 }
 
 func (imp *Impression) Reset() {
+	imp.unknown = nil
+	imp.parsedExt = extCache{}
 	imp.ID = ""
 	imp.DisplayManager = ""
 	imp.DisplayManagerVer = ""
@@ -53,20 +106,32 @@ func (imp *Impression) Reset() {
 		imp.Ext = imp.Ext[:0]
 	}
 	if imp.Pmp != nil {
-		imp.Pmp.Reset()
+		FreePmp(imp.Pmp)
+		imp.Pmp = nil
 	}
 	if imp.Audio != nil {
-		imp.Audio.Reset()
+		FreeAudio(imp.Audio)
+		imp.Audio = nil
 	}
 	if imp.Banner != nil {
-		imp.Banner.Reset()
+		FreeBanner(imp.Banner)
+		imp.Banner = nil
 	}
 	if imp.Video != nil {
-		imp.Video.Reset()
+		FreeVideo(imp.Video)
+		imp.Video = nil
 	}
 	if imp.Native != nil {
-		imp.Native.Reset()
+		FreeNative(imp.Native)
+		imp.Native = nil
+	}
+	imp.Rwdd = 0
+	imp.SSAI = 0
+	if imp.Qty != nil {
+		FreeQty(imp.Qty)
+		imp.Qty = nil
 	}
+	imp.DT = 0.0
 }
 
 //var impressionSlicePool = sync.Pool{
@@ -88,35 +153,163 @@ func (imp *Impression) Reset() {
 //	impressionSlicePool.Put(imps)
 //}
 
-func (imp *Impression) assetCount() int {
+// markupTypes lists the markup types an impression can offer, in order of
+// the impression's fields.
+var markupTypes = [...]MarkupType{MarkupBanner, MarkupVideo, MarkupAudio, MarkupNative}
+
+// Formats returns the markup types offered by the impression.
+func (imp *Impression) Formats() []MarkupType {
+	var formats []MarkupType
+	for _, mt := range markupTypes {
+		if imp.Offers(mt) {
+			formats = append(formats, mt)
+		}
+	}
+	return formats
+}
+
+// IsMultiFormat returns true if the impression offers more than one markup type.
+func (imp *Impression) IsMultiFormat() bool {
+	return imp.numFormats() > 1
+}
+
+// numFormats returns the number of markup types offered by the impression.
+func (imp *Impression) numFormats() int {
 	n := 0
-	if imp.Banner != nil {
-		n++
+	for _, mt := range markupTypes {
+		if imp.Offers(mt) {
+			n++
+		}
 	}
-	if imp.Video != nil {
-		n++
+	return n
+}
+
+// Offers returns true if the impression offers the given markup type.
+func (imp *Impression) Offers(mtype MarkupType) bool {
+	switch mtype {
+	case MarkupBanner:
+		return imp.Banner != nil
+	case MarkupVideo:
+		return imp.Video != nil
+	case MarkupAudio:
+		return imp.Audio != nil
+	case MarkupNative:
+		return imp.Native != nil
 	}
-	if imp.Native != nil {
-		n++
+	return false
+}
+
+// bAttr returns the creative attributes blocked by the asset of the given
+// markup type or, if unknown, by any of the offered assets.
+func (imp *Impression) bAttr(mtype MarkupType) []CreativeAttribute {
+	var attrs []CreativeAttribute
+	if imp.Banner != nil && (mtype == 0 || mtype == MarkupBanner) {
+		attrs = append(attrs, imp.Banner.BAttr...)
 	}
-	return n
+	if imp.Video != nil && (mtype == 0 || mtype == MarkupVideo) {
+		attrs = append(attrs, imp.Video.BAttr...)
+	}
+	if imp.Audio != nil && (mtype == 0 || mtype == MarkupAudio) {
+		attrs = append(attrs, imp.Audio.BAttr...)
+	}
+	if imp.Native != nil && (mtype == 0 || mtype == MarkupNative) {
+		attrs = append(attrs, imp.Native.BAttr...)
+	}
+	return attrs
+}
+
+// ParsedExt returns the extension of the impression, decoded into the type registered
+// for ExtensionKindImpression. The result is cached until Ext changes and is shared
+// between calls, change Ext to modify it. Safe for concurrent use, as long as
+// Ext is not modified at the same time.
+func (imp *Impression) ParsedExt() (interface{}, error) {
+	return imp.parsedExt.decode(ExtensionKindImpression, imp.Ext)
 }
 
 // Validates the `imp` object
 func (imp *Impression) Validate() error {
+	return imp.ValidateAll().First()
+}
+
+// ValidateAll validates the `imp` object and returns all issues found.
+func (imp *Impression) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	imp.validate(v, "")
+	return v.errs
+}
+
+func (imp *Impression) validate(v *Validator, path string) {
 	if imp.ID == "" {
-		return ErrInvalidImpNoID
+		v.AddError(PathKey(path, "id"), ErrInvalidImpNoID)
 	}
 
-	if count := imp.assetCount(); count > 1 {
-		return ErrInvalidImpMultiAssets
+	if imp.Banner != nil {
+		imp.Banner.validate(v, PathKey(path, "banner"))
+	}
+	if imp.Video != nil {
+		imp.Video.validate(v, PathKey(path, "video"))
+	}
+	if imp.Audio != nil {
+		v.since(Version2_4, true, PathKey(path, "audio"))
+		imp.Audio.validate(v, PathKey(path, "audio"))
+	}
+	if imp.Native != nil {
+		imp.Native.validate(v, PathKey(path, "native"))
+	}
+	if imp.Pmp != nil {
+		imp.Pmp.validate(v, PathKey(path, "pmp"))
 	}
 
+	if !imp.SSAI.IsValid() {
+		v.AddError(PathKey(path, "ssai"), ErrInvalidImpSSAI)
+	}
+	if imp.Qty != nil && imp.Qty.Multiplier <= 0 {
+		v.AddError(PathKey(path, "qty.multiplier"), ErrInvalidImpQty)
+	}
+	v.since(Version2_6, imp.Rwdd != 0, PathKey(path, "rwdd"))
+	v.since(Version2_6, imp.SSAI != 0, PathKey(path, "ssai"))
+	v.since(Version2_6, imp.Qty != nil, PathKey(path, "qty"))
+	v.since(Version2_6, imp.DT != 0, PathKey(path, "dt"))
+}
+
+func (imp *Impression) normalizeAll(n *normalizer, path string) {
+	if imp.BidFloorCurrency == "" {
+		imp.BidFloorCurrency = defaultCurrency
+		n.setDefault(PathKey(path, "bidfloorcur"))
+	}
+	if imp.Banner != nil {
+		imp.Banner.normalizeAll(n, PathKey(path, "banner"))
+	}
 	if imp.Video != nil {
-		if err := imp.Video.Validate(); err != nil {
-			return err
-		}
+		imp.Video.normalizeAll(n, PathKey(path, "video"))
+	}
+	if imp.Audio != nil {
+		imp.Audio.normalizeAll(n, PathKey(path, "audio"))
+	}
+	if imp.Pmp != nil {
+		imp.Pmp.normalizeAll(n, PathKey(path, "pmp"))
+	}
+}
+
+// mergePmp merges deals from a request-level pmp object into the impression.
+// Returns true if the impression was modified.
+func (imp *Impression) mergePmp(p *Pmp) bool {
+	changed := false
+	if imp.Pmp == nil {
+		imp.Pmp = &Pmp{Private: p.Private, Ext: append(Extension(nil), p.Ext...)}
+		changed = true
 	}
 
-	return nil
+	for _, d := range p.Deals {
+		if imp.Pmp.deal(d.ID) == nil {
+			// deals are shared across impressions, so copy mutable slices
+			d.WSeat = append([]string(nil), d.WSeat...)
+			d.WAdvDomain = append([]string(nil), d.WAdvDomain...)
+			d.Seats = append([]string(nil), d.Seats...)
+			d.Ext = append(Extension(nil), d.Ext...)
+			imp.Pmp.Deals = append(imp.Pmp.Deals, d)
+			changed = true
+		}
+	}
+	return changed
 }
@@ -36,8 +36,25 @@ var _ = Describe("Impression", func() {
 
 	It("should validate", func() {
 		Expect((&Impression{}).Validate()).To(Equal(ErrInvalidImpNoID))
-		Expect((&Impression{ID: "IMPID", Banner: &Banner{}, Video: &Video{}}).Validate()).To(Equal(ErrInvalidImpMultiAssets))
+		Expect((&Impression{ID: "IMPID", Banner: &Banner{}, Video: &Video{}}).Validate()).To(Equal(ErrInvalidVideoNoMimes))
+		Expect((&Impression{ID: "IMPID", Banner: &Banner{}, Audio: &Audio{Mimes: []string{"audio/mp4"}}}).Validate()).NotTo(HaveOccurred())
+		Expect((&Impression{ID: "IMPID", Audio: &Audio{}}).Validate()).To(Equal(ErrInvalidAudioNoMimes))
+		Expect((&Impression{ID: "IMPID", Native: &Native{}}).Validate()).To(Equal(ErrInvalidNativeNoRequest))
+		Expect((&Impression{ID: "IMPID", Banner: &Banner{}, Pmp: &Pmp{Deals: []Deal{{}}}}).Validate()).To(Equal(ErrInvalidDealNoID))
 		Expect((&Impression{ID: "IMPID", Banner: &Banner{}}).Validate()).NotTo(HaveOccurred())
 	})
 
+	It("should list offered formats", func() {
+		Expect(subject.Formats()).To(Equal([]MarkupType{MarkupBanner}))
+		Expect(subject.IsMultiFormat()).To(BeFalse())
+		Expect(subject.Offers(MarkupBanner)).To(BeTrue())
+		Expect(subject.Offers(MarkupNative)).To(BeFalse())
+
+		imp := &Impression{Banner: &Banner{}, Native: &Native{}}
+		Expect(imp.Formats()).To(Equal([]MarkupType{MarkupBanner, MarkupNative}))
+		Expect(imp.IsMultiFormat()).To(BeTrue())
+		Expect(imp.Offers(MarkupNative)).To(BeTrue())
+		Expect((&Impression{}).Formats()).To(BeEmpty())
+	})
+
 })
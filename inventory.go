@@ -2,7 +2,7 @@ package openrtb
 
 import "sync"
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
 
 type Inventory struct {
 	ID            string     `json:"id,omitempty"` // ID on the exchange
@@ -26,6 +26,14 @@ func (a *Inventory) GetPrivacyPolicy() int {
 	return 1
 }
 
+func (a *Inventory) normalizeAll(n *normalizer, path string) {
+	if a.PrivacyPolicy == nil {
+		pp := 1
+		a.PrivacyPolicy = &pp
+		n.setDefault(PathKey(path, "privacypolicy"))
+	}
+}
+
 // An "app" object should be included if the ad supported content is part of a mobile application
 // (as opposed to a mobile website).  A bid request must not contain both an "app" object and a
 // "site" object.
@@ -35,17 +43,22 @@ type App struct {
 	StoreURL string `json:"storeurl,omitempty"` // App store URL for an installed app
 	Ver      string `json:"ver,omitempty"`      // App version
 	Paid     int    `json:"paid,omitempty"`     // "1": Paid, "2": Free
+
+	unknown []byte
 }
 
 func (app *App) Reset() {
+	app.unknown = nil
 	if app.Ext != nil {
 		app.Ext = app.Ext[:0]
 	}
 	if app.Content != nil {
-		app.Content.Reset()
+		FreeContent(app.Content)
+		app.Content = nil
 	}
 	if app.Publisher != nil {
-		app.Publisher.Reset()
+		FreePublisher(app.Publisher)
+		app.Publisher = nil
 	}
 	if app.SectionCat != nil {
 		app.SectionCat = app.SectionCat[:0]
@@ -78,7 +91,7 @@ func NewApp() *App {
 }
 
 func FreeApp(app *App) {
-	if app != nil {
+	if app == nil {
 		return
 	}
 	app.Reset()
@@ -93,17 +106,22 @@ type Site struct {
 	Ref    string `json:"ref,omitempty"`    // Referrer URL
 	Search string `json:"search,omitempty"` // Search string that caused naviation
 	Mobile int    `json:"mobile,omitempty"` // Mobile ("1": site is mobile optimised)
+
+	unknown []byte
 }
 
 func (s *Site) Reset() {
+	s.unknown = nil
 	if s.Ext != nil {
 		s.Ext = s.Ext[:0]
 	}
 	if s.Content != nil {
-		s.Content.Reset()
+		FreeContent(s.Content)
+		s.Content = nil
 	}
 	if s.Publisher != nil {
-		s.Publisher.Reset()
+		FreePublisher(s.Publisher)
+		s.Publisher = nil
 	}
 	if s.SectionCat != nil {
 		s.SectionCat = s.SectionCat[:0]
@@ -0,0 +1,266 @@
+package openrtb
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// member is the position of an object member.
+type member struct {
+	start, value, end int // start of the key, start and end of the value
+	prev, next        int // end of the previous value and start of the next key, -1 if none
+}
+
+// findMember looks up key in the object at data[i]. If the key is not found,
+// it returns the position of the closing brace instead.
+func findMember(data []byte, i int, key string) (m member, found bool, end int, err error) {
+	if i >= len(data) || data[i] != '{' {
+		return m, false, 0, ErrExtensionNotObject
+	}
+
+	prev := -1
+	if i = skipSpace(data, i+1); i < len(data) && data[i] == '}' {
+		return m, false, i, nil
+	}
+	for {
+		if i >= len(data) || data[i] != '"' {
+			return m, false, 0, ErrExtensionInvalid
+		}
+		kend, err := skipString(data, i)
+		if err != nil {
+			return m, false, 0, err
+		}
+		j := skipSpace(data, kend)
+		if j >= len(data) || data[j] != ':' {
+			return m, false, 0, ErrExtensionInvalid
+		}
+		v := skipSpace(data, j+1)
+		vend, err := skipValue(data, v)
+		if err != nil {
+			return m, false, 0, err
+		}
+		if !found && keyEqual(data[i:kend], key) {
+			m, found = member{start: i, value: v, end: vend, prev: prev, next: -1}, true
+		}
+
+		prev = vend
+		if i = skipSpace(data, vend); i >= len(data) {
+			return m, false, 0, ErrExtensionInvalid
+		}
+		switch data[i] {
+		case ',':
+			i = skipSpace(data, i+1)
+			if found {
+				m.next = i
+				return m, true, 0, nil
+			}
+		case '}':
+			return m, found, i, nil
+		default:
+			return m, false, 0, ErrExtensionInvalid
+		}
+	}
+}
+
+// keyEqual compares a quoted JSON key with key.
+func keyEqual(quoted []byte, key string) bool {
+	raw := quoted[1 : len(quoted)-1]
+	if !strings.ContainsRune(string(raw), '\\') {
+		return string(raw) == key
+	}
+	var s string
+	return json.Unmarshal(quoted, &s) == nil && s == key
+}
+
+// skipValue returns the end of the JSON value starting at data[i].
+func skipValue(data []byte, i int) (int, error) {
+	if i >= len(data) {
+		return 0, ErrExtensionInvalid
+	}
+
+	switch data[i] {
+	case '"':
+		return skipString(data, i)
+	case '{', '[':
+		depth := 0
+		for i < len(data) {
+			switch data[i] {
+			case '"':
+				end, err := skipString(data, i)
+				if err != nil {
+					return 0, err
+				}
+				i = end
+				continue
+			case '{', '[':
+				depth++
+			case '}', ']':
+				if depth--; depth == 0 {
+					return i + 1, nil
+				}
+			}
+			i++
+		}
+		return 0, ErrExtensionInvalid
+	}
+
+	j := i
+	for j < len(data) && !isDelim(data[j]) {
+		j++
+	}
+	if j == i {
+		return 0, ErrExtensionInvalid
+	}
+	return j, nil
+}
+
+// skipString returns the end of the JSON string starting at data[i].
+func skipString(data []byte, i int) (int, error) {
+	for j := i + 1; j < len(data); j++ {
+		switch data[j] {
+		case '\\':
+			j++
+		case '"':
+			return j + 1, nil
+		}
+	}
+	return 0, ErrExtensionInvalid
+}
+
+// nestingDepth returns the maximum nesting depth of objects and arrays in data.
+func nestingDepth(data []byte) int {
+	max, n := 0, 0
+	for i := 0; i < len(data); i++ {
+		switch data[i] {
+		case '"':
+			end, err := skipString(data, i)
+			if err != nil {
+				return max
+			}
+			i = end - 1
+		case '{', '[':
+			if n++; n > max {
+				max = n
+			}
+		case '}', ']':
+			n--
+		}
+	}
+	return max
+}
+
+// emptyObject reports whether data is an object without members.
+func emptyObject(data []byte) bool {
+	i := skipSpace(data, 0)
+	if i >= len(data) || data[i] != '{' {
+		return false
+	}
+	i = skipSpace(data, i+1)
+	return i < len(data) && data[i] == '}' && skipSpace(data, i+1) == len(data)
+}
+
+func isDelim(c byte) bool {
+	switch c {
+	case ',', '}', ']', ' ', '\t', '\r', '\n':
+		return true
+	}
+	return false
+}
+
+func skipSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\r', '\n':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// splice returns a copy of data with data[from:to] replaced by repl.
+func splice(data []byte, from, to int, repl []byte) []byte {
+	out := make([]byte, 0, len(data)-(to-from)+len(repl))
+	out = append(out, data[:from]...)
+	out = append(out, repl...)
+	return append(out, data[to:]...)
+}
+
+// appendKey appends key as a quoted JSON string.
+func appendKey(dst []byte, key string) []byte {
+	b, _ := json.Marshal(key)
+	return append(dst, b...)
+}
+
+// eachMember calls fn with the quoted key and the value position of each
+// member of the object at data[i].
+func eachMember(data []byte, i int, fn func(key []byte, start, end int)) error {
+	if i >= len(data) || data[i] != '{' {
+		return ErrExtensionNotObject
+	}
+	if i = skipSpace(data, i+1); i < len(data) && data[i] == '}' {
+		return nil
+	}
+	for {
+		if i >= len(data) || data[i] != '"' {
+			return ErrExtensionInvalid
+		}
+		kend, err := skipString(data, i)
+		if err != nil {
+			return err
+		}
+		j := skipSpace(data, kend)
+		if j >= len(data) || data[j] != ':' {
+			return ErrExtensionInvalid
+		}
+		v := skipSpace(data, j+1)
+		vend, err := skipValue(data, v)
+		if err != nil {
+			return err
+		}
+		fn(data[i:kend], v, vend)
+
+		if i = skipSpace(data, vend); i >= len(data) {
+			return ErrExtensionInvalid
+		}
+		switch data[i] {
+		case ',':
+			i = skipSpace(data, i+1)
+		case '}':
+			return nil
+		default:
+			return ErrExtensionInvalid
+		}
+	}
+}
+
+// eachElement calls fn with the position of each element of the array at
+// data[i].
+func eachElement(data []byte, i int, fn func(n, start, end int)) error {
+	if i >= len(data) || data[i] != '[' {
+		return ErrExtensionInvalid
+	}
+	if i = skipSpace(data, i+1); i < len(data) && data[i] == ']' {
+		return nil
+	}
+	for n := 0; ; n++ {
+		end, err := skipValue(data, i)
+		if err != nil {
+			return err
+		}
+		fn(n, i, end)
+
+		if i = skipSpace(data, end); i >= len(data) {
+			return ErrExtensionInvalid
+		}
+		switch data[i] {
+		case ',':
+			i = skipSpace(data, i+1)
+		case ']':
+			return nil
+		default:
+			return ErrExtensionInvalid
+		}
+	}
+}
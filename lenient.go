@@ -0,0 +1,247 @@
+package openrtb
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Coercion records a value which was converted to the type of its field by
+// lenient decoding.
+type Coercion struct {
+	Path  string          // Path of the field, e.g. "imp[0].banner.w"
+	Value json.RawMessage // The original value, e.g. "300" in quotes
+}
+
+// UnmarshalLenient decodes data into v, a pointer to an object of this
+// package. Unlike json.Unmarshal it accepts common type quirks of exchanges:
+//
+//   - numbers encoded as strings, e.g. "price":"1.25" or "tmax":"120"
+//   - true/false for 0/1 flags, e.g. "js":true
+//   - single values instead of arrays, e.g. "cat":"IAB1"
+//
+// All conversions are returned, so they can be reported to the sender.
+// Encoding is not affected and remains strict.
+func UnmarshalLenient(data []byte, v interface{}) ([]Coercion, error) {
+	d := lenientDecoder{data: data}
+	if t := reflect.TypeOf(v); t != nil && t.Kind() == reflect.Ptr {
+		start := skipSpace(data, 0)
+		if end, err := skipValue(data, start); err == nil {
+			d.value(start, end, t, "")
+		}
+	}
+	if len(d.edits) != 0 {
+		data = d.apply()
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	return d.coercions, nil
+}
+
+type lenientEdit struct {
+	start, end int
+	repl       []byte
+}
+
+type lenientDecoder struct {
+	data      []byte
+	edits     []lenientEdit
+	coercions []Coercion
+}
+
+func (d *lenientDecoder) value(start, end int, t reflect.Type, path string) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		fields := lenientFields(t)
+		_ = eachMember(d.data, start, func(key []byte, vstart, vend int) {
+			if f, ok := fields.lookup(key); ok {
+				d.value(vstart, vend, f.typ, PathKey(path, f.name))
+			}
+		})
+	case reflect.Slice:
+		if implements(t, unmarshalerType) || t.Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		if d.data[start] == '[' {
+			_ = eachElement(d.data, start, func(n, estart, eend int) {
+				d.value(estart, eend, t.Elem(), PathIndex(path, n))
+			})
+			return
+		}
+		if repl, _, ok := coerceScalar(d.data[start:end], t.Elem()); ok {
+			wrapped := make([]byte, 0, len(repl)+2)
+			wrapped = append(append(append(wrapped, '['), repl...), ']')
+			d.edit(start, end, wrapped, path)
+		}
+	default:
+		if implements(t, unmarshalerType) && !relaxedTypes[t] {
+			return
+		}
+		if repl, coerced, ok := coerceScalar(d.data[start:end], t); ok && coerced {
+			d.edit(start, end, repl, path)
+		}
+	}
+}
+
+func (d *lenientDecoder) edit(start, end int, repl []byte, path string) {
+	d.edits = append(d.edits, lenientEdit{start: start, end: end, repl: repl})
+	d.coercions = append(d.coercions, Coercion{Path: path, Value: json.RawMessage(d.data[start:end:end])})
+}
+
+// apply returns a copy of the data with all edits applied.
+func (d *lenientDecoder) apply() []byte {
+	out := make([]byte, 0, len(d.data)+2*len(d.edits))
+	last := 0
+	for _, e := range d.edits {
+		out = append(out, d.data[last:e.start]...)
+		out = append(out, e.repl...)
+		last = e.end
+	}
+	return append(out, d.data[last:]...)
+}
+
+// coerceScalar converts the raw JSON value to the kind of t. It reports
+// whether a conversion was required and whether the value is acceptable.
+func coerceScalar(raw []byte, t reflect.Type) (repl []byte, coerced, ok bool) {
+	if implements(t, unmarshalerType) && !relaxedTypes[t] {
+		return raw, false, true
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		if raw[0] == '"' {
+			return raw, false, true
+		}
+		if relaxedTypes[t] && (raw[0] == '-' || (raw[0] >= '0' && raw[0] <= '9')) {
+			repl = make([]byte, 0, len(raw)+2)
+			return append(append(append(repl, '"'), raw...), '"'), true, true
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch {
+		case raw[0] == '-' || (raw[0] >= '0' && raw[0] <= '9'):
+			return raw, false, true
+		case string(raw) == "true":
+			return []byte("1"), true, true
+		case string(raw) == "false":
+			return []byte("0"), true, true
+		case raw[0] == '"':
+			var s string
+			if json.Unmarshal(raw, &s) != nil {
+				return nil, false, false
+			}
+			n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				return nil, false, false
+			}
+			return strconv.AppendInt(nil, n, 10), true, true
+		}
+	case reflect.Float32, reflect.Float64:
+		switch {
+		case raw[0] == '-' || (raw[0] >= '0' && raw[0] <= '9'):
+			return raw, false, true
+		case raw[0] == '"':
+			var s string
+			if json.Unmarshal(raw, &s) != nil {
+				return nil, false, false
+			}
+			f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+				return nil, false, false
+			}
+			return strconv.AppendFloat(nil, f, 'f', -1, 64), true, true
+		}
+	}
+	return nil, false, false
+}
+
+// --------------------------------------------------------------------
+
+type lenientField struct {
+	name string
+	typ  reflect.Type
+}
+
+type lenientFieldMap map[string]lenientField
+
+// lookup finds the field for a quoted JSON key, case-insensitively like
+// encoding/json.
+func (m lenientFieldMap) lookup(quoted []byte) (lenientField, bool) {
+	var key string
+	if err := json.Unmarshal(quoted, &key); err != nil {
+		return lenientField{}, false
+	}
+	if f, ok := m[key]; ok {
+		return f, true
+	}
+	for name, f := range m {
+		if strings.EqualFold(name, key) {
+			return f, true
+		}
+	}
+	return lenientField{}, false
+}
+
+var lenientFieldCache sync.Map // map[reflect.Type]lenientFieldMap
+
+func lenientFields(t reflect.Type) lenientFieldMap {
+	if m, ok := lenientFieldCache.Load(t); ok {
+		return m.(lenientFieldMap)
+	}
+
+	m := make(lenientFieldMap)
+	collectLenientFields(t, m)
+	lenientFieldCache.Store(t, m)
+	return m
+}
+
+func collectLenientFields(t reflect.Type, m lenientFieldMap) {
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name := strings.Split(sf.Tag.Get("json"), ",")[0]
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			embedded = append(embedded, sf.Type)
+			continue
+		}
+		if sf.PkgPath != "" || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		m[name] = lenientField{name: name, typ: sf.Type}
+	}
+
+	// fields of embedded structs don't override direct fields
+	for _, et := range embedded {
+		sub := make(lenientFieldMap)
+		collectLenientFields(et, sub)
+		for name, f := range sub {
+			if _, ok := m[name]; !ok {
+				m[name] = f
+			}
+		}
+	}
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// relaxedTypes implement json.Unmarshaler only to accept numbers as well as
+// strings, their values are coerced like those of their underlying kind.
+var relaxedTypes = map[reflect.Type]bool{
+	reflect.TypeOf(NumberOrString(0)):  true,
+	reflect.TypeOf(StringOrNumber("")): true,
+}
+
+func implements(t, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PtrTo(t).Implements(iface)
+}
@@ -0,0 +1,96 @@
+package openrtb
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UnmarshalLenient", func() {
+
+	It("should decode quirky requests", func() {
+		var req *BidRequest
+		coercions, err := UnmarshalLenient([]byte(`{
+			"id": "A",
+			"tmax": "120",
+			"imp": [{
+				"id": "1",
+				"bidfloor": "1.25",
+				"banner": {"w": "300", "h": 250, "battr": "3"},
+				"secure": "1"
+			}, {
+				"id": "2",
+				"banner": {"w": 300, "h": 250},
+				"secure": true
+			}],
+			"site": {"id": "S", "cat": "IAB1", "pagecat": ["IAB2", "IAB3"], "privacypolicy": true},
+			"device": {"js": true, "lmt": false, "geo": {"lat": "35.01"}},
+			"regs": {"coppa": true, "gdpr": "1"},
+			"ext": {"tmax": "120"}
+		}`), &req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(coercions).To(Equal([]Coercion{
+			{Path: "tmax", Value: json.RawMessage(`"120"`)},
+			{Path: "imp[0].bidfloor", Value: json.RawMessage(`"1.25"`)},
+			{Path: "imp[0].banner.w", Value: json.RawMessage(`"300"`)},
+			{Path: "imp[0].banner.battr", Value: json.RawMessage(`"3"`)},
+			{Path: "imp[0].secure", Value: json.RawMessage(`"1"`)},
+			{Path: "imp[1].secure", Value: json.RawMessage(`true`)},
+			{Path: "site.cat", Value: json.RawMessage(`"IAB1"`)},
+			{Path: "site.privacypolicy", Value: json.RawMessage(`true`)},
+			{Path: "device.js", Value: json.RawMessage(`true`)},
+			{Path: "device.lmt", Value: json.RawMessage(`false`)},
+			{Path: "device.geo.lat", Value: json.RawMessage(`"35.01"`)},
+			{Path: "regs.coppa", Value: json.RawMessage(`true`)},
+			{Path: "regs.gdpr", Value: json.RawMessage(`"1"`)},
+		}))
+
+		Expect(req.TMax).To(Equal(120))
+		Expect(req.Imp[0].BidFloor).To(Equal(1.25))
+		Expect(req.Imp[0].Banner.W).To(Equal(300))
+		Expect(req.Imp[0].Banner.BAttr).To(Equal([]CreativeAttribute{3}))
+		Expect(req.Imp[0].Secure).To(Equal(NumberOrString(1)))
+		Expect(req.Imp[1].Secure).To(Equal(NumberOrString(1)))
+		Expect(req.Site.Cat).To(Equal([]string{"IAB1"}))
+		Expect(req.Site.PageCat).To(Equal([]string{"IAB2", "IAB3"}))
+		Expect(*req.Site.PrivacyPolicy).To(Equal(1))
+		Expect(req.Device.JS).To(Equal(1))
+		Expect(req.Device.Geo.Lat).To(Equal(35.01))
+		Expect(req.Regs.Coppa).To(Equal(1))
+		Expect(*req.Regs.GDPR).To(Equal(1))
+		Expect(string(req.Ext)).To(Equal(`{"tmax": "120"}`))
+
+		Expect(json.Marshal(req.Imp[0].Banner)).To(MatchJSON(`{"w":300,"h":250,"battr":[3]}`))
+	})
+
+	It("should decode responses", func() {
+		var res BidResponse
+		coercions, err := UnmarshalLenient([]byte(`{"id":"A","seatbid":[{"bid":[{"id":"1","impid":"1","price":"0.5","adomain":"a.com","cid":1.5}]}]}`), &res)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(coercions).To(HaveLen(3))
+		Expect(res.SeatBid[0].Bid[0].Price).To(Equal(0.5))
+		Expect(res.SeatBid[0].Bid[0].CampaignID).To(Equal(StringOrNumber("1.5")))
+		Expect(res.SeatBid[0].Bid[0].AdvDomain).To(Equal([]string{"a.com"}))
+	})
+
+	It("should not coerce valid input", func() {
+		var req BidRequest
+		coercions, err := UnmarshalLenient([]byte(`{"id":"A","imp":[{"id":"1","banner":{"w":300}}]}`), &req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(coercions).To(BeEmpty())
+		Expect(req.Imp[0].Banner.W).To(Equal(300))
+	})
+
+	It("should reject values which cannot be coerced", func() {
+		var req BidRequest
+		_, err := UnmarshalLenient([]byte(`{"id":"A","tmax":"soon"}`), &req)
+		Expect(err).To(HaveOccurred())
+
+		_, err = UnmarshalLenient([]byte(`{"id":"A","imp":[{"id":"1","bidfloor":"NaN"}]}`), &req)
+		Expect(err).To(HaveOccurred())
+
+		_, err = UnmarshalLenient([]byte(`{"id":`), &req)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -1,6 +1,21 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+// Validation errors
+var (
+	ErrInvalidNativeNoRequest = errors.New("openrtb: native request missing")
+)
+
+// ErrNativeNoMarkup is returned when a bid without ad markup is decoded as native
+var ErrNativeNoMarkup = errors.New("openrtb: native markup missing")
 
 // This object represents a native type impression. Native ad units are intended to blend seamlessly into
 // the surrounding content (e.g., a sponsored Twitter or Facebook post). As such, the response must be
@@ -10,14 +25,17 @@ package openrtb
 // banner and/or video by also including as Imp subordinates the Banner and/or Video objects,
 // respectively. However, any given bid for the impression must conform to one of the offered types.
 type Native struct {
-	Request Extension `json:"request"`         // Request payload complying with the Native Ad Specification.
-	Ver     string    `json:"ver,omitempty"`   // Version of the Native Ad Specification to which request complies; highly recommended for efficient parsing.
-	API     []int     `json:"api,omitempty"`   // List of supported API frameworks for this impression.
-	BAttr   []int     `json:"battr,omitempty"` // Blocked creative attributes
-	Ext     Extension `json:"ext,omitempty"`
+	Request Extension           `json:"request"`         // Request payload complying with the Native Ad Specification.
+	Ver     string              `json:"ver,omitempty"`   // Version of the Native Ad Specification to which request complies; highly recommended for efficient parsing.
+	API     []APIFramework      `json:"api,omitempty"`   // List of supported API frameworks for this impression.
+	BAttr   []CreativeAttribute `json:"battr,omitempty"` // Blocked creative attributes
+	Ext     Extension           `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 func (nt *Native) Reset() {
+	nt.unknown = nil
 	if nt.Request != nil {
 		nt.Request = nt.Request[:0]
 	}
@@ -33,17 +51,90 @@ func (nt *Native) Reset() {
 	}
 }
 
-//var nativePool = sync.Pool{
-//	New: func() interface{} {
-//		return new(Native)
-//	},
-//}
-//
-//func NewNative() *Native{
-//	return nativePool.Get().(*Native)
-//}
-//
-//func FreeNative(nt *Native) {
-//	nt.Reset()
-//	nativePool.Put(nt)
-//}
+var nativePool = sync.Pool{
+	New: func() interface{} {
+		return new(Native)
+	},
+}
+
+func NewNative() *Native {
+	return nativePool.Get().(*Native)
+}
+
+func FreeNative(nt *Native) {
+	if nt == nil {
+		return
+	}
+	nt.Reset()
+	nativePool.Put(nt)
+}
+
+// Validates the object
+func (nt *Native) Validate() error {
+	return nt.ValidateAll().First()
+}
+
+// ValidateAll validates the object and returns all issues found.
+func (nt *Native) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	nt.validate(v, "")
+	return v.errs
+}
+
+func (nt *Native) validate(v *Validator, path string) {
+	if s := string(nt.Request); s == "" || s == "null" || s == `""` {
+		v.AddError(PathKey(path, "request"), ErrInvalidNativeNoRequest)
+	}
+}
+
+// DecodeRequest decodes the embedded native request into v, typically a
+// *request.Request of the native/request package. Requests may be transmitted
+// as an escaped JSON string or as an object and may be wrapped in a "native"
+// object, as required by Native 1.0.
+func (nt *Native) DecodeRequest(v interface{}) error {
+	if s := string(nt.Request); s == "" || s == "null" || s == `""` {
+		return ErrInvalidNativeNoRequest
+	}
+
+	data := []byte(nt.Request)
+	if data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+	return decodeNative(data, v)
+}
+
+// EncodeRequest encodes v, typically a *request.Request of the native/request
+// package, as an escaped JSON string and stores it as the request payload.
+func (nt *Native) EncodeRequest(v interface{}) error {
+	enc, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(string(enc))
+	if err != nil {
+		return err
+	}
+	nt.Request = append(nt.Request[:0], raw...)
+	return nil
+}
+
+// decodeNative decodes a native request or response into v, unwrapping the
+// Native 1.0 "native" object if present
+func decodeNative(data []byte, v interface{}) error {
+	if bytes.Contains(data, []byte(`"native"`)) {
+		var wrapper struct {
+			Native json.RawMessage `json:"native"`
+		}
+		if err := json.Unmarshal(data, &wrapper); err != nil {
+			return err
+		}
+		if len(wrapper.Native) != 0 && wrapper.Native[0] == '{' {
+			data = wrapper.Native
+		}
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,50 @@
+package request
+
+//go:generate ffjson $GOFILE
+
+import "github.com/bsm/openrtb"
+
+type EventTypeID int
+
+const (
+	EventTypeImpression     EventTypeID = 1 // Impression
+	EventTypeViewableMRC50  EventTypeID = 2 // Visible impression using MRC definition at 50% in view for 1 second
+	EventTypeViewableMRC100 EventTypeID = 3 // 100% in view for 1 second (ie GroupM standard)
+	EventTypeViewableVideo  EventTypeID = 4 // Visible impression for video using MRC definition at 50% in view for 2 seconds
+)
+
+type EventTrackingMethodID int
+
+const (
+	EventTrackingImage      EventTrackingMethodID = 1 // Image-pixel tracking - URL provided will be inserted as a 1x1 pixel at the time of the event
+	EventTrackingJavaScript EventTrackingMethodID = 2 // Javascript-based tracking - URL provided will be inserted as a js tag at the time of the event
+)
+
+// The event trackers object specifies the types of events the bidder can request to
+// be tracked in the bid response, and which types of tracking are available for each
+// event type, and is included as an array in the request.
+type EventTracker struct {
+	Event   EventTypeID             `json:"event"`   // Type of event available for tracking
+	Methods []EventTrackingMethodID `json:"methods"` // Array of types of tracking available for the given event
+	Ext     openrtb.Extension       `json:"ext,omitempty"`
+}
+
+func (et *EventTracker) Reset() {
+	et.Event = 0
+	if et.Methods != nil {
+		et.Methods = et.Methods[:0]
+	}
+	if et.Ext != nil {
+		et.Ext = et.Ext[:0]
+	}
+}
+
+// Supports returns true if the tracker offers the given method
+func (et *EventTracker) Supports(method EventTrackingMethodID) bool {
+	for _, m := range et.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
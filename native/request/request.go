@@ -80,6 +80,10 @@ type Request struct {
 	PlacementCount   int               `json:"plcmtcnt,omitempty"`       // The number of identical placements in this Layout
 	Sequence         int               `json:"seq,omitempty"`            // 0 for the first ad, 1 for the second ad, and so on
 	Assets           []Asset           `json:"assets"`                   // An array of Asset Objects
+	AURLSupport      int               `json:"aurlsupport,omitempty"`    // Whether the supply source/impression supports returning an assetsurl instead of an asset object, where 0 = no, 1 = yes (Native 1.2)
+	DURLSupport      int               `json:"durlsupport,omitempty"`    // Whether the supply source/impression supports returning a dco url instead of an asset object, where 0 = no, 1 = yes (Native 1.2)
+	EventTrackers    []EventTracker    `json:"eventtrackers,omitempty"`  // Specifies what type of event tracking is supported (Native 1.2)
+	Privacy          int               `json:"privacy,omitempty"`        // Set to 1 when the native ad supports buyer-specific privacy notice (Native 1.2)
 	Ext              openrtb.Extension `json:"ext,omitempty"`
 }
 
@@ -101,6 +105,26 @@ func (nr *Request) Reset() {
 	nr.AdUnitID = 0
 	nr.Ver = ""
 	nr.LayoutID = 0
+	nr.AURLSupport = 0
+	nr.DURLSupport = 0
+	if nr.EventTrackers != nil {
+		for i := 0; i < len(nr.EventTrackers); i++ {
+			(&nr.EventTrackers[i]).Reset()
+		}
+		nr.EventTrackers = nr.EventTrackers[:0]
+	}
+	nr.Privacy = 0
+}
+
+// SupportsEventTracker returns true if the request accepts trackers for the
+// given event and method
+func (nr *Request) SupportsEventTracker(event EventTypeID, method EventTrackingMethodID) bool {
+	for i := range nr.EventTrackers {
+		if et := &nr.EventTrackers[i]; et.Event == event && et.Supports(method) {
+			return true
+		}
+	}
+	return false
 }
 
 var nativeRequestPool = sync.Pool{
@@ -120,3 +144,14 @@ func FreeNativeRequest(nr *Request) {
 	nr.Reset()
 	nativeRequestPool.Put(nr)
 }
+
+// ParseNative decodes the native request embedded in n into a pooled Request.
+// Callers should release it via FreeNativeRequest when done.
+func ParseNative(n *openrtb.Native) (*Request, error) {
+	nr := NewNativeRequest()
+	if err := n.DecodeRequest(nr); err != nil {
+		FreeNativeRequest(nr)
+		return nil, err
+	}
+	return nr, nil
+}
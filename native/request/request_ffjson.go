@@ -95,6 +95,45 @@ func (mj *Request) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		buf.WriteString(`null`)
 	}
 	buf.WriteByte(',')
+	if mj.AURLSupport != 0 {
+		buf.WriteString(`"aurlsupport":`)
+		fflib.FormatBits2(buf, uint64(mj.AURLSupport), 10, mj.AURLSupport < 0)
+		buf.WriteByte(',')
+	}
+	if mj.DURLSupport != 0 {
+		buf.WriteString(`"durlsupport":`)
+		fflib.FormatBits2(buf, uint64(mj.DURLSupport), 10, mj.DURLSupport < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.EventTrackers) != 0 {
+		buf.WriteString(`"eventtrackers":`)
+		if mj.EventTrackers != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.EventTrackers {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					err = v.MarshalJSONBuf(buf)
+					if err != nil {
+						return err
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.Privacy != 0 {
+		buf.WriteString(`"privacy":`)
+		fflib.FormatBits2(buf, uint64(mj.Privacy), 10, mj.Privacy < 0)
+		buf.WriteByte(',')
+	}
 	if len(mj.Ext) != 0 {
 		buf.WriteString(`"ext":`)
 
@@ -136,6 +175,14 @@ const (
 
 	ffj_t_Request_Assets
 
+	ffj_t_Request_AURLSupport
+
+	ffj_t_Request_DURLSupport
+
+	ffj_t_Request_EventTrackers
+
+	ffj_t_Request_Privacy
+
 	ffj_t_Request_Ext
 )
 
@@ -157,6 +204,14 @@ var ffj_key_Request_Sequence = []byte("seq")
 
 var ffj_key_Request_Assets = []byte("assets")
 
+var ffj_key_Request_AURLSupport = []byte("aurlsupport")
+
+var ffj_key_Request_DURLSupport = []byte("durlsupport")
+
+var ffj_key_Request_EventTrackers = []byte("eventtrackers")
+
+var ffj_key_Request_Privacy = []byte("privacy")
+
 var ffj_key_Request_Ext = []byte("ext")
 
 func (uj *Request) UnmarshalJSON(input []byte) error {
@@ -229,6 +284,11 @@ mainparse:
 						currentKey = ffj_t_Request_Assets
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Request_AURLSupport, kn) {
+						currentKey = ffj_t_Request_AURLSupport
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'c':
@@ -244,9 +304,22 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'd':
+
+					if bytes.Equal(ffj_key_Request_DURLSupport, kn) {
+						currentKey = ffj_t_Request_DURLSupport
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'e':
 
-					if bytes.Equal(ffj_key_Request_Ext, kn) {
+					if bytes.Equal(ffj_key_Request_EventTrackers, kn) {
+						currentKey = ffj_t_Request_EventTrackers
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Request_Ext, kn) {
 						currentKey = ffj_t_Request_Ext
 						state = fflib.FFParse_want_colon
 						goto mainparse
@@ -271,6 +344,11 @@ mainparse:
 						currentKey = ffj_t_Request_PlacementCount
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Request_Privacy, kn) {
+						currentKey = ffj_t_Request_Privacy
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 's':
@@ -297,6 +375,30 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffj_key_Request_Privacy, kn) {
+					currentKey = ffj_t_Request_Privacy
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Request_EventTrackers, kn) {
+					currentKey = ffj_t_Request_EventTrackers
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Request_DURLSupport, kn) {
+					currentKey = ffj_t_Request_DURLSupport
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Request_AURLSupport, kn) {
+					currentKey = ffj_t_Request_AURLSupport
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.EqualFoldRight(ffj_key_Request_Assets, kn) {
 					currentKey = ffj_t_Request_Assets
 					state = fflib.FFParse_want_colon
@@ -395,6 +497,18 @@ mainparse:
 				case ffj_t_Request_Assets:
 					goto handle_Assets
 
+				case ffj_t_Request_AURLSupport:
+					goto handle_AURLSupport
+
+				case ffj_t_Request_DURLSupport:
+					goto handle_DURLSupport
+
+				case ffj_t_Request_EventTrackers:
+					goto handle_EventTrackers
+
+				case ffj_t_Request_Privacy:
+					goto handle_Privacy
+
 				case ffj_t_Request_Ext:
 					goto handle_Ext
 
@@ -717,6 +831,165 @@ handle_Assets:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_AURLSupport:
+
+	/* handler: uj.AURLSupport type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.AURLSupport = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_DURLSupport:
+
+	/* handler: uj.DURLSupport type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.DURLSupport = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_EventTrackers:
+
+	/* handler: uj.EventTrackers type=[]request.EventTracker kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.EventTrackers = nil
+		} else {
+
+			uj.EventTrackers = []EventTracker{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__EventTrackers EventTracker
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__EventTrackers type=request.EventTracker kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						state = fflib.FFParse_after_value
+						goto mainparse
+					}
+
+					err = tmp_uj__EventTrackers.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+					if err != nil {
+						return err
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				uj.EventTrackers = append(uj.EventTrackers, tmp_uj__EventTrackers)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Privacy:
+
+	/* handler: uj.Privacy type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Privacy = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_Ext:
 
 	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
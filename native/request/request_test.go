@@ -25,10 +25,123 @@ var _ = Describe("Request", func() {
 				{ID: 128, Image: &Image{TypeID: ImageTypeMain, WidthMin: 836, HeightMin: 627, Width: 1000, Height: 800, Mimes: []string{"image/jpg"}}},
 				{ID: 126, Required: 1, Data: &Data{TypeID: DataTypeSponsored, Length: 25}},
 				{ID: 127, Required: 1, Data: &Data{TypeID: DataTypeDesc, Length: 140}},
-				{ID: 4, Video: &Video{MinDuration: 15, MaxDuration: 30, Protocols: []int{openrtb.VideoProtoVAST2, openrtb.VideoProtoVAST3}, Mimes: []string{"video/mp4"}}},
+				{ID: 4, Video: &Video{Linearity: openrtb.VideoLinearityLinear, MinDuration: 15, MaxDuration: 30, Protocols: []openrtb.Protocol{openrtb.VideoProtoVAST2, openrtb.VideoProtoVAST3}, Mimes: []string{"video/mp4"}}},
 			},
 		}))
 	})
+
+	It("should parse embedded requests", func() {
+		data, err := ioutil.ReadFile("testdata/request1.json")
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, n := range []*openrtb.Native{
+			{Request: openrtb.Extension(data)},
+			{Request: openrtb.Extension(`{"native":` + string(data) + `}`)},
+		} {
+			nr, err := ParseNative(n)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nr).To(Equal(fixture("testdata/request1.json")))
+
+			enc := new(openrtb.Native)
+			Expect(enc.EncodeRequest(nr)).To(Succeed())
+			Expect(enc.Request[0]).To(Equal(byte('"')))
+			FreeNativeRequest(nr)
+
+			nr, err = ParseNative(enc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nr).To(Equal(fixture("testdata/request1.json")))
+			FreeNativeRequest(nr)
+		}
+
+		_, err = ParseNative(&openrtb.Native{})
+		Expect(err).To(Equal(openrtb.ErrInvalidNativeNoRequest))
+	})
+
+	It("should convert video assets", func() {
+		boxing := 0
+		src := &openrtb.Video{
+			Mimes:          []string{"video/mp4"},
+			MinDuration:    5,
+			MaxDuration:    30,
+			Protocol:       openrtb.VideoProtoVAST3,
+			W:              640,
+			H:              480,
+			Placement:      openrtb.VideoPlacementInFeed,
+			Linearity:      openrtb.VideoLinearityLinear,
+			Skip:           1,
+			SkipAfter:      5,
+			MaxBitrate:     2000,
+			BoxingAllowed:  &boxing,
+			PlaybackMethod: []openrtb.PlaybackMethod{openrtb.VideoPlaybackClickToPlay},
+			Api:            []openrtb.APIFramework{openrtb.APIFrameworkVPAID2},
+			Sequence:       2,
+		}
+
+		v := FromVideo(src)
+		Expect(v).To(Equal(&Video{
+			Mimes:          []string{"video/mp4"},
+			MinDuration:    5,
+			MaxDuration:    30,
+			Protocols:      []openrtb.Protocol{openrtb.VideoProtoVAST3},
+			W:              640,
+			H:              480,
+			Placement:      openrtb.VideoPlacementInFeed,
+			Linearity:      openrtb.VideoLinearityLinear,
+			Skip:           1,
+			SkipAfter:      5,
+			MaxBitrate:     2000,
+			BoxingAllowed:  &boxing,
+			PlaybackMethod: []openrtb.PlaybackMethod{openrtb.VideoPlaybackClickToPlay},
+			API:            []openrtb.APIFramework{openrtb.APIFrameworkVPAID2},
+		}))
+		Expect(v.BoxingAllowed).NotTo(BeIdenticalTo(src.BoxingAllowed))
+
+		back := v.ToVideo()
+		Expect(back.Protocols).To(Equal([]openrtb.Protocol{openrtb.VideoProtoVAST3}))
+		Expect(back.Api).To(Equal(src.Api))
+		Expect(back.Sequence).To(BeZero())
+		Expect(FromVideo(back)).To(Equal(v))
+	})
+
+	It("should validate", func() {
+		Expect(fixture("testdata/request1.json").Validate()).To(Succeed())
+		Expect(fixture("testdata/request2.json").Validate()).To(Succeed())
+		Expect((&Request{}).Validate()).To(Equal(ErrInvalidNoAssets))
+
+		req := &Request{Assets: []Asset{
+			{ID: 1, Title: &Title{Length: 25}},
+			{ID: 1, Data: &Data{TypeID: DataTypeDesc}},
+			{ID: 2},
+			{ID: 3, Title: &Title{Length: 25}, Data: &Data{TypeID: DataTypeDesc}},
+			{ID: 4, Image: &Image{WidthMin: -1, Height: 100, HeightMin: 200}},
+		}}
+		errs := req.ValidateAll()
+		Expect(errs.Error()).To(Equal("" +
+			"assets[1].id: openrtb: native asset ID is not unique; " +
+			"assets[2]: openrtb: native asset must contain exactly one of title, img, video or data; " +
+			"assets[3]: openrtb: native asset must contain exactly one of title, img, video or data; " +
+			"assets[4].img.wmin: openrtb: native image minimum size invalid; " +
+			"assets[4].img.hmin: openrtb: native image minimum size exceeds exact size"))
+		Expect(req.Validate()).To(Equal(ErrInvalidAssetDuplicateID))
+	})
+
+	It("should parse 1.2 requests", func() {
+		req := fixture("testdata/request2.json")
+		Expect(req.Ver).To(Equal("1.2"))
+		Expect(req.AURLSupport).To(Equal(1))
+		Expect(req.Privacy).To(Equal(1))
+		Expect(req.EventTrackers).To(Equal([]EventTracker{
+			{Event: EventTypeImpression, Methods: []EventTrackingMethodID{EventTrackingImage, EventTrackingJavaScript}},
+			{Event: EventTypeViewableMRC50, Methods: []EventTrackingMethodID{EventTrackingJavaScript}},
+		}))
+		Expect(req.SupportsEventTracker(EventTypeImpression, EventTrackingJavaScript)).To(BeTrue())
+		Expect(req.SupportsEventTracker(EventTypeViewableMRC50, EventTrackingImage)).To(BeFalse())
+		Expect(req.SupportsEventTracker(EventTypeViewableVideo, EventTrackingJavaScript)).To(BeFalse())
+
+		req.Reset()
+		Expect(req.EventTrackers).To(BeEmpty())
+		Expect(req.Privacy).To(BeZero())
+	})
 })
 
 func TestSuite(t *testing.T) {
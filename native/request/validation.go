@@ -0,0 +1,85 @@
+package request
+
+import (
+	"errors"
+
+	"github.com/bsm/openrtb"
+)
+
+// Validation errors
+var (
+	ErrInvalidNoAssets             = errors.New("openrtb: native request has no assets")
+	ErrInvalidAssetDuplicateID     = errors.New("openrtb: native asset ID is not unique")
+	ErrInvalidAssetType            = errors.New("openrtb: native asset must contain exactly one of title, img, video or data")
+	ErrInvalidImageMinSize         = errors.New("openrtb: native image minimum size invalid")
+	ErrInvalidImageMinSizeExceeded = errors.New("openrtb: native image minimum size exceeds exact size")
+)
+
+// Validate the request, returns the first error found
+func (nr *Request) Validate() error {
+	return nr.ValidateAll().First()
+}
+
+// ValidateAll validates the request and returns all issues found.
+func (nr *Request) ValidateAll() openrtb.ValidationErrors {
+	v := new(openrtb.Validator)
+	nr.validate(v, "")
+	return v.Issues()
+}
+
+func (nr *Request) validate(v *openrtb.Validator, path string) {
+	if len(nr.Assets) == 0 {
+		v.AddError(openrtb.PathKey(path, "assets"), ErrInvalidNoAssets)
+	}
+
+	seen := make(map[int]struct{}, len(nr.Assets))
+	for i := range nr.Assets {
+		asset := &nr.Assets[i]
+		apath := openrtb.PathIndex(openrtb.PathKey(path, "assets"), i)
+		if _, ok := seen[asset.ID]; ok {
+			v.AddError(openrtb.PathKey(apath, "id"), ErrInvalidAssetDuplicateID)
+		}
+		seen[asset.ID] = struct{}{}
+		asset.validate(v, apath)
+	}
+}
+
+func (a *Asset) validate(v *openrtb.Validator, path string) {
+	if a.numTypes() != 1 {
+		v.AddError(path, ErrInvalidAssetType)
+	}
+	if a.Image != nil {
+		a.Image.validate(v, openrtb.PathKey(path, "img"))
+	}
+}
+
+// numTypes returns the number of asset types set
+func (a *Asset) numTypes() int {
+	n := 0
+	if a.Title != nil {
+		n++
+	}
+	if a.Image != nil {
+		n++
+	}
+	if a.Video != nil {
+		n++
+	}
+	if a.Data != nil {
+		n++
+	}
+	return n
+}
+
+func (i *Image) validate(v *openrtb.Validator, path string) {
+	if i.WidthMin < 0 {
+		v.AddError(openrtb.PathKey(path, "wmin"), ErrInvalidImageMinSize)
+	} else if i.Width > 0 && i.WidthMin > i.Width {
+		v.AddError(openrtb.PathKey(path, "wmin"), ErrInvalidImageMinSizeExceeded)
+	}
+	if i.HeightMin < 0 {
+		v.AddError(openrtb.PathKey(path, "hmin"), ErrInvalidImageMinSize)
+	} else if i.Height > 0 && i.HeightMin > i.Height {
+		v.AddError(openrtb.PathKey(path, "hmin"), ErrInvalidImageMinSizeExceeded)
+	}
+}
@@ -4,25 +4,140 @@ package request
 
 import "github.com/bsm/openrtb"
 
-// TODO unclear if its the same as imp.video https://github.com/openrtb/OpenRTB/issues/26
+// The video object to be used for all video elements supported in the Native Ad.
+// This corresponds to the Video object of OpenRTB, exchange implementers can
+// impose their own specific restrictions.
 type Video struct {
-	Mimes       []string          `json:"mimes,omitempty"`       // Whitelist of content MIME types supported
-	MinDuration int               `json:"minduration,omitempty"` // Minimum video ad duration in seconds
-	MaxDuration int               `json:"maxduration,omitempty"` // Maximum video ad duration in seconds
-	Protocols   []int             `json:"protocols,omitempty"`   // Video bid response protocols
-	Ext         openrtb.Extension `json:"ext,omitempty"`
+	Mimes          []string                    `json:"mimes,omitempty"`          // Whitelist of content MIME types supported
+	MinDuration    int                         `json:"minduration,omitempty"`    // Minimum video ad duration in seconds
+	MaxDuration    int                         `json:"maxduration,omitempty"`    // Maximum video ad duration in seconds
+	Protocols      []openrtb.Protocol          `json:"protocols,omitempty"`      // Video bid response protocols
+	W              int                         `json:"w,omitempty"`              // Width of the player in pixels
+	H              int                         `json:"h,omitempty"`              // Height of the player in pixels
+	StartDelay     int                         `json:"startdelay,omitempty"`     // Indicates the start delay in seconds
+	Placement      openrtb.VideoPlacement      `json:"placement,omitempty"`      // Video placement type
+	Plcmt          openrtb.VideoPlcmt          `json:"plcmt,omitempty"`          // Video placement type per the updated IAB Tech Lab definitions
+	Linearity      openrtb.VideoLinearity      `json:"linearity,omitempty"`      // Indicates whether the ad impression is linear or non-linear
+	Skip           int                         `json:"skip,omitempty"`           // Indicates if the player will allow the video to be skipped, where 0 = no, 1 = yes
+	SkipMin        int                         `json:"skipmin,omitempty"`        // Videos of total duration greater than this number of seconds can be skippable
+	SkipAfter      int                         `json:"skipafter,omitempty"`      // Number of seconds a video must play before skipping is enabled
+	BAttr          []openrtb.CreativeAttribute `json:"battr,omitempty"`          // Blocked creative attributes
+	MinBitrate     int                         `json:"minbitrate,omitempty"`     // Minimum bit rate in Kbps
+	MaxBitrate     int                         `json:"maxbitrate,omitempty"`     // Maximum bit rate in Kbps
+	BoxingAllowed  *int                        `json:"boxingallowed,omitempty"`  // If exchange publisher has rules preventing letter boxing
+	PlaybackMethod []openrtb.PlaybackMethod    `json:"playbackmethod,omitempty"` // List of allowed playback methods
+	Delivery       []openrtb.ContentDelivery   `json:"delivery,omitempty"`       // List of supported delivery methods
+	Pos            openrtb.AdPosition          `json:"pos,omitempty"`            // Ad Position
+	API            []openrtb.APIFramework      `json:"api,omitempty"`            // List of supported API frameworks
+	Ext            openrtb.Extension           `json:"ext,omitempty"`
 }
 
 func (v *Video) Reset() {
-	v.MinDuration = 0
-	v.MaxDuration = 0
 	if v.Mimes != nil {
 		v.Mimes = v.Mimes[:0]
 	}
+	v.MinDuration = 0
+	v.MaxDuration = 0
 	if v.Protocols != nil {
 		v.Protocols = v.Protocols[:0]
 	}
+	v.W = 0
+	v.H = 0
+	v.StartDelay = 0
+	v.Placement = 0
+	v.Plcmt = 0
+	v.Linearity = 0
+	v.Skip = 0
+	v.SkipMin = 0
+	v.SkipAfter = 0
+	if v.BAttr != nil {
+		v.BAttr = v.BAttr[:0]
+	}
+	v.MinBitrate = 0
+	v.MaxBitrate = 0
+	v.BoxingAllowed = nil
+	if v.PlaybackMethod != nil {
+		v.PlaybackMethod = v.PlaybackMethod[:0]
+	}
+	if v.Delivery != nil {
+		v.Delivery = v.Delivery[:0]
+	}
+	v.Pos = 0
+	if v.API != nil {
+		v.API = v.API[:0]
+	}
 	if v.Ext != nil {
 		v.Ext = v.Ext[:0]
 	}
 }
+
+// FromVideo creates a native video asset from the constraints of an
+// imp.video object. Fields without a native counterpart, such as pods,
+// companions and sequences, are not carried over.
+func FromVideo(src *openrtb.Video) *Video {
+	protocols := src.Protocols
+	if len(protocols) == 0 && src.Protocol != 0 {
+		protocols = []openrtb.Protocol{src.Protocol}
+	}
+
+	v := &Video{
+		Mimes:          append([]string(nil), src.Mimes...),
+		MinDuration:    src.MinDuration,
+		MaxDuration:    src.MaxDuration,
+		Protocols:      append([]openrtb.Protocol(nil), protocols...),
+		W:              src.W,
+		H:              src.H,
+		StartDelay:     src.StartDelay,
+		Placement:      src.Placement,
+		Plcmt:          src.Plcmt,
+		Linearity:      src.Linearity,
+		Skip:           src.Skip,
+		SkipMin:        src.SkipMin,
+		SkipAfter:      src.SkipAfter,
+		BAttr:          append([]openrtb.CreativeAttribute(nil), src.BAttr...),
+		MinBitrate:     src.MinBitrate,
+		MaxBitrate:     src.MaxBitrate,
+		PlaybackMethod: append([]openrtb.PlaybackMethod(nil), src.PlaybackMethod...),
+		Delivery:       append([]openrtb.ContentDelivery(nil), src.Delivery...),
+		Pos:            src.Pos,
+		API:            append([]openrtb.APIFramework(nil), src.Api...),
+		Ext:            append(openrtb.Extension(nil), src.Ext...),
+	}
+	if src.BoxingAllowed != nil {
+		n := *src.BoxingAllowed
+		v.BoxingAllowed = &n
+	}
+	return v
+}
+
+// ToVideo converts the native video asset into an imp.video object.
+func (v *Video) ToVideo() *openrtb.Video {
+	dst := &openrtb.Video{
+		Mimes:          append([]string(nil), v.Mimes...),
+		MinDuration:    v.MinDuration,
+		MaxDuration:    v.MaxDuration,
+		Protocols:      append([]openrtb.Protocol(nil), v.Protocols...),
+		W:              v.W,
+		H:              v.H,
+		StartDelay:     v.StartDelay,
+		Placement:      v.Placement,
+		Plcmt:          v.Plcmt,
+		Linearity:      v.Linearity,
+		Skip:           v.Skip,
+		SkipMin:        v.SkipMin,
+		SkipAfter:      v.SkipAfter,
+		BAttr:          append([]openrtb.CreativeAttribute(nil), v.BAttr...),
+		MinBitrate:     v.MinBitrate,
+		MaxBitrate:     v.MaxBitrate,
+		PlaybackMethod: append([]openrtb.PlaybackMethod(nil), v.PlaybackMethod...),
+		Delivery:       append([]openrtb.ContentDelivery(nil), v.Delivery...),
+		Pos:            v.Pos,
+		Api:            append([]openrtb.APIFramework(nil), v.API...),
+		Ext:            append(openrtb.Extension(nil), v.Ext...),
+	}
+	if v.BoxingAllowed != nil {
+		n := *v.BoxingAllowed
+		dst.BoxingAllowed = &n
+	}
+	return dst
+}
@@ -8,6 +8,7 @@ package request
 import (
 	"bytes"
 	"fmt"
+	"github.com/bsm/openrtb"
 	fflib "github.com/pquerna/ffjson/fflib/v1"
 )
 
@@ -75,6 +76,137 @@ func (mj *Video) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	if mj.W != 0 {
+		buf.WriteString(`"w":`)
+		fflib.FormatBits2(buf, uint64(mj.W), 10, mj.W < 0)
+		buf.WriteByte(',')
+	}
+	if mj.H != 0 {
+		buf.WriteString(`"h":`)
+		fflib.FormatBits2(buf, uint64(mj.H), 10, mj.H < 0)
+		buf.WriteByte(',')
+	}
+	if mj.StartDelay != 0 {
+		buf.WriteString(`"startdelay":`)
+		fflib.FormatBits2(buf, uint64(mj.StartDelay), 10, mj.StartDelay < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Placement != 0 {
+		buf.WriteString(`"placement":`)
+		fflib.FormatBits2(buf, uint64(mj.Placement), 10, mj.Placement < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Plcmt != 0 {
+		buf.WriteString(`"plcmt":`)
+		fflib.FormatBits2(buf, uint64(mj.Plcmt), 10, mj.Plcmt < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Linearity != 0 {
+		buf.WriteString(`"linearity":`)
+		fflib.FormatBits2(buf, uint64(mj.Linearity), 10, mj.Linearity < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Skip != 0 {
+		buf.WriteString(`"skip":`)
+		fflib.FormatBits2(buf, uint64(mj.Skip), 10, mj.Skip < 0)
+		buf.WriteByte(',')
+	}
+	if mj.SkipMin != 0 {
+		buf.WriteString(`"skipmin":`)
+		fflib.FormatBits2(buf, uint64(mj.SkipMin), 10, mj.SkipMin < 0)
+		buf.WriteByte(',')
+	}
+	if mj.SkipAfter != 0 {
+		buf.WriteString(`"skipafter":`)
+		fflib.FormatBits2(buf, uint64(mj.SkipAfter), 10, mj.SkipAfter < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.BAttr) != 0 {
+		buf.WriteString(`"battr":`)
+		if mj.BAttr != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.BAttr {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.MinBitrate != 0 {
+		buf.WriteString(`"minbitrate":`)
+		fflib.FormatBits2(buf, uint64(mj.MinBitrate), 10, mj.MinBitrate < 0)
+		buf.WriteByte(',')
+	}
+	if mj.MaxBitrate != 0 {
+		buf.WriteString(`"maxbitrate":`)
+		fflib.FormatBits2(buf, uint64(mj.MaxBitrate), 10, mj.MaxBitrate < 0)
+		buf.WriteByte(',')
+	}
+	if mj.BoxingAllowed != nil {
+		if true {
+			buf.WriteString(`"boxingallowed":`)
+			fflib.FormatBits2(buf, uint64(*mj.BoxingAllowed), 10, *mj.BoxingAllowed < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if len(mj.PlaybackMethod) != 0 {
+		buf.WriteString(`"playbackmethod":`)
+		if mj.PlaybackMethod != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.PlaybackMethod {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.Delivery) != 0 {
+		buf.WriteString(`"delivery":`)
+		if mj.Delivery != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.Delivery {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if mj.Pos != 0 {
+		buf.WriteString(`"pos":`)
+		fflib.FormatBits2(buf, uint64(mj.Pos), 10, mj.Pos < 0)
+		buf.WriteByte(',')
+	}
+	if len(mj.API) != 0 {
+		buf.WriteString(`"api":`)
+		if mj.API != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.API {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.FormatBits2(buf, uint64(v), 10, v < 0)
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
 	if len(mj.Ext) != 0 {
 		buf.WriteString(`"ext":`)
 
@@ -106,6 +238,40 @@ const (
 
 	ffj_t_Video_Protocols
 
+	ffj_t_Video_W
+
+	ffj_t_Video_H
+
+	ffj_t_Video_StartDelay
+
+	ffj_t_Video_Placement
+
+	ffj_t_Video_Plcmt
+
+	ffj_t_Video_Linearity
+
+	ffj_t_Video_Skip
+
+	ffj_t_Video_SkipMin
+
+	ffj_t_Video_SkipAfter
+
+	ffj_t_Video_BAttr
+
+	ffj_t_Video_MinBitrate
+
+	ffj_t_Video_MaxBitrate
+
+	ffj_t_Video_BoxingAllowed
+
+	ffj_t_Video_PlaybackMethod
+
+	ffj_t_Video_Delivery
+
+	ffj_t_Video_Pos
+
+	ffj_t_Video_API
+
 	ffj_t_Video_Ext
 )
 
@@ -117,6 +283,40 @@ var ffj_key_Video_MaxDuration = []byte("maxduration")
 
 var ffj_key_Video_Protocols = []byte("protocols")
 
+var ffj_key_Video_W = []byte("w")
+
+var ffj_key_Video_H = []byte("h")
+
+var ffj_key_Video_StartDelay = []byte("startdelay")
+
+var ffj_key_Video_Placement = []byte("placement")
+
+var ffj_key_Video_Plcmt = []byte("plcmt")
+
+var ffj_key_Video_Linearity = []byte("linearity")
+
+var ffj_key_Video_Skip = []byte("skip")
+
+var ffj_key_Video_SkipMin = []byte("skipmin")
+
+var ffj_key_Video_SkipAfter = []byte("skipafter")
+
+var ffj_key_Video_BAttr = []byte("battr")
+
+var ffj_key_Video_MinBitrate = []byte("minbitrate")
+
+var ffj_key_Video_MaxBitrate = []byte("maxbitrate")
+
+var ffj_key_Video_BoxingAllowed = []byte("boxingallowed")
+
+var ffj_key_Video_PlaybackMethod = []byte("playbackmethod")
+
+var ffj_key_Video_Delivery = []byte("delivery")
+
+var ffj_key_Video_Pos = []byte("pos")
+
+var ffj_key_Video_API = []byte("api")
+
 var ffj_key_Video_Ext = []byte("ext")
 
 func (uj *Video) UnmarshalJSON(input []byte) error {
@@ -178,6 +378,35 @@ mainparse:
 			} else {
 				switch kn[0] {
 
+				case 'a':
+
+					if bytes.Equal(ffj_key_Video_API, kn) {
+						currentKey = ffj_t_Video_API
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'b':
+
+					if bytes.Equal(ffj_key_Video_BAttr, kn) {
+						currentKey = ffj_t_Video_BAttr
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Video_BoxingAllowed, kn) {
+						currentKey = ffj_t_Video_BoxingAllowed
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_Video_Delivery, kn) {
+						currentKey = ffj_t_Video_Delivery
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'e':
 
 					if bytes.Equal(ffj_key_Video_Ext, kn) {
@@ -186,6 +415,22 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'h':
+
+					if bytes.Equal(ffj_key_Video_H, kn) {
+						currentKey = ffj_t_Video_H
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'l':
+
+					if bytes.Equal(ffj_key_Video_Linearity, kn) {
+						currentKey = ffj_t_Video_Linearity
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'm':
 
 					if bytes.Equal(ffj_key_Video_Mimes, kn) {
@@ -202,6 +447,16 @@ mainparse:
 						currentKey = ffj_t_Video_MaxDuration
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Video_MinBitrate, kn) {
+						currentKey = ffj_t_Video_MinBitrate
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Video_MaxBitrate, kn) {
+						currentKey = ffj_t_Video_MaxBitrate
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'p':
@@ -210,6 +465,57 @@ mainparse:
 						currentKey = ffj_t_Video_Protocols
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Video_Placement, kn) {
+						currentKey = ffj_t_Video_Placement
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Video_Plcmt, kn) {
+						currentKey = ffj_t_Video_Plcmt
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Video_PlaybackMethod, kn) {
+						currentKey = ffj_t_Video_PlaybackMethod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Video_Pos, kn) {
+						currentKey = ffj_t_Video_Pos
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffj_key_Video_StartDelay, kn) {
+						currentKey = ffj_t_Video_StartDelay
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Video_Skip, kn) {
+						currentKey = ffj_t_Video_Skip
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Video_SkipMin, kn) {
+						currentKey = ffj_t_Video_SkipMin
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Video_SkipAfter, kn) {
+						currentKey = ffj_t_Video_SkipAfter
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'w':
+
+					if bytes.Equal(ffj_key_Video_W, kn) {
+						currentKey = ffj_t_Video_W
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				}
@@ -220,6 +526,108 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffj_key_Video_API, kn) {
+					currentKey = ffj_t_Video_API
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Video_Pos, kn) {
+					currentKey = ffj_t_Video_Pos
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Video_Delivery, kn) {
+					currentKey = ffj_t_Video_Delivery
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Video_PlaybackMethod, kn) {
+					currentKey = ffj_t_Video_PlaybackMethod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Video_BoxingAllowed, kn) {
+					currentKey = ffj_t_Video_BoxingAllowed
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Video_MaxBitrate, kn) {
+					currentKey = ffj_t_Video_MaxBitrate
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Video_MinBitrate, kn) {
+					currentKey = ffj_t_Video_MinBitrate
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Video_BAttr, kn) {
+					currentKey = ffj_t_Video_BAttr
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Video_SkipAfter, kn) {
+					currentKey = ffj_t_Video_SkipAfter
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Video_SkipMin, kn) {
+					currentKey = ffj_t_Video_SkipMin
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Video_Skip, kn) {
+					currentKey = ffj_t_Video_Skip
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Video_Linearity, kn) {
+					currentKey = ffj_t_Video_Linearity
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Video_Plcmt, kn) {
+					currentKey = ffj_t_Video_Plcmt
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Video_Placement, kn) {
+					currentKey = ffj_t_Video_Placement
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Video_StartDelay, kn) {
+					currentKey = ffj_t_Video_StartDelay
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Video_H, kn) {
+					currentKey = ffj_t_Video_H
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Video_W, kn) {
+					currentKey = ffj_t_Video_W
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.EqualFoldRight(ffj_key_Video_Protocols, kn) {
 					currentKey = ffj_t_Video_Protocols
 					state = fflib.FFParse_want_colon
@@ -273,22 +681,73 @@ mainparse:
 				case ffj_t_Video_Protocols:
 					goto handle_Protocols
 
-				case ffj_t_Video_Ext:
-					goto handle_Ext
+				case ffj_t_Video_W:
+					goto handle_W
 
-				case ffj_t_Videono_such_key:
-					err = fs.SkipField(tok)
-					if err != nil {
-						return fs.WrapErr(err)
-					}
-					state = fflib.FFParse_after_value
-					goto mainparse
-				}
-			} else {
-				goto wantedvalue
-			}
-		}
-	}
+				case ffj_t_Video_H:
+					goto handle_H
+
+				case ffj_t_Video_StartDelay:
+					goto handle_StartDelay
+
+				case ffj_t_Video_Placement:
+					goto handle_Placement
+
+				case ffj_t_Video_Plcmt:
+					goto handle_Plcmt
+
+				case ffj_t_Video_Linearity:
+					goto handle_Linearity
+
+				case ffj_t_Video_Skip:
+					goto handle_Skip
+
+				case ffj_t_Video_SkipMin:
+					goto handle_SkipMin
+
+				case ffj_t_Video_SkipAfter:
+					goto handle_SkipAfter
+
+				case ffj_t_Video_BAttr:
+					goto handle_BAttr
+
+				case ffj_t_Video_MinBitrate:
+					goto handle_MinBitrate
+
+				case ffj_t_Video_MaxBitrate:
+					goto handle_MaxBitrate
+
+				case ffj_t_Video_BoxingAllowed:
+					goto handle_BoxingAllowed
+
+				case ffj_t_Video_PlaybackMethod:
+					goto handle_PlaybackMethod
+
+				case ffj_t_Video_Delivery:
+					goto handle_Delivery
+
+				case ffj_t_Video_Pos:
+					goto handle_Pos
+
+				case ffj_t_Video_API:
+					goto handle_API
+
+				case ffj_t_Video_Ext:
+					goto handle_Ext
+
+				case ffj_t_Videono_such_key:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
 
 handle_Mimes:
 
@@ -426,7 +885,7 @@ handle_MaxDuration:
 
 handle_Protocols:
 
-	/* handler: uj.Protocols type=[]int kind=slice quoted=false*/
+	/* handler: uj.Protocols type=[]openrtb.Protocol kind=slice quoted=false*/
 
 	{
 
@@ -440,13 +899,13 @@ handle_Protocols:
 			uj.Protocols = nil
 		} else {
 
-			uj.Protocols = []int{}
+			uj.Protocols = []openrtb.Protocol{}
 
 			wantVal := true
 
 			for {
 
-				var tmp_uj__Protocols int
+				var tmp_uj__Protocols openrtb.Protocol
 
 				tok = fs.Scan()
 				if tok == fflib.FFTok_error {
@@ -467,11 +926,11 @@ handle_Protocols:
 					wantVal = true
 				}
 
-				/* handler: tmp_uj__Protocols type=int kind=int quoted=false*/
+				/* handler: tmp_uj__Protocols type=openrtb.Protocol kind=int quoted=false*/
 
 				{
 					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
-						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for Protocol", tok))
 					}
 				}
 
@@ -487,7 +946,7 @@ handle_Protocols:
 							return fs.WrapErr(err)
 						}
 
-						tmp_uj__Protocols = int(tval)
+						tmp_uj__Protocols = openrtb.Protocol(tval)
 
 					}
 				}
@@ -502,6 +961,711 @@ handle_Protocols:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_W:
+
+	/* handler: uj.W type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.W = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_H:
+
+	/* handler: uj.H type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.H = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_StartDelay:
+
+	/* handler: uj.StartDelay type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.StartDelay = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Placement:
+
+	/* handler: uj.Placement type=openrtb.VideoPlacement kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for VideoPlacement", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Placement = openrtb.VideoPlacement(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Plcmt:
+
+	/* handler: uj.Plcmt type=openrtb.VideoPlcmt kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for VideoPlcmt", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Plcmt = openrtb.VideoPlcmt(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Linearity:
+
+	/* handler: uj.Linearity type=openrtb.VideoLinearity kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for VideoLinearity", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Linearity = openrtb.VideoLinearity(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Skip:
+
+	/* handler: uj.Skip type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Skip = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SkipMin:
+
+	/* handler: uj.SkipMin type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.SkipMin = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SkipAfter:
+
+	/* handler: uj.SkipAfter type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.SkipAfter = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BAttr:
+
+	/* handler: uj.BAttr type=[]openrtb.CreativeAttribute kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.BAttr = nil
+		} else {
+
+			uj.BAttr = []openrtb.CreativeAttribute{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__BAttr openrtb.CreativeAttribute
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__BAttr type=openrtb.CreativeAttribute kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for CreativeAttribute", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__BAttr = openrtb.CreativeAttribute(tval)
+
+					}
+				}
+
+				uj.BAttr = append(uj.BAttr, tmp_uj__BAttr)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MinBitrate:
+
+	/* handler: uj.MinBitrate type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MinBitrate = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MaxBitrate:
+
+	/* handler: uj.MaxBitrate type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.MaxBitrate = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BoxingAllowed:
+
+	/* handler: uj.BoxingAllowed type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			uj.BoxingAllowed = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int(tval)
+			uj.BoxingAllowed = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PlaybackMethod:
+
+	/* handler: uj.PlaybackMethod type=[]openrtb.PlaybackMethod kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.PlaybackMethod = nil
+		} else {
+
+			uj.PlaybackMethod = []openrtb.PlaybackMethod{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__PlaybackMethod openrtb.PlaybackMethod
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__PlaybackMethod type=openrtb.PlaybackMethod kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for PlaybackMethod", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__PlaybackMethod = openrtb.PlaybackMethod(tval)
+
+					}
+				}
+
+				uj.PlaybackMethod = append(uj.PlaybackMethod, tmp_uj__PlaybackMethod)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Delivery:
+
+	/* handler: uj.Delivery type=[]openrtb.ContentDelivery kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.Delivery = nil
+		} else {
+
+			uj.Delivery = []openrtb.ContentDelivery{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__Delivery openrtb.ContentDelivery
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__Delivery type=openrtb.ContentDelivery kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ContentDelivery", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__Delivery = openrtb.ContentDelivery(tval)
+
+					}
+				}
+
+				uj.Delivery = append(uj.Delivery, tmp_uj__Delivery)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Pos:
+
+	/* handler: uj.Pos type=openrtb.AdPosition kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for AdPosition", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Pos = openrtb.AdPosition(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_API:
+
+	/* handler: uj.API type=[]openrtb.APIFramework kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.API = nil
+		} else {
+
+			uj.API = []openrtb.APIFramework{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__API openrtb.APIFramework
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__API type=openrtb.APIFramework kind=int quoted=false*/
+
+				{
+					if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+						return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for APIFramework", tok))
+					}
+				}
+
+				{
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+						if err != nil {
+							return fs.WrapErr(err)
+						}
+
+						tmp_uj__API = openrtb.APIFramework(tval)
+
+					}
+				}
+
+				uj.API = append(uj.API, tmp_uj__API)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_Ext:
 
 	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
@@ -2,15 +2,22 @@ package response
 
 //go:generate ffjson $GOFILE
 
-import "github.com/bsm/openrtb"
+import (
+	"github.com/bsm/openrtb"
+	"github.com/bsm/openrtb/native/request"
+)
 
 type Data struct {
-	Label string            `json:"label,omitempty"` // The optional formatted string name of the data type to be displayed
-	Value string            `json:"value"`           // The formatted string of data to be displayed. Can contain a formatted value such as “5 stars” or “$10” or “3.4 stars out of 5”
-	Ext   openrtb.Extension `json:"ext,omitempty"`
+	TypeID request.DataTypeID `json:"type,omitempty"`  // Type ID of the element supported by the publisher, required for assetsurl or dcourl responses (Native 1.2)
+	Length int                `json:"len,omitempty"`   // Length of the response, required for assetsurl or dcourl responses (Native 1.2)
+	Label  string             `json:"label,omitempty"` // DEPRECATED The optional formatted string name of the data type to be displayed
+	Value  string             `json:"value"`           // The formatted string of data to be displayed. Can contain a formatted value such as “5 stars” or “$10” or “3.4 stars out of 5”
+	Ext    openrtb.Extension  `json:"ext,omitempty"`
 }
 
 func (d *Data) Reset() {
+	d.TypeID = 0
+	d.Length = 0
 	d.Label = ""
 	d.Value = ""
 	if d.Ext != nil {
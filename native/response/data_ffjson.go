@@ -8,6 +8,7 @@ package response
 import (
 	"bytes"
 	"fmt"
+	"github.com/bsm/openrtb/native/request"
 	fflib "github.com/pquerna/ffjson/fflib/v1"
 )
 
@@ -33,6 +34,16 @@ func (mj *Data) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = obj
 	_ = err
 	buf.WriteString(`{ `)
+	if mj.TypeID != 0 {
+		buf.WriteString(`"type":`)
+		fflib.FormatBits2(buf, uint64(mj.TypeID), 10, mj.TypeID < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Length != 0 {
+		buf.WriteString(`"len":`)
+		fflib.FormatBits2(buf, uint64(mj.Length), 10, mj.Length < 0)
+		buf.WriteByte(',')
+	}
 	if len(mj.Label) != 0 {
 		buf.WriteString(`"label":`)
 		fflib.WriteJsonString(buf, string(mj.Label))
@@ -64,6 +75,10 @@ const (
 	ffj_t_Database = iota
 	ffj_t_Datano_such_key
 
+	ffj_t_Data_TypeID
+
+	ffj_t_Data_Length
+
 	ffj_t_Data_Label
 
 	ffj_t_Data_Value
@@ -71,6 +86,10 @@ const (
 	ffj_t_Data_Ext
 )
 
+var ffj_key_Data_TypeID = []byte("type")
+
+var ffj_key_Data_Length = []byte("len")
+
 var ffj_key_Data_Label = []byte("label")
 
 var ffj_key_Data_Value = []byte("value")
@@ -146,12 +165,25 @@ mainparse:
 
 				case 'l':
 
-					if bytes.Equal(ffj_key_Data_Label, kn) {
+					if bytes.Equal(ffj_key_Data_Length, kn) {
+						currentKey = ffj_t_Data_Length
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Data_Label, kn) {
 						currentKey = ffj_t_Data_Label
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
+				case 't':
+
+					if bytes.Equal(ffj_key_Data_TypeID, kn) {
+						currentKey = ffj_t_Data_TypeID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'v':
 
 					if bytes.Equal(ffj_key_Data_Value, kn) {
@@ -180,6 +212,18 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffj_key_Data_Length, kn) {
+					currentKey = ffj_t_Data_Length
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Data_TypeID, kn) {
+					currentKey = ffj_t_Data_TypeID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				currentKey = ffj_t_Datano_such_key
 				state = fflib.FFParse_want_colon
 				goto mainparse
@@ -197,6 +241,12 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
+				case ffj_t_Data_TypeID:
+					goto handle_TypeID
+
+				case ffj_t_Data_Length:
+					goto handle_Length
+
 				case ffj_t_Data_Label:
 					goto handle_Label
 
@@ -220,6 +270,66 @@ mainparse:
 		}
 	}
 
+handle_TypeID:
+
+	/* handler: uj.TypeID type=request.DataTypeID kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for DataTypeID", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.TypeID = request.DataTypeID(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Length:
+
+	/* handler: uj.Length type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Length = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_Label:
 
 	/* handler: uj.Label type=string kind=string quoted=false*/
@@ -0,0 +1,30 @@
+package response
+
+//go:generate ffjson $GOFILE
+
+import (
+	"github.com/bsm/openrtb"
+	"github.com/bsm/openrtb/native/request"
+)
+
+// The event trackers response is an array of objects and specifies the types of
+// events the bidder wishes to track and the URLs/information to track them.
+type EventTracker struct {
+	Event      request.EventTypeID           `json:"event"`                // Type of event to track
+	Method     request.EventTrackingMethodID `json:"method"`               // Type of tracking requested
+	URL        string                        `json:"url,omitempty"`        // The URL of the image or js
+	CustomData openrtb.Extension             `json:"customdata,omitempty"` // To be agreed individually with the exchange, an array of key:value objects for custom tracking
+	Ext        openrtb.Extension             `json:"ext,omitempty"`
+}
+
+func (et *EventTracker) Reset() {
+	et.Event = 0
+	et.Method = 0
+	et.URL = ""
+	if et.CustomData != nil {
+		et.CustomData = et.CustomData[:0]
+	}
+	if et.Ext != nil {
+		et.Ext = et.Ext[:0]
+	}
+}
@@ -2,16 +2,21 @@ package response
 
 //go:generate ffjson $GOFILE
 
-import "github.com/bsm/openrtb"
+import (
+	"github.com/bsm/openrtb"
+	"github.com/bsm/openrtb/native/request"
+)
 
 type Image struct {
-	URL    string            `json:"url,omitempty"` // URL of the image asset
-	Width  int               `json:"w,omitempty"`   // Width of the image in pixels
-	Height int               `json:"h,omitempty"`   // Height of the image in pixels
-	Ext    openrtb.Extension `json:"ext,omitempty"`
+	TypeID request.ImageTypeID `json:"type,omitempty"` // Type ID of the image element being submitted, required for assetsurl or dcourl responses (Native 1.2)
+	URL    string              `json:"url,omitempty"`  // URL of the image asset
+	Width  int                 `json:"w,omitempty"`    // Width of the image in pixels
+	Height int                 `json:"h,omitempty"`    // Height of the image in pixels
+	Ext    openrtb.Extension   `json:"ext,omitempty"`
 }
 
 func (i *Image) Reset() {
+	i.TypeID = 0
 	i.URL = ""
 	i.Width = 0
 	i.Height = 0
@@ -8,6 +8,7 @@ package response
 import (
 	"bytes"
 	"fmt"
+	"github.com/bsm/openrtb/native/request"
 	fflib "github.com/pquerna/ffjson/fflib/v1"
 )
 
@@ -33,6 +34,11 @@ func (mj *Image) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = obj
 	_ = err
 	buf.WriteString(`{ `)
+	if mj.TypeID != 0 {
+		buf.WriteString(`"type":`)
+		fflib.FormatBits2(buf, uint64(mj.TypeID), 10, mj.TypeID < 0)
+		buf.WriteByte(',')
+	}
 	if len(mj.URL) != 0 {
 		buf.WriteString(`"url":`)
 		fflib.WriteJsonString(buf, string(mj.URL))
@@ -71,6 +77,8 @@ const (
 	ffj_t_Imagebase = iota
 	ffj_t_Imageno_such_key
 
+	ffj_t_Image_TypeID
+
 	ffj_t_Image_URL
 
 	ffj_t_Image_Width
@@ -80,6 +88,8 @@ const (
 	ffj_t_Image_Ext
 )
 
+var ffj_key_Image_TypeID = []byte("type")
+
 var ffj_key_Image_URL = []byte("url")
 
 var ffj_key_Image_Width = []byte("w")
@@ -163,6 +173,14 @@ mainparse:
 						goto mainparse
 					}
 
+				case 't':
+
+					if bytes.Equal(ffj_key_Image_TypeID, kn) {
+						currentKey = ffj_t_Image_TypeID
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'u':
 
 					if bytes.Equal(ffj_key_Image_URL, kn) {
@@ -205,6 +223,12 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffj_key_Image_TypeID, kn) {
+					currentKey = ffj_t_Image_TypeID
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				currentKey = ffj_t_Imageno_such_key
 				state = fflib.FFParse_want_colon
 				goto mainparse
@@ -222,6 +246,9 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
+				case ffj_t_Image_TypeID:
+					goto handle_TypeID
+
 				case ffj_t_Image_URL:
 					goto handle_URL
 
@@ -248,6 +275,36 @@ mainparse:
 		}
 	}
 
+handle_TypeID:
+
+	/* handler: uj.TypeID type=request.ImageTypeID kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ImageTypeID", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.TypeID = request.ImageTypeID(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_URL:
 
 	/* handler: uj.URL type=string kind=string quoted=false*/
@@ -4,17 +4,22 @@ package response
 
 import (
 	"github.com/bsm/openrtb"
+	"github.com/bsm/openrtb/native/request"
 	"sync"
 )
 
 // The native object is the top level JSON object which identifies a native response
 type Response struct {
-	Ver         openrtb.StringOrNumber `json:"ver,omitempty"`         // Version of the Native Markup
-	Assets      []Asset                `json:"assets"`                // An array of Asset Objects
-	Link        Link                   `json:"link"`                  // Destination Link. This is default link object for the ad
-	ImpTrackers []string               `json:"imptrackers,omitempty"` // Array of impression tracking URLs, expected to return a 1x1 image or 204 response
-	JSTracker   string                 `json:"jstracker,omitempty"`   // Optional JavaScript impression tracker. This is a valid HTML, Javascript is already wrapped in <script> tags. It should be executed at impression time where it can be supported
-	Ext         openrtb.Extension      `json:"ext,omitempty"`
+	Ver           openrtb.StringOrNumber `json:"ver,omitempty"`           // Version of the Native Markup
+	Assets        []Asset                `json:"assets"`                  // An array of Asset Objects
+	AssetsURL     string                 `json:"assetsurl,omitempty"`     // URL of an alternate source for the assets object (Native 1.2)
+	DCOURL        string                 `json:"dcourl,omitempty"`        // URL where a dynamic creative specification may be found for populating this response (Native 1.2)
+	Link          Link                   `json:"link"`                    // Destination Link. This is default link object for the ad
+	ImpTrackers   []string               `json:"imptrackers,omitempty"`   // DEPRECATED Array of impression tracking URLs, expected to return a 1x1 image or 204 response
+	JSTracker     string                 `json:"jstracker,omitempty"`     // DEPRECATED Optional JavaScript impression tracker. This is a valid HTML, Javascript is already wrapped in <script> tags. It should be executed at impression time where it can be supported
+	EventTrackers []EventTracker         `json:"eventtrackers,omitempty"` // Array of tracking objects to run with the ad, in response to the declared supported methods in the request (Native 1.2)
+	Privacy       string                 `json:"privacy,omitempty"`       // If support was indicated in the request, URL of a page informing the user about the buyer's targeting activity (Native 1.2)
+	Ext           openrtb.Extension      `json:"ext,omitempty"`
 }
 
 func (nr *Response) Reset() {
@@ -33,6 +38,31 @@ func (nr *Response) Reset() {
 		nr.Ext = nr.Ext[:0]
 	}
 	nr.Ver = ""
+	nr.AssetsURL = ""
+	nr.DCOURL = ""
+	if nr.EventTrackers != nil {
+		for i := 0; i < len(nr.EventTrackers); i++ {
+			(&nr.EventTrackers[i]).Reset()
+		}
+		nr.EventTrackers = nr.EventTrackers[:0]
+	}
+	nr.Privacy = ""
+}
+
+// EventTrackerURLs returns the URLs of all trackers for the given event and
+// method. Legacy imptrackers and jstracker values are included for impression
+// events.
+func (nr *Response) EventTrackerURLs(event request.EventTypeID, method request.EventTrackingMethodID) []string {
+	var urls []string
+	if event == request.EventTypeImpression && method == request.EventTrackingImage {
+		urls = append(urls, nr.ImpTrackers...)
+	}
+	for _, et := range nr.EventTrackers {
+		if et.Event == event && et.Method == method && et.URL != "" {
+			urls = append(urls, et.URL)
+		}
+	}
+	return urls
 }
 
 var nativeRequestPool = sync.Pool{
@@ -52,3 +82,14 @@ func FreeNativeRequest(nr *Response) {
 	nr.Reset()
 	nativeRequestPool.Put(nr)
 }
+
+// ParseBid decodes the native ad markup of b into a pooled Response.
+// Callers should release it via FreeNativeRequest when done.
+func ParseBid(b *openrtb.Bid) (*Response, error) {
+	nr := NewNativeRequest()
+	if err := b.DecodeNativeMarkup(nr); err != nil {
+		FreeNativeRequest(nr)
+		return nil, err
+	}
+	return nr, nil
+}
@@ -59,7 +59,18 @@ func (mj *Response) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	} else {
 		buf.WriteString(`null`)
 	}
-	buf.WriteString(`,"link":`)
+	buf.WriteByte(',')
+	if len(mj.AssetsURL) != 0 {
+		buf.WriteString(`"assetsurl":`)
+		fflib.WriteJsonString(buf, string(mj.AssetsURL))
+		buf.WriteByte(',')
+	}
+	if len(mj.DCOURL) != 0 {
+		buf.WriteString(`"dcourl":`)
+		fflib.WriteJsonString(buf, string(mj.DCOURL))
+		buf.WriteByte(',')
+	}
+	buf.WriteString(`"link":`)
 
 	{
 
@@ -91,6 +102,35 @@ func (mj *Response) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		fflib.WriteJsonString(buf, string(mj.JSTracker))
 		buf.WriteByte(',')
 	}
+	if len(mj.EventTrackers) != 0 {
+		buf.WriteString(`"eventtrackers":`)
+		if mj.EventTrackers != nil {
+			buf.WriteString(`[`)
+			for i, v := range mj.EventTrackers {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					err = v.MarshalJSONBuf(buf)
+					if err != nil {
+						return err
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(mj.Privacy) != 0 {
+		buf.WriteString(`"privacy":`)
+		fflib.WriteJsonString(buf, string(mj.Privacy))
+		buf.WriteByte(',')
+	}
 	if len(mj.Ext) != 0 {
 		buf.WriteString(`"ext":`)
 
@@ -118,12 +158,20 @@ const (
 
 	ffj_t_Response_Assets
 
+	ffj_t_Response_AssetsURL
+
+	ffj_t_Response_DCOURL
+
 	ffj_t_Response_Link
 
 	ffj_t_Response_ImpTrackers
 
 	ffj_t_Response_JSTracker
 
+	ffj_t_Response_EventTrackers
+
+	ffj_t_Response_Privacy
+
 	ffj_t_Response_Ext
 )
 
@@ -131,12 +179,20 @@ var ffj_key_Response_Ver = []byte("ver")
 
 var ffj_key_Response_Assets = []byte("assets")
 
+var ffj_key_Response_AssetsURL = []byte("assetsurl")
+
+var ffj_key_Response_DCOURL = []byte("dcourl")
+
 var ffj_key_Response_Link = []byte("link")
 
 var ffj_key_Response_ImpTrackers = []byte("imptrackers")
 
 var ffj_key_Response_JSTracker = []byte("jstracker")
 
+var ffj_key_Response_EventTrackers = []byte("eventtrackers")
+
+var ffj_key_Response_Privacy = []byte("privacy")
+
 var ffj_key_Response_Ext = []byte("ext")
 
 func (uj *Response) UnmarshalJSON(input []byte) error {
@@ -204,11 +260,29 @@ mainparse:
 						currentKey = ffj_t_Response_Assets
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Response_AssetsURL, kn) {
+						currentKey = ffj_t_Response_AssetsURL
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'd':
+
+					if bytes.Equal(ffj_key_Response_DCOURL, kn) {
+						currentKey = ffj_t_Response_DCOURL
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'e':
 
-					if bytes.Equal(ffj_key_Response_Ext, kn) {
+					if bytes.Equal(ffj_key_Response_EventTrackers, kn) {
+						currentKey = ffj_t_Response_EventTrackers
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Response_Ext, kn) {
 						currentKey = ffj_t_Response_Ext
 						state = fflib.FFParse_want_colon
 						goto mainparse
@@ -238,6 +312,14 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'p':
+
+					if bytes.Equal(ffj_key_Response_Privacy, kn) {
+						currentKey = ffj_t_Response_Privacy
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'v':
 
 					if bytes.Equal(ffj_key_Response_Ver, kn) {
@@ -254,6 +336,18 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffj_key_Response_Privacy, kn) {
+					currentKey = ffj_t_Response_Privacy
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Response_EventTrackers, kn) {
+					currentKey = ffj_t_Response_EventTrackers
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.EqualFoldRight(ffj_key_Response_JSTracker, kn) {
 					currentKey = ffj_t_Response_JSTracker
 					state = fflib.FFParse_want_colon
@@ -272,6 +366,18 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffj_key_Response_DCOURL, kn) {
+					currentKey = ffj_t_Response_DCOURL
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffj_key_Response_AssetsURL, kn) {
+					currentKey = ffj_t_Response_AssetsURL
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.EqualFoldRight(ffj_key_Response_Assets, kn) {
 					currentKey = ffj_t_Response_Assets
 					state = fflib.FFParse_want_colon
@@ -307,6 +413,12 @@ mainparse:
 				case ffj_t_Response_Assets:
 					goto handle_Assets
 
+				case ffj_t_Response_AssetsURL:
+					goto handle_AssetsURL
+
+				case ffj_t_Response_DCOURL:
+					goto handle_DCOURL
+
 				case ffj_t_Response_Link:
 					goto handle_Link
 
@@ -316,6 +428,12 @@ mainparse:
 				case ffj_t_Response_JSTracker:
 					goto handle_JSTracker
 
+				case ffj_t_Response_EventTrackers:
+					goto handle_EventTrackers
+
+				case ffj_t_Response_Privacy:
+					goto handle_Privacy
+
 				case ffj_t_Response_Ext:
 					goto handle_Ext
 
@@ -428,6 +546,58 @@ handle_Assets:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_AssetsURL:
+
+	/* handler: uj.AssetsURL type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.AssetsURL = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_DCOURL:
+
+	/* handler: uj.DCOURL type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.DCOURL = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_Link:
 
 	/* handler: uj.Link type=response.Link kind=struct quoted=false*/
@@ -549,6 +719,101 @@ handle_JSTracker:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_EventTrackers:
+
+	/* handler: uj.EventTrackers type=[]response.EventTracker kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			uj.EventTrackers = nil
+		} else {
+
+			uj.EventTrackers = []EventTracker{}
+
+			wantVal := true
+
+			for {
+
+				var tmp_uj__EventTrackers EventTracker
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmp_uj__EventTrackers type=response.EventTracker kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						state = fflib.FFParse_after_value
+						goto mainparse
+					}
+
+					err = tmp_uj__EventTrackers.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+					if err != nil {
+						return err
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				uj.EventTrackers = append(uj.EventTrackers, tmp_uj__EventTrackers)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Privacy:
+
+	/* handler: uj.Privacy type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			uj.Privacy = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_Ext:
 
 	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
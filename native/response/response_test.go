@@ -3,8 +3,11 @@ package response
 import (
 	"encoding/json"
 	"io/ioutil"
+	"strings"
 	"testing"
 
+	"github.com/bsm/openrtb"
+	"github.com/bsm/openrtb/native/request"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -31,6 +34,127 @@ var _ = Describe("Response", func() {
 		}))
 	})
 
+	It("should parse bid markup", func() {
+		data, err := ioutil.ReadFile("testdata/response3.json")
+		Expect(err).NotTo(HaveOccurred())
+
+		bid := &openrtb.Bid{AdMarkup: `{"native":` + string(data) + `}`}
+		res, err := ParseBid(bid)
+		Expect(err).NotTo(HaveOccurred())
+		exp := fixture("testdata/response3.json")
+		Expect(res).To(Equal(&exp))
+
+		Expect(bid.EncodeNativeMarkup(res)).To(Succeed())
+		FreeNativeRequest(res)
+
+		res, err = ParseBid(bid)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.AssetsURL).To(Equal("http://cdn.adnetwork.com/assets.json"))
+		Expect(res.EventTrackers).To(HaveLen(3))
+		FreeNativeRequest(res)
+	})
+
+	It("should validate against the request", func() {
+		var req request.Request
+		data, err := ioutil.ReadFile("../request/testdata/request1.json")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(json.Unmarshal(data, &req)).To(Succeed())
+
+		res := fixture("testdata/response1.json")
+		Expect(res.ValidateAgainst(&req).Error()).To(Equal("" +
+			"assets[1].id: openrtb: native asset ID not in request; " +
+			"assets[5].video.vasttag: openrtb: native video VAST tag malformed; " +
+			"assets[6].id: openrtb: native asset ID not in request"))
+
+		res = Response{Assets: []Asset{
+			{ID: 123, Title: &Title{Text: strings.Repeat("ü", 141)}},
+			{ID: 128, Image: &Image{URL: "http://x", Width: 800, Height: 627}},
+			{ID: 126, Data: &Data{Value: "Brand with a name that is too long"}},
+			{ID: 4, Title: &Title{Text: "not a video"}},
+		}}
+		Expect(res.ValidateAgainst(&req).Error()).To(Equal("" +
+			"assets[0].title.text: openrtb: native title text exceeds requested length; " +
+			"assets[1].img.w: openrtb: native image size does not match request; " +
+			"assets[2].data.value: openrtb: native data value exceeds requested length; " +
+			"assets[3].title: openrtb: native asset type does not match request; " +
+			"assets: openrtb: native required asset missing"))
+
+		res = Response{Assets: []Asset{
+			{ID: 123, Title: &Title{Text: "Title"}},
+			{ID: 128, Image: &Image{URL: "http://x", Width: 1200, Height: 627}},
+			{ID: 126, Data: &Data{Value: "Brand"}},
+			{ID: 127, Data: &Data{Value: "Description"}},
+		}}
+		Expect(res.ValidateAgainst(&req)).To(BeNil())
+		Expect((&Response{AssetsURL: "http://assets"}).ValidateAgainst(&req)).To(BeNil())
+	})
+
+	It("should validate VAST against the requested video", func() {
+		req := &request.Request{Assets: []request.Asset{{ID: 1, Video: &request.Video{
+			Mimes:       []string{"video/mp4"},
+			MinDuration: 5,
+			MaxDuration: 30,
+			Protocols:   []openrtb.Protocol{openrtb.VideoProtoVAST3, openrtb.VideoProtoVAST3Wrapper},
+			Linearity:   openrtb.VideoLinearityLinear,
+			Skip:        1,
+			SkipAfter:   5,
+			MinBitrate:  300,
+			MaxBitrate:  1500,
+		}}}}
+		validate := func(vast string) error {
+			res := &Response{Assets: []Asset{{ID: 1, Video: &Video{VASTTag: vast}}}}
+			return res.ValidateAgainst(req)
+		}
+		inline := func(ver, linear string) string {
+			return `<VAST version="` + ver + `"><Ad><InLine><Creatives><Creative>` + linear + `</Creative></Creatives></InLine></Ad></VAST>`
+		}
+
+		Expect(validate(inline("3.0", `<Linear skipoffset="00:00:05"><Duration>00:00:15.000</Duration><MediaFiles>`+
+			`<MediaFile type="video/webm" bitrate="3000"/>`+
+			`<MediaFile type="video/mp4" minBitrate="500" maxBitrate="2500"/>`+
+			`</MediaFiles></Linear>`))).To(BeNil())
+		Expect(validate(`<VAST version="3.0"><Ad><Wrapper><VASTAdTagURI>http://x</VASTAdTagURI></Wrapper></Ad></VAST>`)).To(BeNil())
+
+		Expect(validate("<VAST")).To(MatchError("assets[0].video.vasttag: openrtb: native video VAST tag malformed"))
+		Expect(validate(inline("4.0", `<Linear skipoffset="00:00:02"><Duration>00:01:00</Duration><MediaFiles>`+
+			`<MediaFile type="application/javascript" apiFramework="VPAID" bitrate="100"/>`+
+			`</MediaFiles></Linear>`))).To(MatchError("" +
+			"assets[0].video.vasttag: openrtb: native video VAST version not accepted; " +
+			"assets[0].video.vasttag: openrtb: native video duration not accepted; " +
+			"assets[0].video.vasttag: openrtb: native video skip offset not accepted; " +
+			"assets[0].video.vasttag: openrtb: native video has no media file with an accepted MIME type; " +
+			"assets[0].video.vasttag: openrtb: native video has no media file with an accepted bitrate; " +
+			"assets[0].video.vasttag: openrtb: native video requires an API framework not supported"))
+		Expect(validate(inline("3.0", `<NonLinearAds><NonLinear/></NonLinearAds>`))).To(MatchError(
+			"assets[0].video.vasttag: openrtb: native video linearity not accepted"))
+	})
+
+	It("should parse 1.2 responses", func() {
+		res := fixture("testdata/response3.json")
+		Expect(res.AssetsURL).To(Equal("http://cdn.adnetwork.com/assets.json"))
+		Expect(res.Privacy).To(Equal("http://privacy.adnetwork.com"))
+		Expect(res.EventTrackers).To(HaveLen(3))
+		Expect(res.EventTrackers[1]).To(Equal(EventTracker{
+			Event:      request.EventTypeImpression,
+			Method:     request.EventTrackingJavaScript,
+			URL:        "http://omid.adnetwork.com/verify.js",
+			CustomData: openrtb.Extension(`{ "vendorKey": "v" }`),
+		}))
+		Expect(res.Assets).To(Equal([]Asset{
+			{ID: 1, Title: &Title{Text: "Learn more", Length: 10, Link: &Link{URL: "http://title.link"}}},
+			{ID: 2, Image: &Image{TypeID: request.ImageTypeMain, URL: "http://www.myads.com/large.png", Width: 1200, Height: 627}},
+			{ID: 3, Data: &Data{TypeID: request.DataTypeDesc, Length: 9, Value: "Read this"}},
+		}))
+
+		Expect(res.EventTrackerURLs(request.EventTypeImpression, request.EventTrackingImage)).To(Equal([]string{"http://imptracker.com", "http://imptracker.com/v2"}))
+		Expect(res.EventTrackerURLs(request.EventTypeImpression, request.EventTrackingJavaScript)).To(Equal([]string{"http://omid.adnetwork.com/verify.js"}))
+		Expect(res.EventTrackerURLs(request.EventTypeViewableMRC100, request.EventTrackingImage)).To(BeEmpty())
+
+		res.Reset()
+		Expect(res.EventTrackers).To(BeEmpty())
+		Expect(res.AssetsURL).To(BeEmpty())
+	})
+
 })
 
 func TestSuite(t *testing.T) {
@@ -5,12 +5,18 @@ package response
 import "github.com/bsm/openrtb"
 
 type Title struct {
-	Text string            `json:"text"` // The text associated with the text element
-	Ext  openrtb.Extension `json:"ext,omitempty"`
+	Text   string            `json:"text"`           // The text associated with the text element
+	Length int               `json:"len,omitempty"`  // The length of the title being provided (Native 1.2)
+	Link   *Link             `json:"link,omitempty"` // Link object for the title, overriding the asset and default link
+	Ext    openrtb.Extension `json:"ext,omitempty"`
 }
 
 func (t *Title) Reset() {
 	t.Text = ""
+	t.Length = 0
+	if t.Link != nil {
+		t.Link.Reset()
+	}
 	if t.Ext != nil {
 		t.Ext = t.Ext[:0]
 	}
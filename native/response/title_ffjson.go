@@ -35,6 +35,26 @@ func (mj *Title) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	buf.WriteString(`{ "text":`)
 	fflib.WriteJsonString(buf, string(mj.Text))
 	buf.WriteByte(',')
+	if mj.Length != 0 {
+		buf.WriteString(`"len":`)
+		fflib.FormatBits2(buf, uint64(mj.Length), 10, mj.Length < 0)
+		buf.WriteByte(',')
+	}
+	if mj.Link != nil {
+		if true {
+			buf.WriteString(`"link":`)
+
+			{
+
+				err = mj.Link.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
 	if len(mj.Ext) != 0 {
 		buf.WriteString(`"ext":`)
 
@@ -60,11 +80,19 @@ const (
 
 	ffj_t_Title_Text
 
+	ffj_t_Title_Length
+
+	ffj_t_Title_Link
+
 	ffj_t_Title_Ext
 )
 
 var ffj_key_Title_Text = []byte("text")
 
+var ffj_key_Title_Length = []byte("len")
+
+var ffj_key_Title_Link = []byte("link")
+
 var ffj_key_Title_Ext = []byte("ext")
 
 func (uj *Title) UnmarshalJSON(input []byte) error {
@@ -134,6 +162,19 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'l':
+
+					if bytes.Equal(ffj_key_Title_Length, kn) {
+						currentKey = ffj_t_Title_Length
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffj_key_Title_Link, kn) {
+						currentKey = ffj_t_Title_Link
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 't':
 
 					if bytes.Equal(ffj_key_Title_Text, kn) {
@@ -150,6 +191,18 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.EqualFoldRight(ffj_key_Title_Link, kn) {
+					currentKey = ffj_t_Title_Link
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffj_key_Title_Length, kn) {
+					currentKey = ffj_t_Title_Length
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffj_key_Title_Text, kn) {
 					currentKey = ffj_t_Title_Text
 					state = fflib.FFParse_want_colon
@@ -176,6 +229,12 @@ mainparse:
 				case ffj_t_Title_Text:
 					goto handle_Text
 
+				case ffj_t_Title_Length:
+					goto handle_Length
+
+				case ffj_t_Title_Link:
+					goto handle_Link
+
 				case ffj_t_Title_Ext:
 					goto handle_Ext
 
@@ -219,6 +278,63 @@ handle_Text:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_Length:
+
+	/* handler: uj.Length type=int kind=int quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			uj.Length = int(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Link:
+
+	/* handler: uj.Link type=response.Link kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			uj.Link = nil
+
+			state = fflib.FFParse_after_value
+			goto mainparse
+		}
+
+		if uj.Link == nil {
+			uj.Link = new(Link)
+		}
+
+		err = uj.Link.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+		if err != nil {
+			return err
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_Ext:
 
 	/* handler: uj.Ext type=openrtb.Extension kind=slice quoted=false*/
@@ -0,0 +1,112 @@
+package response
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"github.com/bsm/openrtb"
+	"github.com/bsm/openrtb/native/request"
+)
+
+// Validation errors
+var (
+	ErrInvalidAssetUnknownID       = errors.New("openrtb: native asset ID not in request")
+	ErrInvalidAssetMissingRequired = errors.New("openrtb: native required asset missing")
+	ErrInvalidAssetTypeMismatch    = errors.New("openrtb: native asset type does not match request")
+	ErrInvalidTitleLength          = errors.New("openrtb: native title text exceeds requested length")
+	ErrInvalidDataLength           = errors.New("openrtb: native data value exceeds requested length")
+	ErrInvalidImageSize            = errors.New("openrtb: native image size does not match request")
+)
+
+// ValidateAgainst checks the response against the native request it answers
+// and returns all issues found. Returns nil if the response is valid.
+func (nr *Response) ValidateAgainst(req *request.Request) openrtb.ValidationErrors {
+	v := new(openrtb.Validator)
+	nr.validateAgainst(v, "", req)
+	return v.Issues()
+}
+
+func (nr *Response) validateAgainst(v *openrtb.Validator, path string, req *request.Request) {
+	reqAssets := make(map[int]*request.Asset, len(req.Assets))
+	for i := range req.Assets {
+		reqAssets[req.Assets[i].ID] = &req.Assets[i]
+	}
+
+	present := make(map[int]struct{}, len(nr.Assets))
+	for i := range nr.Assets {
+		asset := &nr.Assets[i]
+		apath := openrtb.PathIndex(openrtb.PathKey(path, "assets"), i)
+		present[asset.ID] = struct{}{}
+
+		if ra, ok := reqAssets[asset.ID]; !ok {
+			v.AddError(openrtb.PathKey(apath, "id"), ErrInvalidAssetUnknownID)
+		} else {
+			asset.validateAgainst(v, apath, ra)
+		}
+	}
+
+	// assets may be delivered separately, via assetsurl or dcourl (Native 1.2)
+	if nr.AssetsURL != "" || nr.DCOURL != "" {
+		return
+	}
+	for i := range req.Assets {
+		if ra := &req.Assets[i]; ra.Required == 1 {
+			if _, ok := present[ra.ID]; !ok {
+				v.AddError(openrtb.PathKey(path, "assets"), ErrInvalidAssetMissingRequired)
+			}
+		}
+	}
+}
+
+func (a *Asset) validateAgainst(v *openrtb.Validator, path string, req *request.Asset) {
+	switch {
+	case a.Title != nil:
+		if req.Title == nil {
+			v.AddError(openrtb.PathKey(path, "title"), ErrInvalidAssetTypeMismatch)
+		} else if req.Title.Length > 0 && utf8.RuneCountInString(a.Title.Text) > req.Title.Length {
+			v.AddError(openrtb.PathKey(openrtb.PathKey(path, "title"), "text"), ErrInvalidTitleLength)
+		}
+	case a.Image != nil:
+		if req.Image == nil {
+			v.AddError(openrtb.PathKey(path, "img"), ErrInvalidAssetTypeMismatch)
+		} else {
+			a.Image.validateAgainst(v, openrtb.PathKey(path, "img"), req.Image)
+		}
+	case a.Video != nil:
+		if req.Video == nil {
+			v.AddError(openrtb.PathKey(path, "video"), ErrInvalidAssetTypeMismatch)
+		} else {
+			a.Video.validateAgainst(v, openrtb.PathKey(path, "video"), req.Video)
+		}
+	case a.Data != nil:
+		if req.Data == nil {
+			v.AddError(openrtb.PathKey(path, "data"), ErrInvalidAssetTypeMismatch)
+		} else if req.Data.Length > 0 && utf8.RuneCountInString(a.Data.Value) > req.Data.Length {
+			v.AddError(openrtb.PathKey(openrtb.PathKey(path, "data"), "value"), ErrInvalidDataLength)
+		}
+	}
+}
+
+func (i *Image) validateAgainst(v *openrtb.Validator, path string, req *request.Image) {
+	if !sizeAccepted(i.Width, req.Width, req.WidthMin) {
+		v.AddError(openrtb.PathKey(path, "w"), ErrInvalidImageSize)
+	}
+	if !sizeAccepted(i.Height, req.Height, req.HeightMin) {
+		v.AddError(openrtb.PathKey(path, "h"), ErrInvalidImageSize)
+	}
+}
+
+// sizeAccepted reports whether a response dimension satisfies the requested
+// minimum or, if no minimum is given, the exact dimension. Unknown (zero)
+// dimensions are accepted.
+func sizeAccepted(n, exact, min int) bool {
+	switch {
+	case n == 0:
+		return true
+	case min > 0:
+		return n >= min
+	case exact > 0:
+		return n == exact
+	}
+	return true
+}
@@ -0,0 +1,263 @@
+package response
+
+import (
+	"encoding/xml"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/bsm/openrtb"
+	"github.com/bsm/openrtb/native/request"
+)
+
+// VAST validation errors
+var (
+	ErrInvalidVASTMalformed    = errors.New("openrtb: native video VAST tag malformed")
+	ErrInvalidVASTProtocol     = errors.New("openrtb: native video VAST version not accepted")
+	ErrInvalidVASTDuration     = errors.New("openrtb: native video duration not accepted")
+	ErrInvalidVASTMimeType     = errors.New("openrtb: native video has no media file with an accepted MIME type")
+	ErrInvalidVASTBitrate      = errors.New("openrtb: native video has no media file with an accepted bitrate")
+	ErrInvalidVASTAPIFramework = errors.New("openrtb: native video requires an API framework not supported")
+	ErrInvalidVASTLinearity    = errors.New("openrtb: native video linearity not accepted")
+	ErrInvalidVASTSkip         = errors.New("openrtb: native video skip offset not accepted")
+)
+
+// vast is the subset of a VAST document required for validation.
+type vast struct {
+	Version string   `xml:"version,attr"`
+	Ads     []vastAd `xml:"Ad"`
+}
+
+type vastAd struct {
+	InLine  *vastCreatives `xml:"InLine"`
+	Wrapper *vastCreatives `xml:"Wrapper"`
+}
+
+type vastCreatives struct {
+	Creatives []vastCreative `xml:"Creatives>Creative"`
+}
+
+type vastCreative struct {
+	Linear       *vastLinear `xml:"Linear"`
+	NonLinearAds *struct{}   `xml:"NonLinearAds"`
+}
+
+type vastLinear struct {
+	SkipOffset string          `xml:"skipoffset,attr"`
+	Duration   string          `xml:"Duration"`
+	MediaFiles []vastMediaFile `xml:"MediaFiles>MediaFile"`
+}
+
+type vastMediaFile struct {
+	Type         string `xml:"type,attr"`
+	Bitrate      int    `xml:"bitrate,attr"`
+	MinBitrate   int    `xml:"minBitrate,attr"`
+	MaxBitrate   int    `xml:"maxBitrate,attr"`
+	APIFramework string `xml:"apiFramework,attr"`
+}
+
+// protocol returns the protocol of the document
+func (d *vast) protocol() openrtb.Protocol {
+	wrapper := false
+	for _, ad := range d.Ads {
+		if ad.Wrapper != nil {
+			wrapper = true
+		}
+	}
+
+	var p openrtb.Protocol
+	switch ver := strings.TrimSpace(d.Version); {
+	case strings.HasPrefix(ver, "4.2"):
+		p = openrtb.VideoProtoVAST42
+	case strings.HasPrefix(ver, "4.1"):
+		p = openrtb.VideoProtoVAST41
+	case strings.HasPrefix(ver, "4"):
+		p = openrtb.VideoProtoVAST4
+	case strings.HasPrefix(ver, "3"):
+		p = openrtb.VideoProtoVAST3
+	case strings.HasPrefix(ver, "2"):
+		p = openrtb.VideoProtoVAST2
+	case strings.HasPrefix(ver, "1"):
+		p = openrtb.VideoProtoVAST1
+	default:
+		return 0
+	}
+	if !wrapper {
+		return p
+	}
+
+	switch p {
+	case openrtb.VideoProtoVAST1:
+		return openrtb.VideoProtoVAST1Wrapper
+	case openrtb.VideoProtoVAST2:
+		return openrtb.VideoProtoVAST2Wrapper
+	case openrtb.VideoProtoVAST3:
+		return openrtb.VideoProtoVAST3Wrapper
+	case openrtb.VideoProtoVAST4:
+		return openrtb.VideoProtoVAST4Wrapper
+	case openrtb.VideoProtoVAST41:
+		return openrtb.VideoProtoVAST41Wrapper
+	}
+	return openrtb.VideoProtoVAST42Wrapper
+}
+
+func (v *Video) validateAgainst(vv *openrtb.Validator, path string, req *request.Video) {
+	var doc vast
+	if err := xml.Unmarshal([]byte(v.VASTTag), &doc); err != nil || len(doc.Ads) == 0 {
+		vv.AddError(openrtb.PathKey(path, "vasttag"), ErrInvalidVASTMalformed)
+		return
+	}
+
+	path = openrtb.PathKey(path, "vasttag")
+	if len(req.Protocols) != 0 && !containsProtocol(req.Protocols, doc.protocol()) {
+		vv.AddError(path, ErrInvalidVASTProtocol)
+	}
+
+	var linear, nonLinear bool
+	var files []vastMediaFile
+	for _, ad := range doc.Ads {
+		if ad.Wrapper != nil {
+			// wrappers delegate the creative to another document
+			linear, nonLinear = true, true
+			continue
+		}
+		if ad.InLine == nil {
+			continue
+		}
+		for _, c := range ad.InLine.Creatives {
+			if c.NonLinearAds != nil {
+				nonLinear = true
+			}
+			if c.Linear == nil {
+				continue
+			}
+			linear = true
+			files = append(files, c.Linear.MediaFiles...)
+
+			if dur, ok := parseVASTTime(c.Linear.Duration); ok {
+				if (req.MinDuration > 0 && dur < req.MinDuration) || (req.MaxDuration > 0 && dur > req.MaxDuration) {
+					vv.AddError(path, ErrInvalidVASTDuration)
+				}
+			}
+			if offset, ok := parseVASTTime(c.Linear.SkipOffset); ok && req.Skip == 1 && offset < req.SkipAfter {
+				vv.AddError(path, ErrInvalidVASTSkip)
+			}
+		}
+	}
+
+	switch req.Linearity {
+	case openrtb.VideoLinearityLinear:
+		if !linear {
+			vv.AddError(path, ErrInvalidVASTLinearity)
+		}
+	case openrtb.VideoLinearityNonLinear:
+		if !nonLinear {
+			vv.AddError(path, ErrInvalidVASTLinearity)
+		}
+	}
+
+	if len(files) == 0 {
+		return
+	}
+	if len(req.Mimes) != 0 && !anyMediaFile(files, func(f *vastMediaFile) bool { return containsString(req.Mimes, f.Type) }) {
+		vv.AddError(path, ErrInvalidVASTMimeType)
+	}
+	if (req.MinBitrate > 0 || req.MaxBitrate > 0) && !anyMediaFile(files, func(f *vastMediaFile) bool { return f.bitrateAccepted(req.MinBitrate, req.MaxBitrate) }) {
+		vv.AddError(path, ErrInvalidVASTBitrate)
+	}
+	if !anyMediaFile(files, func(f *vastMediaFile) bool { return f.apiAccepted(req.API) }) {
+		vv.AddError(path, ErrInvalidVASTAPIFramework)
+	}
+}
+
+// bitrateAccepted reports whether the media file can be delivered within the
+// bitrate bounds. Files without bitrate information are accepted.
+func (f *vastMediaFile) bitrateAccepted(min, max int) bool {
+	lo, hi := f.MinBitrate, f.MaxBitrate
+	if f.Bitrate != 0 {
+		lo, hi = f.Bitrate, f.Bitrate
+	}
+	if lo == 0 && hi == 0 {
+		return true
+	}
+	if hi == 0 {
+		hi = lo
+	}
+	if lo == 0 {
+		lo = hi
+	}
+	return (min == 0 || hi >= min) && (max == 0 || lo <= max)
+}
+
+// apiAccepted reports whether the API framework required by the media file
+// is supported.
+func (f *vastMediaFile) apiAccepted(apis []openrtb.APIFramework) bool {
+	switch strings.ToUpper(strings.TrimSpace(f.APIFramework)) {
+	case "":
+		return true
+	case "VPAID":
+		return containsAPIFramework(apis, openrtb.APIFrameworkVPAID1) || containsAPIFramework(apis, openrtb.APIFrameworkVPAID2)
+	case "SIMID":
+		return containsAPIFramework(apis, openrtb.APIFrameworkSIMID1) || containsAPIFramework(apis, openrtb.APIFrameworkSIMID1_1)
+	case "OMID":
+		return containsAPIFramework(apis, openrtb.APIFrameworkOMID1)
+	}
+	return false
+}
+
+func anyMediaFile(files []vastMediaFile, fn func(*vastMediaFile) bool) bool {
+	for i := range files {
+		if fn(&files[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseVASTTime parses a HH:MM:SS[.mmm] time into whole seconds
+func parseVASTTime(s string) (int, bool) {
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) != 3 {
+		return 0, false
+	}
+	if n := strings.IndexByte(parts[2], '.'); n > -1 {
+		parts[2] = parts[2][:n]
+	}
+
+	secs := 0
+	for _, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return 0, false
+		}
+		secs = secs*60 + n
+	}
+	return secs, true
+}
+
+func containsString(list []string, s string) bool {
+	for _, x := range list {
+		if strings.EqualFold(x, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsProtocol(list []openrtb.Protocol, p openrtb.Protocol) bool {
+	for _, x := range list {
+		if x == p {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAPIFramework(list []openrtb.APIFramework, n openrtb.APIFramework) bool {
+	for _, x := range list {
+		if x == n {
+			return true
+		}
+	}
+	return false
+}
@@ -20,4 +20,36 @@ var _ = Describe("Native", func() {
 		}))
 	})
 
+	It("should validate", func() {
+		Expect(subject.Validate()).NotTo(HaveOccurred())
+		Expect((&Native{}).Validate()).To(Equal(ErrInvalidNativeNoRequest))
+		Expect((&Native{Request: Extension(`""`)}).Validate()).To(Equal(ErrInvalidNativeNoRequest))
+	})
+
+	It("should decode requests", func() {
+		type nativeRequest struct {
+			Ver    string `json:"ver"`
+			Layout int    `json:"layout"`
+		}
+		for _, raw := range []string{
+			`"{\"ver\":\"1.1\",\"layout\":3}"`,
+			`{"ver":"1.1","layout":3}`,
+			`{"native":{"ver":"1.1","layout":3}}`,
+			`"{\"native\":{\"ver\":\"1.1\",\"layout\":3}}"`,
+		} {
+			var nreq nativeRequest
+			Expect((&Native{Request: Extension(raw)}).DecodeRequest(&nreq)).To(Succeed(), raw)
+			Expect(nreq).To(Equal(nativeRequest{Ver: "1.1", Layout: 3}), raw)
+		}
+
+		var nreq nativeRequest
+		Expect((&Native{}).DecodeRequest(&nreq)).To(Equal(ErrInvalidNativeNoRequest))
+		Expect((&Native{Request: Extension(`"{bad"`)}).DecodeRequest(&nreq)).To(HaveOccurred())
+	})
+
+	It("should encode requests", func() {
+		Expect(subject.EncodeRequest(map[string]string{"ver": "1.1"})).To(Succeed())
+		Expect(string(subject.Request)).To(Equal(`"{\"ver\":\"1.1\"}"`))
+	})
+
 })
@@ -0,0 +1,46 @@
+package openrtb
+
+// ChangeKind classifies a modification applied by Normalize or ConvertTo.
+type ChangeKind int
+
+const (
+	ChangeDefault ChangeKind = iota // A missing field was set to its specification default
+	ChangeMigrate                   // A field was migrated to its replacement
+	ChangeDrop                      // A field was removed because the target version cannot carry it
+)
+
+// String returns the name of the change kind
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeDefault:
+		return "default"
+	case ChangeMigrate:
+		return "migrate"
+	case ChangeDrop:
+		return "drop"
+	}
+	return ""
+}
+
+// Change describes a single modification applied by Normalize or ConvertTo.
+type Change struct {
+	Path string     // JSON path of the field that was set, e.g. "imp[0].bidfloorcur"
+	Kind ChangeKind // Kind of the change
+}
+
+// normalizer collects changes while walking an object tree.
+type normalizer struct {
+	changes []Change
+}
+
+func (n *normalizer) setDefault(path string) {
+	n.changes = append(n.changes, Change{Path: path, Kind: ChangeDefault})
+}
+
+func (n *normalizer) migrate(path string) {
+	n.changes = append(n.changes, Change{Path: path, Kind: ChangeMigrate})
+}
+
+func (n *normalizer) drop(path string) {
+	n.changes = append(n.changes, Change{Path: path, Kind: ChangeDrop})
+}
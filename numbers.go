@@ -1,6 +1,6 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
 
 import (
 	"encoding/json"
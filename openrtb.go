@@ -1,100 +1,154 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
+//go:generate go run gen_clone.go
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	fflib "github.com/pquerna/ffjson/fflib/v1"
+)
+
+// Validation errors
+var (
+	ErrInvalidGeoLat        = errors.New("openrtb: geo latitude out of range")
+	ErrInvalidGeoLon        = errors.New("openrtb: geo longitude out of range")
+	ErrInvalidGeoCountry    = errors.New("openrtb: geo country is not an ISO-3166-1 alpha-3 code")
+	ErrInvalidUserYOB       = errors.New("openrtb: user year of birth implausible")
+	ErrInvalidUserGender    = errors.New("openrtb: user gender invalid")
+	ErrInvalidUserEIDSource = errors.New("openrtb: user EID source missing")
+	ErrInvalidUserEIDUID    = errors.New("openrtb: user EID has no UIDs")
+	ErrInvalidRegsGDPR      = errors.New("openrtb: regs GDPR flag invalid")
+	ErrInvalidRegsUSPrivacy = errors.New("openrtb: regs US privacy string invalid")
+	ErrInvalidRegsGPPSID    = errors.New("openrtb: regs GPP section IDs missing")
+)
 
 // 5.2 Banner Ad Types
+type BannerType int
+
 const (
-	BannerTypeXHTMLText = 1
-	BannerTypeXHTML     = 2
-	BannerTypeJS        = 3
-	BannerTypeFrame     = 4
+	BannerTypeXHTMLText BannerType = 1
+	BannerTypeXHTML     BannerType = 2
+	BannerTypeJS        BannerType = 3
+	BannerTypeFrame     BannerType = 4
 )
 
 // 5.3 Creative Attributes
+type CreativeAttribute int
+
 const (
-	CreativeAttributeAudioAdAutoPlay                 = 1
-	CreativeAttributeAudioAdUserInitiated            = 2
-	CreativeAttributeExpandableAuto                  = 3
-	CreativeAttributeExpandableUserInitiatedClick    = 4
-	CreativeAttributeExpandableUserInitiatedRollover = 5
-	CreativeAttributeInBannerVideoAdAutoPlay         = 6
-	CreativeAttributeInBannerVideoAdUserInitiated    = 7
-	CreativeAttributePop                             = 8
-	CreativeAttributeProvocativeOrSuggestiveImagery  = 9
-	CreativeAttributeExtremeAnimation                = 10
-	CreativeAttributeSurveys                         = 11
-	CreativeAttributeTextOnly                        = 12
-	CreativeAttributeUserInitiated                   = 13
-	CreativeAttributeWindowsDialogOrAlert            = 14
-	CreativeAttributeHasAudioWithPlayer              = 15
-	CreativeAttributeAdProvidesSkipButton            = 16
-	CreativeAttributeAdobeFlash                      = 17
+	CreativeAttributeAudioAdAutoPlay                 CreativeAttribute = 1
+	CreativeAttributeAudioAdUserInitiated            CreativeAttribute = 2
+	CreativeAttributeExpandableAuto                  CreativeAttribute = 3
+	CreativeAttributeExpandableUserInitiatedClick    CreativeAttribute = 4
+	CreativeAttributeExpandableUserInitiatedRollover CreativeAttribute = 5
+	CreativeAttributeInBannerVideoAdAutoPlay         CreativeAttribute = 6
+	CreativeAttributeInBannerVideoAdUserInitiated    CreativeAttribute = 7
+	CreativeAttributePop                             CreativeAttribute = 8
+	CreativeAttributeProvocativeOrSuggestiveImagery  CreativeAttribute = 9
+	CreativeAttributeExtremeAnimation                CreativeAttribute = 10
+	CreativeAttributeSurveys                         CreativeAttribute = 11
+	CreativeAttributeTextOnly                        CreativeAttribute = 12
+	CreativeAttributeUserInitiated                   CreativeAttribute = 13
+	CreativeAttributeWindowsDialogOrAlert            CreativeAttribute = 14
+	CreativeAttributeHasAudioWithPlayer              CreativeAttribute = 15
+	CreativeAttributeAdProvidesSkipButton            CreativeAttribute = 16
+	CreativeAttributeAdobeFlash                      CreativeAttribute = 17
 )
 
 // 5.4 Ad Position
+type AdPosition int
+
 const (
-	AdPosUnknown    = 0
-	AdPosAboveFold  = 1
-	AdPosBelowFold  = 3
-	AdPosHeader     = 4
-	AdPosFooter     = 5
-	AdPosSidebar    = 6
-	AdPosFullscreen = 7
+	AdPosUnknown      AdPosition = 0
+	AdPosAboveFold    AdPosition = 1
+	AdPosMaybeVisible AdPosition = 2 // DEPRECATED: may or may not be initially visible
+	AdPosBelowFold    AdPosition = 3
+	AdPosHeader       AdPosition = 4
+	AdPosFooter       AdPosition = 5
+	AdPosSidebar      AdPosition = 6
+	AdPosFullscreen   AdPosition = 7
 )
 
 // 5.5 Expandable Direction
+type ExpandableDirection int
+
 const (
-	ExpDirLeft       = 1
-	ExpDirRight      = 2
-	ExpDirUp         = 3
-	ExpDirDown       = 4
-	ExpDirFullScreen = 5
+	ExpDirLeft           ExpandableDirection = 1
+	ExpDirRight          ExpandableDirection = 2
+	ExpDirUp             ExpandableDirection = 3
+	ExpDirDown           ExpandableDirection = 4
+	ExpDirFullScreen     ExpandableDirection = 5
+	ExpDirResizeMinimize ExpandableDirection = 6 // Resize/minimize, i.e. make smaller (Spec 2.6)
 )
 
 // 5.6 API Frameworks
+type APIFramework int
+
 const (
-	APIFrameworkVPAID1 = 1
-	APIFrameworkVPAID2 = 2
-	APIFrameworkMRAID1 = 3
-	APIFrameworkORMMA  = 4
-	APIFrameworkMRAID2 = 5
+	APIFrameworkVPAID1   APIFramework = 1
+	APIFrameworkVPAID2   APIFramework = 2
+	APIFrameworkMRAID1   APIFramework = 3
+	APIFrameworkORMMA    APIFramework = 4
+	APIFrameworkMRAID2   APIFramework = 5
+	APIFrameworkMRAID3   APIFramework = 6 // (Spec 2.5)
+	APIFrameworkOMID1    APIFramework = 7 // (Spec 2.5)
+	APIFrameworkSIMID1   APIFramework = 8 // (Spec 2.6)
+	APIFrameworkSIMID1_1 APIFramework = 9 // (Spec 2.6)
 )
 
 // 5.7 Video Linearity
+type VideoLinearity int
+
 const (
-	VideoLinearityLinear    = 1
-	VideoLinearityNonLinear = 2
+	VideoLinearityLinear    VideoLinearity = 1
+	VideoLinearityNonLinear VideoLinearity = 2
 )
 
 // 5.8 Video and Audio Bid Response Protocols
+type Protocol int
+
 const (
-	VideoProtoVAST1            = 1
-	VideoProtoVAST2            = 2
-	VideoProtoVAST3            = 3
-	VideoProtoVAST1Wrapper     = 4
-	VideoProtoVAST2Wrapper     = 5
-	VideoProtoVAST3Wrapper     = 6
-	VideoProtoVAST4            = 7
-	VideoProtoVAST4Wrapper     = 8
-	AudioProtocolDAAST1        = 9
-	AudioProtocolDAAST1Wrapper = 10
+	VideoProtoVAST1            Protocol = 1
+	VideoProtoVAST2            Protocol = 2
+	VideoProtoVAST3            Protocol = 3
+	VideoProtoVAST1Wrapper     Protocol = 4
+	VideoProtoVAST2Wrapper     Protocol = 5
+	VideoProtoVAST3Wrapper     Protocol = 6
+	VideoProtoVAST4            Protocol = 7
+	VideoProtoVAST4Wrapper     Protocol = 8
+	AudioProtocolDAAST1        Protocol = 9
+	AudioProtocolDAAST1Wrapper Protocol = 10
+	VideoProtoVAST41           Protocol = 11 // (Spec 2.6)
+	VideoProtoVAST41Wrapper    Protocol = 12 // (Spec 2.6)
+	VideoProtoVAST42           Protocol = 13 // (Spec 2.6)
+	VideoProtoVAST42Wrapper    Protocol = 14 // (Spec 2.6)
 )
 
 // 5.9 Video Playback Methods
+type PlaybackMethod int
+
 const (
-	VideoPlaybackAutoSoundOn  = 1
-	VideoPlaybackAutoSoundOff = 2
-	VideoPlaybackClickToPlay  = 3
-	VideoPlaybackMouseOver    = 4
+	VideoPlaybackAutoSoundOn      PlaybackMethod = 1
+	VideoPlaybackAutoSoundOff     PlaybackMethod = 2
+	VideoPlaybackClickToPlay      PlaybackMethod = 3
+	VideoPlaybackMouseOver        PlaybackMethod = 4
+	VideoPlaybackViewportSoundOn  PlaybackMethod = 5 // Initiates on entering viewport with sound on (Spec 2.5)
+	VideoPlaybackViewportSoundOff PlaybackMethod = 6 // Initiates on entering viewport with sound off by default (Spec 2.5)
 )
 
 // 5.9 Video Placement Types (Spec 2.5)
+type VideoPlacement int
+
 const (
-	VideoPlacementInStream     = 1
-	VideoPlacementInBanner     = 2
-	VideoPlacementInArticle    = 3
-	VideoPlacementInFeed       = 4
-	VideoPlacementInterstitial = 5
+	VideoPlacementInStream     VideoPlacement = 1
+	VideoPlacementInBanner     VideoPlacement = 2
+	VideoPlacementInArticle    VideoPlacement = 3
+	VideoPlacementInFeed       VideoPlacement = 4
+	VideoPlacementInterstitial VideoPlacement = 5
 )
 
 // 5.10 Video Start Delay
@@ -105,86 +159,276 @@ const (
 )
 
 // 5.11 Video Quality
+type ProductionQuality int
+
 const (
-	VideoQualityUnknown      = 0
-	VideoQualityProfessional = 1
-	VideoQualityProsumer     = 2
-	VideoQualityUGC          = 3
+	VideoQualityUnknown      ProductionQuality = 0
+	VideoQualityProfessional ProductionQuality = 1
+	VideoQualityProsumer     ProductionQuality = 2
+	VideoQualityUGC          ProductionQuality = 3
 )
 
 // 5.12 VAST Companion Types
+type CompanionType int
+
 const (
-	VASTCompanionStatic = 1
-	VASTCompanionHTML   = 2
-	VASTCompanionIFrame = 3
+	VASTCompanionStatic CompanionType = 1
+	VASTCompanionHTML   CompanionType = 2
+	VASTCompanionIFrame CompanionType = 3
 )
 
 // 5.13 Content Delivery Methods
+type ContentDelivery int
+
 const (
-	ContentDeliveryStreaming   = 1
-	ContentDeliveryProgressive = 2
-	ContentDeliveryDownload    = 3
+	ContentDeliveryStreaming   ContentDelivery = 1
+	ContentDeliveryProgressive ContentDelivery = 2
+	ContentDeliveryDownload    ContentDelivery = 3
 )
 
 // 5.14 Content Context
+type ContentContext int
+
 const (
-	ContextVideo       = 1
-	ContextGame        = 2
-	ContextMusic       = 3
-	ContextApplication = 4
-	ContextText        = 5
-	ContextOther       = 6
-	ContextUnknown     = 7
+	ContextVideo       ContentContext = 1
+	ContextGame        ContentContext = 2
+	ContextMusic       ContentContext = 3
+	ContextApplication ContentContext = 4
+	ContextText        ContentContext = 5
+	ContextOther       ContentContext = 6
+	ContextUnknown     ContentContext = 7
 )
 
 // 5.15 QAG Media Ratings
+type QAGMediaRating int
+
 const (
-	QAGAll    = 1
-	QAGOver12 = 2
-	QAGMature = 3
+	QAGAll    QAGMediaRating = 1
+	QAGOver12 QAGMediaRating = 2
+	QAGMature QAGMediaRating = 3
 )
 
 // 5.16 Location Type
+type LocationType int
+
 const (
-	LocationTypeGPS  = 1
-	LocationTypeIP   = 2
-	LocationTypeUser = 3
+	LocationTypeGPS  LocationType = 1
+	LocationTypeIP   LocationType = 2
+	LocationTypeUser LocationType = 3
+)
+
+// IP Location Services (Spec 2.4)
+type LocationService int
+
+const (
+	LocationServiceIP2Location LocationService = 1
+	LocationServiceNeustar     LocationService = 2
+	LocationServiceMaxMind     LocationService = 3
+	LocationServiceNetAcuity   LocationService = 4
 )
 
 // 5.17 Device Type
+type DeviceType int
+
 const (
-	DeviceTypeUnknown   = 0
-	DeviceTypeMobile    = 1
-	DeviceTypePC        = 2
-	DeviceTypeTV        = 3
-	DeviceTypePhone     = 4
-	DeviceTypeTablet    = 5
-	DeviceTypeConnected = 6
-	DeviceTypeSetTopBox = 7
+	DeviceTypeUnknown   DeviceType = 0
+	DeviceTypeMobile    DeviceType = 1
+	DeviceTypePC        DeviceType = 2
+	DeviceTypeTV        DeviceType = 3
+	DeviceTypePhone     DeviceType = 4
+	DeviceTypeTablet    DeviceType = 5
+	DeviceTypeConnected DeviceType = 6
+	DeviceTypeSetTopBox DeviceType = 7
+	DeviceTypeOOH       DeviceType = 8 // Out-of-home device (Spec 2.6)
 )
 
 // 5.18 Connection Type
+type ConnectionType int
+
 const (
-	ConnTypeUnknown  = 0
-	ConnTypeEthernet = 1
-	ConnTypeWIFI     = 2
-	ConnTypeCell     = 3
-	ConnTypeCell2G   = 4
-	ConnTypeCell3G   = 5
-	ConnTypeCell4G   = 6
+	ConnTypeUnknown  ConnectionType = 0
+	ConnTypeEthernet ConnectionType = 1
+	ConnTypeWIFI     ConnectionType = 2
+	ConnTypeCell     ConnectionType = 3
+	ConnTypeCell2G   ConnectionType = 4
+	ConnTypeCell3G   ConnectionType = 5
+	ConnTypeCell4G   ConnectionType = 6
+	ConnTypeCell5G   ConnectionType = 7 // (Spec 2.6)
 )
 
 // 5.19 No-Bid Reason Codes
+type NoBidReason int
+
+const (
+	NBRUnknownError       NoBidReason = 0
+	NBRTechnicalError     NoBidReason = 1
+	NBRInvalidRequest     NoBidReason = 2
+	NBRKnownSpider        NoBidReason = 3
+	NBRSuspectedNonHuman  NoBidReason = 4
+	NBRProxyIP            NoBidReason = 5
+	NBRUnsupportedDevice  NoBidReason = 6
+	NBRBlockedSite        NoBidReason = 7
+	NBRUnmatchedUser      NoBidReason = 8
+	NBRDailyUserCapMet    NoBidReason = 9  // (Spec 2.6)
+	NBRDailyDomainCapMet  NoBidReason = 10 // (Spec 2.6)
+	NBRAdsTxtUnavailable  NoBidReason = 11 // Ads.txt authorization unavailable (Spec 2.6)
+	NBRAdsTxtViolation    NoBidReason = 12 // Ads.txt authorization violation (Spec 2.6)
+	NBRAdsCertUnavailable NoBidReason = 13 // Ads.cert authentication unavailable (Spec 2.6)
+	NBRAdsCertViolation   NoBidReason = 14 // Ads.cert authentication violation (Spec 2.6)
+	NBRInsufficientTime   NoBidReason = 15 // Insufficient auction time (Spec 2.6)
+	NBRIncompleteSChain   NoBidReason = 16 // Incomplete supply chain (Spec 2.6)
+	NBRBlockedSChainNode  NoBidReason = 17 // Blocked supply chain node (Spec 2.6)
+)
+
+// Loss Reason Codes (Spec 2.5)
+type LossReason int
+
+const (
+	LossBidWon               LossReason = 0
+	LossInternalError        LossReason = 1
+	LossExpired              LossReason = 2 // Impression opportunity expired
+	LossInvalidResponse      LossReason = 3
+	LossInvalidDealID        LossReason = 4
+	LossInvalidAuctionID     LossReason = 5
+	LossInvalidAdvDomain     LossReason = 6 // Invalid (i.e., malformed) advertiser domain
+	LossMissingMarkup        LossReason = 7
+	LossMissingCreativeID    LossReason = 8
+	LossMissingPrice         LossReason = 9
+	LossMissingApprovalData  LossReason = 10 // Missing minimum creative approval data
+	LossBelowAuctionFloor    LossReason = 100
+	LossBelowDealFloor       LossReason = 101
+	LossLostToHigherBid      LossReason = 102
+	LossLostToDeal           LossReason = 103 // Lost to a bid for a PMP deal
+	LossSeatBlocked          LossReason = 104 // Buyer seat blocked
+	LossCreativeFiltered     LossReason = 200 // Creative filtered, general reason unknown
+	LossCreativePending      LossReason = 201 // Creative filtered, pending processing by exchange
+	LossCreativeDisapproved  LossReason = 202
+	LossCreativeSize         LossReason = 203 // Creative filtered, size not allowed
+	LossCreativeFormat       LossReason = 204 // Creative filtered, incorrect creative format
+	LossCreativeAdvExcluded  LossReason = 205 // Creative filtered, advertiser exclusions
+	LossCreativeAppExcluded  LossReason = 206 // Creative filtered, app bundle ID exclusions
+	LossCreativeNotSecure    LossReason = 207
+	LossCreativeLangExcluded LossReason = 208 // Creative filtered, language exclusions
+	LossCreativeCatExcluded  LossReason = 209 // Creative filtered, category exclusions
+	LossCreativeAttrExcluded LossReason = 210 // Creative filtered, creative attribute exclusions
+	LossCreativeTypeExcluded LossReason = 211 // Creative filtered, ad type exclusions
+	LossCreativeAnimation    LossReason = 212 // Creative filtered, animation too long
+	LossCreativeNotInDeal    LossReason = 213 // Creative filtered, not allowed in PMP deal
+)
+
+// Category Taxonomies (Spec 2.6)
+type CategoryTaxonomy int
+
+const (
+	CatTaxIABContent1_0  CategoryTaxonomy = 1
+	CatTaxIABContent2_0  CategoryTaxonomy = 2
+	CatTaxIABProduct1_0  CategoryTaxonomy = 3
+	CatTaxIABAudience1_1 CategoryTaxonomy = 4
+	CatTaxIABContent2_1  CategoryTaxonomy = 5
+	CatTaxIABContent2_2  CategoryTaxonomy = 6
+	CatTaxIABContent3_0  CategoryTaxonomy = 7
+	CatTaxIABProduct2_0  CategoryTaxonomy = 8
+)
+
+// Video Placement Subtypes (Spec 2.6)
+type VideoPlcmt int
+
 const (
-	NBRUnknownError      = 0
-	NBRTechnicalError    = 1
-	NBRInvalidRequest    = 2
-	NBRKnownSpider       = 3
-	NBRSuspectedNonHuman = 4
-	NBRProxyIP           = 5
-	NBRUnsupportedDevice = 6
-	NBRBlockedSite       = 7
-	NBRUnmatchedUser     = 8
+	VideoPlcmtInstream     VideoPlcmt = 1
+	VideoPlcmtAccompanying VideoPlcmt = 2
+	VideoPlcmtInterstitial VideoPlcmt = 3
+	VideoPlcmtStandalone   VideoPlcmt = 4
+)
+
+// Pod Sequence (Spec 2.6)
+type PodSequence int
+
+const (
+	PodSeqLast  PodSequence = -1
+	PodSeqAny   PodSequence = 0
+	PodSeqFirst PodSequence = 1
+)
+
+// Slot Position in Pod (Spec 2.6)
+type SlotPosition int
+
+const (
+	SlotInPodLast        SlotPosition = -1
+	SlotInPodAny         SlotPosition = 0
+	SlotInPodFirst       SlotPosition = 1
+	SlotInPodFirstOrLast SlotPosition = 2
+)
+
+// Creative Markup Types (Spec 2.6)
+type MarkupType int
+
+const (
+	MarkupBanner MarkupType = 1
+	MarkupVideo  MarkupType = 2
+	MarkupAudio  MarkupType = 3
+	MarkupNative MarkupType = 4
+)
+
+// SSAI Types (Spec 2.6)
+type SSAIType int
+
+const (
+	SSAIUnknown      SSAIType = 0
+	SSAIClientSide   SSAIType = 1
+	SSAIServerStitch SSAIType = 2
+	SSAIServerAll    SSAIType = 3
+)
+
+// Quantity Source Types (Spec 2.6)
+type QtySource int
+
+const (
+	QtySourceMRCAccredited QtySource = 1
+	QtySourcePublisher     QtySource = 2
+	QtySourceExchange      QtySource = 3
+)
+
+// Audio Feed Types (Spec 2.4)
+type FeedType int
+
+const (
+	FeedMusicService FeedType = 1
+	FeedBroadcast    FeedType = 2 // FM/AM broadcast
+	FeedPodcast      FeedType = 3
+	FeedCatchUpRadio FeedType = 4 // (Spec 2.6)
+	FeedWebRadio     FeedType = 5 // (Spec 2.6)
+	FeedVideoGame    FeedType = 6 // (Spec 2.6)
+	FeedTextToSpeech FeedType = 7 // (Spec 2.6)
+)
+
+// Volume Normalization Modes (Spec 2.4)
+type VolumeNormalization int
+
+const (
+	NVolNone     VolumeNormalization = 0
+	NVolAverage  VolumeNormalization = 1 // Ad volume average normalized to content
+	NVolPeak     VolumeNormalization = 2 // Ad volume peak normalized to content
+	NVolLoudness VolumeNormalization = 3 // Ad loudness normalized to content
+	NVolCustom   VolumeNormalization = 4 // Custom volume normalization
+)
+
+// User-Agent Sources (Spec 2.6)
+type UASource int
+
+const (
+	UASourceUnknown     UASource = 0
+	UASourceLowEntropy  UASource = 1 // User-Agent Client Hints, only low-entropy headers available
+	UASourceHighEntropy UASource = 2 // User-Agent Client Hints, with high-entropy headers available
+	UASourceUserAgent   UASource = 3 // Parsed from the User-Agent header
+)
+
+// Agent Types of extended identifiers (Spec 2.6)
+type AgentType int
+
+const (
+	AgentTypeDevice AgentType = 1 // Web browser, mobile device or connected TV identifier
+	AgentTypePerson AgentType = 2 // Person-based identifier, e.g. derived from a login
+	AgentTypeOther  AgentType = 3
 )
 
 /*************************************************************************
@@ -206,6 +450,8 @@ type ThirdParty struct {
 	Cat    []string  `json:"cat,omitempty"` // Array of IAB content categories
 	Domain string    `json:"domain,omitempty"`
 	Ext    Extension `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 // The publisher object itself and all of its parameters are optional, so default values are not
@@ -213,6 +459,7 @@ type ThirdParty struct {
 type Publisher ThirdParty
 
 func (p *Publisher) Reset() {
+	p.unknown = nil
 	p.ID = ""
 	p.Name = ""
 	p.Domain = ""
@@ -224,6 +471,24 @@ func (p *Publisher) Reset() {
 	}
 }
 
+var publisherPool = sync.Pool{
+	New: func() interface{} {
+		return new(Publisher)
+	},
+}
+
+func NewPublisher() *Publisher {
+	return publisherPool.Get().(*Publisher)
+}
+
+func FreePublisher(p *Publisher) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	publisherPool.Put(p)
+}
+
 // The producer is useful when content where the ad is shown is syndicated, and may appear on a
 // completely different publisher. The producer object itself and all of its parameters are optional,
 // so default values are not provided. If an optional parameter is not specified, it should be
@@ -231,6 +496,7 @@ func (p *Publisher) Reset() {
 type Producer ThirdParty
 
 func (p *Producer) Reset() {
+	p.unknown = nil
 	p.ID = ""
 	p.Name = ""
 	p.Domain = ""
@@ -242,28 +508,49 @@ func (p *Producer) Reset() {
 	}
 }
 
+var producerPool = sync.Pool{
+	New: func() interface{} {
+		return new(Producer)
+	},
+}
+
+func NewProducer() *Producer {
+	return producerPool.Get().(*Producer)
+}
+
+func FreeProducer(p *Producer) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	producerPool.Put(p)
+}
+
 // Note that the Geo Object may appear in one or both the Device Object and the User Object.
 // This is intentional, since the information may be derived from either a device-oriented source
 // (such as IP geo lookup), or by user registration information (for example provided to a publisher
 // through a user registration).
 type Geo struct {
-	Lat           float64   `json:"lat,omitempty"`           // Latitude from -90 to 90
-	Lon           float64   `json:"lon,omitempty"`           // Longitude from -180 to 180
-	Type          int       `json:"type,omitempty"`          // Indicate the source of the geo data
-	Accuracy      int       `json:"accuracy,omitempty"`      // Estimated location accuracy in meters; recommended when lat/lon are specified and derived from a device’s location services
-	LastFix       int       `json:"lastfix,omitempty"`       // Number of seconds since this geolocation fix was established.
-	IPService     int       `json:"ipservice,omitempty"`     // Service or provider used to determine geolocation from IP address if applicable
-	Country       string    `json:"country,omitempty"`       // Country using ISO 3166-1 Alpha 3
-	Region        string    `json:"region,omitempty"`        // Region using ISO 3166-2
-	RegionFIPS104 string    `json:"regionFIPS104,omitempty"` // Region of a country using FIPS 10-4
-	Metro         string    `json:"metro,omitempty"`
-	City          string    `json:"city,omitempty"`
-	Zip           string    `json:"zip,omitempty"`
-	UTCOffset     int       `json:"utcoffset,omitempty"` // Local time as the number +/- of minutes from UTC
-	Ext           Extension `json:"ext,omitempty"`
+	Lat           float64         `json:"lat,omitempty"`           // Latitude from -90 to 90
+	Lon           float64         `json:"lon,omitempty"`           // Longitude from -180 to 180
+	Type          LocationType    `json:"type,omitempty"`          // Indicate the source of the geo data
+	Accuracy      int             `json:"accuracy,omitempty"`      // Estimated location accuracy in meters; recommended when lat/lon are specified and derived from a device’s location services
+	LastFix       int             `json:"lastfix,omitempty"`       // Number of seconds since this geolocation fix was established.
+	IPService     LocationService `json:"ipservice,omitempty"`     // Service or provider used to determine geolocation from IP address if applicable
+	Country       string          `json:"country,omitempty"`       // Country using ISO 3166-1 Alpha 3
+	Region        string          `json:"region,omitempty"`        // Region using ISO 3166-2
+	RegionFIPS104 string          `json:"regionFIPS104,omitempty"` // Region of a country using FIPS 10-4
+	Metro         string          `json:"metro,omitempty"`
+	City          string          `json:"city,omitempty"`
+	Zip           string          `json:"zip,omitempty"`
+	UTCOffset     int             `json:"utcoffset,omitempty"` // Local time as the number +/- of minutes from UTC
+	Ext           Extension       `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 func (g *Geo) Reset() {
+	g.unknown = nil
 	g.Lat = 0.0
 	g.Lon = 0.0
 	g.Type = 0
@@ -282,6 +569,61 @@ func (g *Geo) Reset() {
 	}
 }
 
+var geoPool = sync.Pool{
+	New: func() interface{} {
+		return new(Geo)
+	},
+}
+
+func NewGeo() *Geo {
+	return geoPool.Get().(*Geo)
+}
+
+func FreeGeo(g *Geo) {
+	if g == nil {
+		return
+	}
+	g.Reset()
+	geoPool.Put(g)
+}
+
+// Validates the object
+func (g *Geo) Validate() error {
+	return g.ValidateAll().First()
+}
+
+// ValidateAll validates the object and returns all issues found.
+func (g *Geo) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	g.validate(v, "")
+	return v.errs
+}
+
+func (g *Geo) validate(v *Validator, path string) {
+	if g.Lat < -90 || g.Lat > 90 {
+		v.AddError(PathKey(path, "lat"), ErrInvalidGeoLat)
+	}
+	if g.Lon < -180 || g.Lon > 180 {
+		v.AddError(PathKey(path, "lon"), ErrInvalidGeoLon)
+	}
+	if g.Country != "" && !isAlpha3(g.Country) {
+		v.AddError(PathKey(path, "country"), ErrInvalidGeoCountry)
+	}
+}
+
+// isAlpha3 checks the shape of an ISO-3166-1 alpha-3 code.
+func isAlpha3(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
 // This object contains information known or derived about the human user of the device (i.e., the
 // audience for advertising). The user id is an exchange artifact and may be subject to rotation or other
 // privacy policies. However, this user ID must be stable long enough to serve reasonably as the basis for
@@ -294,12 +636,17 @@ type User struct {
 	Gender     string    `json:"gender,omitempty"`     // Gender ("M": male, "F" female, "O" Other)
 	Keywords   string    `json:"keywords,omitempty"`   // Comma separated list of keywords, interests, or intent
 	CustomData string    `json:"customdata,omitempty"` // Optional feature to pass bidder data that was set in the exchange's cookie. The string must be in base85 cookie safe characters and be in any format. Proper JSON encoding must be used to include "escaped" quotation marks.
+	Consent    string    `json:"consent,omitempty"`    // GDPR consent string (TCF) if applicable; moved from user.ext.consent by ConvertTo (Spec 2.6)
 	Geo        *Geo      `json:"geo,omitempty"`
 	Data       []Data    `json:"data,omitempty"`
+	EIDs       []EID     `json:"eids,omitempty"` // Extended identifiers from identity providers; moved from user.ext.eids by ConvertTo (Spec 2.6)
 	Ext        Extension `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 func (u *User) Reset() {
+	u.unknown = nil
 	u.ID = ""
 	u.BuyerID = ""
 	u.BuyerUID = ""
@@ -307,8 +654,10 @@ func (u *User) Reset() {
 	u.Gender = ""
 	u.Keywords = ""
 	u.CustomData = ""
+	u.Consent = ""
 	if u.Geo != nil {
-		u.Geo.Reset()
+		FreeGeo(u.Geo)
+		u.Geo = nil
 	}
 	if u.Data != nil {
 		for i := 0; i < len(u.Data); i++ {
@@ -316,11 +665,144 @@ func (u *User) Reset() {
 		}
 		u.Data = u.Data[:0]
 	}
+	if u.EIDs != nil {
+		for i := 0; i < len(u.EIDs); i++ {
+			(&u.EIDs[i]).Reset()
+		}
+		u.EIDs = u.EIDs[:0]
+	}
 	if u.Ext != nil {
 		u.Ext = u.Ext[:0]
 	}
 }
 
+var userPool = sync.Pool{
+	New: func() interface{} {
+		return new(User)
+	},
+}
+
+func NewUser() *User {
+	return userPool.Get().(*User)
+}
+
+func FreeUser(u *User) {
+	if u == nil {
+		return
+	}
+	u.Reset()
+	userPool.Put(u)
+}
+
+type jsonUser User
+
+// MarshalJSON custom marshalling
+func (u *User) MarshalJSON() ([]byte, error) {
+	return (*jsonUser)(u).MarshalJSON()
+}
+
+// MarshalJSONBuf custom marshalling
+func (u *User) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	return (*jsonUser)(u).MarshalJSONBuf(buf)
+}
+
+// UnmarshalJSON custom unmarshalling
+func (u *User) UnmarshalJSON(data []byte) error {
+	return u.UnmarshalJSONFFLexer(fflib.NewFFLexer(data), fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer custom unmarshalling
+func (u *User) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	// decode in place, reusing the allocations of recycled objects
+	u.Reset()
+	return (*jsonUser)(u).UnmarshalJSONFFLexer(fs, state)
+}
+
+// readExt fills unset privacy fields from their legacy extension locations.
+func (u *User) readExt() error {
+	if len(u.Ext) == 0 || (u.Consent != "" && len(u.EIDs) != 0) {
+		return nil
+	}
+
+	var ext struct {
+		Consent string `json:"consent"`
+		EIDs    []EID  `json:"eids"`
+	}
+	if err := json.Unmarshal(u.Ext, &ext); err != nil {
+		return err
+	}
+	if u.Consent == "" {
+		u.Consent = ext.Consent
+	}
+	if len(u.EIDs) == 0 && len(ext.EIDs) != 0 {
+		u.EIDs = ext.EIDs
+	}
+	return nil
+}
+
+// EID returns the extended identifier of the given source, e.g. "id5-sync.com".
+// Returns nil if not present. Identifiers in user.ext.eids are only found
+// once moved by ConvertTo(Version2_6).
+func (u *User) EID(source string) *EID {
+	for i := range u.EIDs {
+		if u.EIDs[i].Source == source {
+			return &u.EIDs[i]
+		}
+	}
+	return nil
+}
+
+// UIDFor returns the first user ID issued by the given source. Returns an
+// empty string if not present.
+func (u *User) UIDFor(source string) string {
+	if eid := u.EID(source); eid != nil && len(eid.UIDs) != 0 {
+		return eid.UIDs[0].ID
+	}
+	return ""
+}
+
+// Validates the object
+func (u *User) Validate() error {
+	return u.ValidateAll().First()
+}
+
+// ValidateAll validates the object and returns all issues found.
+func (u *User) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	u.validate(v, "")
+	return v.errs
+}
+
+func (u *User) validate(v *Validator, path string) {
+	if u.YOB != 0 && (u.YOB < 1900 || u.YOB > time.Now().Year()) {
+		v.AddError(PathKey(path, "yob"), ErrInvalidUserYOB)
+	}
+	switch u.Gender {
+	case "", "M", "F", "O":
+	default:
+		v.AddError(PathKey(path, "gender"), ErrInvalidUserGender)
+	}
+	if u.Geo != nil {
+		u.Geo.validate(v, PathKey(path, "geo"))
+	}
+	for i := range u.EIDs {
+		u.EIDs[i].validate(v, PathIndex(PathKey(path, "eids"), i))
+	}
+
+	v.deprecated(Version2_6, u.YOB != 0, PathKey(path, "yob"))
+	v.deprecated(Version2_6, u.Gender != "", PathKey(path, "gender"))
+	v.since(Version2_6, u.Consent != "" && !u.Ext.has("consent"), PathKey(path, "consent"))
+	v.since(Version2_6, len(u.EIDs) != 0 && !u.Ext.has("eids"), PathKey(path, "eids"))
+}
+
+func (u *User) normalizeAll(n *normalizer, path string) {
+	if u.BuyerID != "" && (u.BuyerUID == "" || u.BuyerUID == u.BuyerID) {
+		u.BuyerUID = u.BuyerID
+		u.BuyerID = ""
+		n.migrate(PathKey(path, "buyeruid"))
+	}
+}
+
 // The data and segment objects together allow additional data about the user to be specified. This data
 // may be from multiple sources whether from the exchange itself or third party providers as specified by
 // the id field. A bid request can mix data objects from multiple providers. The specific data providers in
@@ -330,9 +812,12 @@ type Data struct {
 	Name    string    `json:"name,omitempty"`
 	Segment []Segment `json:"segment,omitempty"`
 	Ext     Extension `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 func (d *Data) Reset() {
+	d.unknown = nil
 	d.ID = ""
 	d.Name = ""
 	if d.Segment != nil {
@@ -354,9 +839,12 @@ type Segment struct {
 	Name  string    `json:"name,omitempty"`
 	Value string    `json:"value,omitempty"`
 	Ext   Extension `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 func (s *Segment) Reset() {
+	s.unknown = nil
 	s.ID = ""
 	s.Name = ""
 	s.Value = ""
@@ -369,26 +857,160 @@ func (s *Segment) Reset() {
 // coppa flag signals whether or not the request falls under the United States Federal Trade Commission's
 // regulations for the United States Children's Online Privacy Protection Act ("COPPA").
 type Regulations struct {
-	Coppa int       `json:"coppa,omitempty"` // Flag indicating if this request is subject to the COPPA regulations established by the USA FTC, where 0 = no, 1 = yes.
-	Ext   Extension `json:"ext,omitempty"`
+	Coppa     int       `json:"coppa,omitempty"`      // Flag indicating if this request is subject to the COPPA regulations established by the USA FTC, where 0 = no, 1 = yes.
+	GDPR      *int      `json:"gdpr,omitempty"`       // Flag indicating if this request is subject to the GDPR, where 0 = no, 1 = yes, nil = unknown; moved from regs.ext.gdpr by ConvertTo (Spec 2.6)
+	USPrivacy string    `json:"us_privacy,omitempty"` // Communicates signals regarding consumer privacy under US privacy regulation; moved from regs.ext.us_privacy by ConvertTo (Spec 2.6)
+	GPP       string    `json:"gpp,omitempty"`        // Global Privacy Platform consent string (Spec 2.6)
+	GPPSID    []int     `json:"gpp_sid,omitempty"`    // Section IDs of the GPP string which are applicable to this transaction (Spec 2.6)
+	Ext       Extension `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 func (r *Regulations) Reset() {
+	r.unknown = nil
 	r.Coppa = 0
+	r.GDPR = nil
+	r.USPrivacy = ""
+	r.GPP = ""
+	if r.GPPSID != nil {
+		r.GPPSID = r.GPPSID[:0]
+	}
 	if r.Ext != nil {
 		r.Ext = r.Ext[:0]
 	}
 }
 
+var regulationsPool = sync.Pool{
+	New: func() interface{} {
+		return new(Regulations)
+	},
+}
+
+func NewRegulations() *Regulations {
+	return regulationsPool.Get().(*Regulations)
+}
+
+func FreeRegulations(r *Regulations) {
+	if r == nil {
+		return
+	}
+	r.Reset()
+	regulationsPool.Put(r)
+}
+
+type jsonRegulations Regulations
+
+// MarshalJSON custom marshalling
+func (r *Regulations) MarshalJSON() ([]byte, error) {
+	return (*jsonRegulations)(r).MarshalJSON()
+}
+
+// MarshalJSONBuf custom marshalling
+func (r *Regulations) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	return (*jsonRegulations)(r).MarshalJSONBuf(buf)
+}
+
+// UnmarshalJSON custom unmarshalling
+func (r *Regulations) UnmarshalJSON(data []byte) error {
+	return r.UnmarshalJSONFFLexer(fflib.NewFFLexer(data), fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer custom unmarshalling
+func (r *Regulations) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	// decode in place, reusing the allocations of recycled objects
+	r.Reset()
+	return (*jsonRegulations)(r).UnmarshalJSONFFLexer(fs, state)
+}
+
+// readExt fills unset privacy fields from their legacy extension locations.
+func (r *Regulations) readExt() error {
+	if len(r.Ext) == 0 {
+		return nil
+	}
+
+	var ext struct {
+		GDPR      *NumberOrString `json:"gdpr"`
+		USPrivacy string          `json:"us_privacy"`
+		GPP       string          `json:"gpp"`
+		GPPSID    []int           `json:"gpp_sid"`
+	}
+	if err := json.Unmarshal(r.Ext, &ext); err != nil {
+		return err
+	}
+	if r.GDPR == nil && ext.GDPR != nil {
+		gdpr := int(*ext.GDPR)
+		r.GDPR = &gdpr
+	}
+	if r.USPrivacy == "" {
+		r.USPrivacy = ext.USPrivacy
+	}
+	if r.GPP == "" {
+		r.GPP = ext.GPP
+	}
+	if len(r.GPPSID) == 0 && len(ext.GPPSID) != 0 {
+		r.GPPSID = ext.GPPSID
+	}
+	return nil
+}
+
+// Validates the object
+func (r *Regulations) Validate() error {
+	return r.ValidateAll().First()
+}
+
+// ValidateAll validates the object and returns all issues found.
+func (r *Regulations) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	r.validate(v, "")
+	return v.errs
+}
+
+func (r *Regulations) validate(v *Validator, path string) {
+	if r.GDPR != nil && *r.GDPR != 0 && *r.GDPR != 1 {
+		v.AddError(PathKey(path, "gdpr"), ErrInvalidRegsGDPR)
+	}
+	if r.USPrivacy != "" && !isUSPrivacy(r.USPrivacy) {
+		v.AddError(PathKey(path, "us_privacy"), ErrInvalidRegsUSPrivacy)
+	}
+	if r.GPP != "" && len(r.GPPSID) == 0 {
+		v.AddWarning(PathKey(path, "gpp_sid"), ErrInvalidRegsGPPSID)
+	}
+
+	// signals may also be carried in their legacy extension locations
+	v.since(Version2_6, r.GDPR != nil && !r.Ext.has("gdpr"), PathKey(path, "gdpr"))
+	v.since(Version2_6, r.USPrivacy != "" && !r.Ext.has("us_privacy"), PathKey(path, "us_privacy"))
+	v.since(Version2_6, r.GPP != "" && !r.Ext.has("gpp"), PathKey(path, "gpp"))
+	v.since(Version2_6, len(r.GPPSID) != 0 && !r.Ext.has("gpp_sid"), PathKey(path, "gpp_sid"))
+}
+
+// isUSPrivacy checks the shape of a CCPA US privacy string, e.g. "1YNN".
+func isUSPrivacy(s string) bool {
+	if len(s) != 4 || s[0] != '1' {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case 'Y', 'N', 'y', 'n', '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // This object represents an allowed size (i.e., height and width combination) for a banner impression.
 // These are typically used in an array for an impression where multiple sizes are permitted.
 type Format struct {
 	W   int       `json:"w,omitempty"` // Width in device independent pixels (DIPS).
 	H   int       `json:"h,omitempty"` //Height in device independent pixels (DIPS).
 	Ext Extension `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 func (f *Format) Reset() {
+	f.unknown = nil
 	f.W = 0
 	f.H = 0
 	if f.Ext != nil {
@@ -0,0 +1,1436 @@
+package openrtb3
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/bsm/openrtb"
+	"github.com/bsm/openrtb/adcom1"
+	"github.com/bsm/openrtb/native/request"
+)
+
+// FromBidRequest converts an OpenRTB 2.x bid request into its OpenRTB 3.0
+// counterpart. Fields which have no 3.0 equivalent are reported as
+// openrtb.ChangeDrop, fields which are carried in an extension are
+// reported as openrtb.ChangeMigrate. Change paths always refer to the
+// source object.
+func FromBidRequest(req *openrtb.BidRequest) (*Request, []openrtb.Change, error) {
+	c := new(converter)
+	r, err := c.fromBidRequest(req)
+	return r, c.changes, err
+}
+
+// ToBidRequest converts an OpenRTB 3.0 request into its OpenRTB 2.x
+// counterpart. Fields which have no 2.x equivalent are reported as
+// openrtb.ChangeDrop, fields restored from an extension are reported as
+// openrtb.ChangeMigrate.
+func ToBidRequest(r *Request) (*openrtb.BidRequest, []openrtb.Change, error) {
+	c := new(converter)
+	req, err := c.toBidRequest(r)
+	return req, c.changes, err
+}
+
+// FromBidResponse converts an OpenRTB 2.x bid response into its OpenRTB 3.0
+// counterpart. Fields which have no 3.0 equivalent are reported as
+// openrtb.ChangeDrop. The media type of bids without mtype is inferred from
+// the markup, protocol and duration, defaulting to display.
+func FromBidResponse(res *openrtb.BidResponse) (*Response, []openrtb.Change) {
+	c := new(converter)
+	r := c.fromBidResponse(res)
+	return r, c.changes
+}
+
+// ToBidResponse converts an OpenRTB 3.0 response into its OpenRTB 2.x
+// counterpart. Fields which have no 2.x equivalent are reported as
+// openrtb.ChangeDrop.
+func ToBidResponse(r *Response) (*openrtb.BidResponse, []openrtb.Change) {
+	c := new(converter)
+	res := c.toBidResponse(r)
+	return res, c.changes
+}
+
+// --------------------------------------------------------------------
+
+// converter collects changes while converting between versions.
+type converter struct {
+	changes []openrtb.Change
+}
+
+func (c *converter) migrate(path string) {
+	c.changes = append(c.changes, openrtb.Change{Path: path, Kind: openrtb.ChangeMigrate})
+}
+
+func (c *converter) drop(path string) {
+	c.changes = append(c.changes, openrtb.Change{Path: path, Kind: openrtb.ChangeDrop})
+}
+
+func (c *converter) dropIf(cond bool, path string) {
+	if cond {
+		c.drop(path)
+	}
+}
+
+// extPut stores v under key and records a migration.
+func (c *converter) extPut(ext openrtb.Extension, key string, v interface{}, path string) (openrtb.Extension, error) {
+	m, err := extFields(ext)
+	if err != nil {
+		return ext, err
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return ext, err
+	}
+	m[key] = raw
+	c.migrate(openrtb.PathKey(path, key))
+	return extOf(m)
+}
+
+// extTake decodes key into v and removes it, recording a migration.
+// It is a no-op if the key is absent.
+func (c *converter) extTake(ext openrtb.Extension, key string, v interface{}, path string) (openrtb.Extension, error) {
+	m, err := extFields(ext)
+	if err != nil {
+		return ext, err
+	}
+	raw, ok := m[key]
+	if !ok {
+		return ext, nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return ext, err
+	}
+	delete(m, key)
+	c.migrate(openrtb.PathKey(openrtb.PathKey(path, "ext"), key))
+	return extOf(m)
+}
+
+func extFields(ext openrtb.Extension) (map[string]json.RawMessage, error) {
+	m := make(map[string]json.RawMessage)
+	if len(ext) == 0 {
+		return m, nil
+	}
+	if err := json.Unmarshal(ext, &m); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		m = make(map[string]json.RawMessage)
+	}
+	return m, nil
+}
+
+func extOf(m map[string]json.RawMessage) (openrtb.Extension, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(m)
+	return openrtb.Extension(b), err
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+// --------------------------------------------------------------------
+
+func (c *converter) fromBidRequest(req *openrtb.BidRequest) (*Request, error) {
+	r := &Request{
+		ID:   req.ID,
+		Test: req.Test,
+		TMax: req.TMax,
+		AT:   req.AuctionType,
+		Cur:  req.Cur,
+		Ext:  req.Ext,
+	}
+
+	switch {
+	case len(req.WSeat) != 0:
+		r.Seat = req.WSeat
+		c.dropIf(len(req.BSeat) != 0, "bseat")
+	case len(req.BSeat) != 0:
+		r.Seat = req.BSeat
+		r.WSeat = intPtr(0)
+	}
+	c.dropIf(req.AllImps != 0, "allimps")
+
+	var battr []openrtb.CreativeAttribute
+	for i := range req.Imp {
+		imp := &req.Imp[i]
+		r.Item = append(r.Item, *c.fromImpression(req, imp, openrtb.PathIndex("imp", i)))
+		battr = appendAttrs(battr, imp)
+	}
+
+	ctx := new(Context)
+	if req.Site != nil {
+		ctx.Site = &adcom1.Site{
+			DistributionChannel: fromInventory(&req.Site.Inventory),
+			Page:                req.Site.Page,
+			Ref:                 req.Site.Ref,
+			Search:              req.Site.Search,
+			Mobile:              req.Site.Mobile,
+		}
+	}
+	if req.App != nil {
+		ctx.App = &adcom1.App{
+			DistributionChannel: fromInventory(&req.App.Inventory),
+			Bundle:              req.App.Bundle,
+			StoreURL:            req.App.StoreURL,
+			Ver:                 req.App.Ver,
+			Paid:                req.App.Paid,
+		}
+	}
+	if req.Device != nil {
+		dev, err := c.fromDevice(req.Device, "device")
+		if err != nil {
+			return nil, err
+		}
+		ctx.Device = dev
+	}
+	if req.User != nil {
+		user, err := c.fromUser(req.User, "user")
+		if err != nil {
+			return nil, err
+		}
+		ctx.User = user
+		r.CData = req.User.CustomData
+	}
+	if req.Regs != nil {
+		regs, err := c.fromRegs(req.Regs, "regs")
+		if err != nil {
+			return nil, err
+		}
+		ctx.Regs = regs
+	}
+	if len(req.Bcat) != 0 || len(req.BAdv) != 0 || len(req.BApp) != 0 || len(battr) != 0 {
+		ctx.Restrictions = &adcom1.Restrictions{
+			BCat:   req.Bcat,
+			CatTax: req.CatTax,
+			BAdv:   req.BAdv,
+			BApp:   req.BApp,
+			BAttr:  battr,
+		}
+	}
+	if *ctx != (Context{}) {
+		r.Context = ctx
+	}
+
+	if req.Source != nil {
+		src, err := c.fromSource(req.Source, "source")
+		if err != nil {
+			return nil, err
+		}
+		r.Source = src
+	}
+	return r, nil
+}
+
+// appendAttrs appends blocked creative attributes of all media of an impression.
+func appendAttrs(attrs []openrtb.CreativeAttribute, imp *openrtb.Impression) []openrtb.CreativeAttribute {
+	var lists [][]openrtb.CreativeAttribute
+	if imp.Banner != nil {
+		lists = append(lists, imp.Banner.BAttr)
+	}
+	if imp.Video != nil {
+		lists = append(lists, imp.Video.BAttr)
+	}
+	if imp.Audio != nil {
+		lists = append(lists, imp.Audio.BAttr)
+	}
+	if imp.Native != nil {
+		lists = append(lists, imp.Native.BAttr)
+	}
+	for _, list := range lists {
+		for _, a := range list {
+			if !containsAttr(attrs, a) {
+				attrs = append(attrs, a)
+			}
+		}
+	}
+	return attrs
+}
+
+func containsAttr(attrs []openrtb.CreativeAttribute, a openrtb.CreativeAttribute) bool {
+	for _, x := range attrs {
+		if x == a {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *converter) fromImpression(req *openrtb.BidRequest, imp *openrtb.Impression, path string) *Item {
+	item := &Item{
+		ID:     imp.ID,
+		Flr:    imp.BidFloor,
+		FlrCur: imp.BidFloorCurrency,
+		Exp:    imp.Exp,
+		DT:     imp.DT,
+		Ext:    imp.Ext,
+	}
+
+	if q := imp.Qty; q != nil {
+		if n := int(q.Multiplier); float64(n) == q.Multiplier {
+			item.Qty = n
+		} else {
+			c.drop(openrtb.PathKey(path, "qty"))
+		}
+	}
+
+	pmp, pmpPath := imp.Pmp, openrtb.PathKey(path, "pmp")
+	if pmp == nil && req.Pmp != nil {
+		pmp, pmpPath = req.Pmp, "pmp"
+	}
+	if pmp != nil {
+		item.Private = pmp.Private
+		for i, d := range pmp.Deals {
+			deal := Deal{
+				ID:       d.ID,
+				Flr:      d.BidFloor,
+				FlrCur:   d.BidFloorCurrency,
+				AT:       d.AuctionType,
+				WSeat:    d.WSeat,
+				WADomain: d.WAdvDomain,
+				Ext:      d.Ext,
+			}
+			if len(d.Seats) != 0 {
+				deal.WSeat = append(append([]string{}, d.WSeat...), d.Seats...)
+			}
+			c.dropIf(d.Type != 0, openrtb.PathKey(openrtb.PathIndex(openrtb.PathKey(pmpPath, "deals"), i), "type"))
+			item.Deal = append(item.Deal, deal)
+		}
+		c.dropIf(len(pmp.Ext) != 0, openrtb.PathKey(pmpPath, "ext"))
+	}
+
+	plc := &adcom1.Placement{
+		TagID:  imp.TagID,
+		SSAI:   imp.SSAI,
+		SDK:    imp.DisplayManager,
+		SDKVer: imp.DisplayManagerVer,
+		Reward: imp.Rwdd,
+		WLang:  req.WLang,
+		WLangB: req.WLangB,
+		Secure: int(imp.Secure),
+	}
+	if imp.Banner != nil || imp.Native != nil {
+		plc.Display = &adcom1.DisplayPlacement{
+			Instl:   imp.Instl,
+			IfrBust: imp.IFrameBuster,
+		}
+		if imp.Banner != nil {
+			c.fromBanner(plc.Display, imp.Banner, openrtb.PathKey(path, "banner"))
+			c.dropIf(imp.Banner.ID != "", openrtb.PathKey(openrtb.PathKey(path, "banner"), "id"))
+		}
+		if imp.Native != nil {
+			c.fromNative(plc.Display, imp.Native, openrtb.PathKey(path, "native"))
+		}
+	} else {
+		c.dropIf(imp.Instl != 0, openrtb.PathKey(path, "instl"))
+		c.dropIf(len(imp.IFrameBuster) != 0, openrtb.PathKey(path, "iframebuster"))
+	}
+	if imp.Video != nil {
+		plc.Video = c.fromVideo(imp.Video, openrtb.PathKey(path, "video"))
+	}
+	if imp.Audio != nil {
+		plc.Audio = c.fromAudio(imp.Audio, openrtb.PathKey(path, "audio"))
+	}
+	item.Spec = &Spec{Placement: plc}
+	return item
+}
+
+func (c *converter) fromBanner(d *adcom1.DisplayPlacement, b *openrtb.Banner, path string) {
+	d.Pos = b.Pos
+	d.TopFrame = b.TopFrame
+	d.MIME = b.Mimes
+	d.API = b.Api
+	d.W = b.W
+	d.H = b.H
+	d.Ext = b.Ext
+	for _, f := range b.Format {
+		d.DisplayFmt = append(d.DisplayFmt, adcom1.DisplayFormat{W: f.W, H: f.H, ExpDir: b.ExpDir, Ext: f.Ext})
+	}
+	if len(b.Format) == 0 && len(b.ExpDir) != 0 {
+		d.DisplayFmt = append(d.DisplayFmt, adcom1.DisplayFormat{W: b.W, H: b.H, ExpDir: b.ExpDir})
+	}
+
+	c.dropIf(b.WMax != 0, openrtb.PathKey(path, "wmax"))
+	c.dropIf(b.HMax != 0, openrtb.PathKey(path, "hmax"))
+	c.dropIf(b.WMin != 0, openrtb.PathKey(path, "wmin"))
+	c.dropIf(b.HMin != 0, openrtb.PathKey(path, "hmin"))
+	c.dropIf(len(b.BType) != 0, openrtb.PathKey(path, "btype"))
+}
+
+func (c *converter) fromNative(d *adcom1.DisplayPlacement, n *openrtb.Native, path string) {
+	if len(d.API) == 0 {
+		d.API = n.API
+	}
+	c.dropIf(len(n.Ext) != 0, openrtb.PathKey(path, "ext"))
+
+	nreq := new(request.Request)
+	if err := n.DecodeRequest(nreq); err != nil {
+		c.drop(openrtb.PathKey(path, "request"))
+		return
+	}
+
+	path = openrtb.PathKey(path, "request")
+	if nreq.ContextSubTypeID != 0 {
+		d.Context = adcom1.DisplayContextType(nreq.ContextSubTypeID)
+	} else {
+		d.Context = adcom1.DisplayContextType(nreq.ContextTypeID)
+	}
+	switch nreq.PlacementTypeID {
+	case 0:
+	case request.PlacementTypeInFeed:
+		if d.PType == 0 {
+			d.PType = adcom1.DisplayPlacementInFeed
+		}
+	default:
+		c.drop(openrtb.PathKey(path, "plcmttype"))
+	}
+	c.dropIf(nreq.LayoutID != 0, openrtb.PathKey(path, "layout"))
+	c.dropIf(nreq.AdUnitID != 0, openrtb.PathKey(path, "adunit"))
+	c.dropIf(nreq.PlacementCount != 0, openrtb.PathKey(path, "plcmtcnt"))
+	c.dropIf(nreq.Sequence != 0, openrtb.PathKey(path, "seq"))
+
+	nf := &adcom1.NativeFormat{Ext: nreq.Ext}
+	for i, a := range nreq.Assets {
+		af := adcom1.AssetFormat{ID: a.ID, Req: a.Required, Ext: a.Ext}
+		switch {
+		case a.Title != nil:
+			af.Title = &adcom1.TitleAssetFormat{Len: a.Title.Length, Ext: a.Title.Ext}
+		case a.Image != nil:
+			af.Img = &adcom1.ImageAssetFormat{
+				Type: adcom1.NativeImageAssetType(a.Image.TypeID),
+				MIME: a.Image.Mimes,
+				W:    a.Image.Width,
+				H:    a.Image.Height,
+				WMin: a.Image.WidthMin,
+				HMin: a.Image.HeightMin,
+				Ext:  a.Image.Ext,
+			}
+		case a.Video != nil:
+			vpath := openrtb.PathKey(openrtb.PathIndex(openrtb.PathKey(path, "assets"), i), "video")
+			af.Video = c.fromVideo(a.Video.ToVideo(), vpath)
+			c.dropIf(len(a.Video.BAttr) != 0, openrtb.PathKey(vpath, "battr"))
+		case a.Data != nil:
+			af.Data = &adcom1.DataAssetFormat{
+				Type: adcom1.NativeDataAssetType(a.Data.TypeID),
+				Len:  a.Data.Length,
+				Ext:  a.Data.Ext,
+			}
+		}
+		nf.Asset = append(nf.Asset, af)
+	}
+	d.NativeFmt = nf
+}
+
+func (c *converter) fromVideo(v *openrtb.Video, path string) *adcom1.VideoPlacement {
+	vp := &adcom1.VideoPlacement{
+		PType:      v.Placement,
+		Pos:        v.Pos,
+		Delay:      v.StartDelay,
+		Skip:       v.Skip,
+		SkipMin:    v.SkipMin,
+		SkipAfter:  v.SkipAfter,
+		PlayMethod: v.PlaybackMethod,
+		MIME:       v.Mimes,
+		API:        v.Api,
+		CType:      v.Protocols,
+		W:          v.W,
+		H:          v.H,
+		MinDur:     v.MinDuration,
+		MaxDur:     v.MaxDuration,
+		MaxExt:     v.MaxExtended,
+		MinBitR:    v.MinBitrate,
+		MaxBitR:    v.MaxBitrate,
+		Delivery:   v.Delivery,
+		MaxSeq:     v.MaxSeq,
+		Linear:     v.Linearity,
+		Boxing:     v.BoxingAllowed,
+		CompType:   v.CompanionType,
+		Ext:        v.Ext,
+	}
+	if v.Protocol != 0 && !containsProtocol(vp.CType, v.Protocol) {
+		vp.CType = append(append([]openrtb.Protocol{}, vp.CType...), v.Protocol)
+	}
+	vp.Comp = c.fromCompanions(v.CompanionAd, openrtb.PathKey(path, "companionad"))
+
+	c.dropIf(v.Sequence != 0, openrtb.PathKey(path, "sequence"))
+	c.dropIf(v.Plcmt != 0, openrtb.PathKey(path, "plcmt"))
+	c.dropIf(v.PodID != "", openrtb.PathKey(path, "podid"))
+	c.dropIf(v.PodDur != 0, openrtb.PathKey(path, "poddur"))
+	c.dropIf(len(v.RqdDurs) != 0, openrtb.PathKey(path, "rqddurs"))
+	c.dropIf(v.PodSeq != 0, openrtb.PathKey(path, "podseq"))
+	c.dropIf(v.SlotInPod != 0, openrtb.PathKey(path, "slotinpod"))
+	c.dropIf(v.MinCPMPerSec != 0, openrtb.PathKey(path, "mincpmpersec"))
+	return vp
+}
+
+func (c *converter) fromAudio(a *openrtb.Audio, path string) *adcom1.AudioPlacement {
+	ap := &adcom1.AudioPlacement{
+		Delay:    a.StartDelay,
+		Feed:     a.Feed,
+		NVol:     a.NVol,
+		MIME:     a.Mimes,
+		API:      a.API,
+		CType:    a.Protocols,
+		MinDur:   a.MinDuration,
+		MaxDur:   a.MaxDuration,
+		MaxExt:   a.MaxExtended,
+		MinBitR:  a.MinBitrate,
+		MaxBitR:  a.MaxBitrate,
+		Delivery: a.Delivery,
+		MaxSeq:   a.MaxSequence,
+		CompType: a.CompanionType,
+		Ext:      a.Ext,
+	}
+	ap.Comp = c.fromCompanions(a.CompanionAd, openrtb.PathKey(path, "companionad"))
+
+	c.dropIf(a.Sequence != 0, openrtb.PathKey(path, "sequence"))
+	c.dropIf(a.Stitched != 0, openrtb.PathKey(path, "stitched"))
+	return ap
+}
+
+func (c *converter) fromCompanions(banners []openrtb.Banner, path string) []adcom1.Companion {
+	var comps []adcom1.Companion
+	for i := range banners {
+		d := new(adcom1.DisplayPlacement)
+		c.fromBanner(d, &banners[i], openrtb.PathIndex(path, i))
+		c.dropIf(len(banners[i].BAttr) != 0, openrtb.PathKey(openrtb.PathIndex(path, i), "battr"))
+		comps = append(comps, adcom1.Companion{ID: banners[i].ID, Display: d})
+	}
+	return comps
+}
+
+func containsProtocol(list []openrtb.Protocol, p openrtb.Protocol) bool {
+	for _, x := range list {
+		if x == p {
+			return true
+		}
+	}
+	return false
+}
+
+func fromInventory(inv *openrtb.Inventory) adcom1.DistributionChannel {
+	return adcom1.DistributionChannel{
+		ID:         inv.ID,
+		Name:       inv.Name,
+		Pub:        fromThirdParty((*openrtb.ThirdParty)(inv.Publisher)),
+		Content:    fromContent(inv.Content),
+		Domain:     inv.Domain,
+		Cat:        inv.Cat,
+		SectCat:    inv.SectionCat,
+		PageCat:    inv.PageCat,
+		PrivPolicy: inv.PrivacyPolicy,
+		Keywords:   inv.Keywords,
+		Ext:        inv.Ext,
+	}
+}
+
+func fromThirdParty(p *openrtb.ThirdParty) *adcom1.Publisher {
+	if p == nil {
+		return nil
+	}
+	return &adcom1.Publisher{ID: p.ID, Name: p.Name, Domain: p.Domain, Cat: p.Cat, Ext: p.Ext}
+}
+
+func fromContent(ct *openrtb.Content) *adcom1.Content {
+	if ct == nil {
+		return nil
+	}
+	res := &adcom1.Content{
+		ID:       ct.ID,
+		Episode:  ct.Episode,
+		Title:    ct.Title,
+		Series:   ct.Series,
+		Season:   ct.Season,
+		Artist:   ct.Artist,
+		Genre:    ct.Genre,
+		Album:    ct.Album,
+		ISRC:     ct.ISRC,
+		URL:      ct.URL,
+		Cat:      ct.Cat,
+		ProdQ:    ct.ProdQuality,
+		Context:  ct.Context,
+		Rating:   ct.ContentRating,
+		URating:  ct.UserRating,
+		MRating:  ct.QAGMediaRating,
+		Keywords: ct.Keywords,
+		Live:     ct.LiveStream,
+		SrcRel:   ct.SourceRelationship,
+		Len:      ct.Len,
+		Lang:     ct.Language,
+		Embed:    ct.Embeddable,
+		Producer: (*adcom1.Producer)(fromThirdParty((*openrtb.ThirdParty)(ct.Producer))),
+		Data:     fromData(ct.Data),
+		Ext:      ct.Ext,
+	}
+	if res.ProdQ == 0 {
+		res.ProdQ = ct.VideoQuality
+	}
+	return res
+}
+
+func fromData(data []openrtb.Data) []adcom1.Data {
+	var res []adcom1.Data
+	for _, d := range data {
+		ad := adcom1.Data{ID: d.ID, Name: d.Name, Ext: d.Ext}
+		for _, s := range d.Segment {
+			ad.Segment = append(ad.Segment, adcom1.Segment{ID: s.ID, Name: s.Name, Value: s.Value, Ext: s.Ext})
+		}
+		res = append(res, ad)
+	}
+	return res
+}
+
+func (c *converter) fromGeo(g *openrtb.Geo, path string) *adcom1.Geo {
+	if g == nil {
+		return nil
+	}
+	c.dropIf(g.RegionFIPS104 != "", openrtb.PathKey(path, "regionFIPS104"))
+	return &adcom1.Geo{
+		Type:      g.Type,
+		Lat:       g.Lat,
+		Lon:       g.Lon,
+		Accur:     g.Accuracy,
+		LastFix:   g.LastFix,
+		IPServ:    g.IPService,
+		Country:   g.Country,
+		Region:    g.Region,
+		Metro:     g.Metro,
+		City:      g.City,
+		Zip:       g.Zip,
+		UTCOffset: g.UTCOffset,
+		Ext:       g.Ext,
+	}
+}
+
+func (c *converter) fromDevice(d *openrtb.Device, path string) (*adcom1.Device, error) {
+	dev := &adcom1.Device{
+		Type:     d.DeviceType,
+		UA:       d.UA,
+		IFA:      d.IFA,
+		DNT:      d.DNT,
+		LMT:      d.LMT,
+		Make:     d.Make,
+		Model:    d.Model,
+		OSV:      d.OSVer,
+		HWV:      d.HwVer,
+		H:        d.H,
+		W:        d.W,
+		PPI:      d.PPI,
+		PxRatio:  d.PxRatio,
+		JS:       d.JS,
+		Lang:     d.Language,
+		IP:       d.IP,
+		IPv6:     d.IPv6,
+		Carrier:  d.Carrier,
+		MCCMNC:   d.MCCMNC,
+		ConType:  d.ConnType,
+		GeoFetch: d.GeoFetch,
+		Geo:      c.fromGeo(d.Geo, openrtb.PathKey(path, "geo")),
+		Ext:      d.Ext,
+	}
+	if d.OS != "" {
+		if dev.OS = adcom1.ParseOperatingSystem(d.OS); dev.OS == 0 {
+			c.drop(openrtb.PathKey(path, "os"))
+		}
+	}
+	c.dropIf(d.FlashVer != "", openrtb.PathKey(path, "flashver"))
+	c.dropIf(d.IDSHA1 != "", openrtb.PathKey(path, "didsha1"))
+	c.dropIf(d.IDMD5 != "", openrtb.PathKey(path, "didmd5"))
+	c.dropIf(d.PIDSHA1 != "", openrtb.PathKey(path, "dpidsha1"))
+	c.dropIf(d.PIDMD5 != "", openrtb.PathKey(path, "dpidmd5"))
+	c.dropIf(d.MacSHA1 != "", openrtb.PathKey(path, "macsha1"))
+	c.dropIf(d.MacMD5 != "", openrtb.PathKey(path, "macmd5"))
+
+	var err error
+	if d.SUA != nil {
+		dev.Ext, err = c.extPut(dev.Ext, "sua", d.SUA, path)
+	}
+	return dev, err
+}
+
+func (c *converter) fromUser(u *openrtb.User, path string) (*adcom1.User, error) {
+	user := &adcom1.User{
+		ID:       u.ID,
+		BuyerUID: u.BuyerUID,
+		YOB:      u.YOB,
+		Gender:   u.Gender,
+		Keywords: u.Keywords,
+		Consent:  u.Consent,
+		Geo:      c.fromGeo(u.Geo, openrtb.PathKey(path, "geo")),
+		Data:     fromData(u.Data),
+		Ext:      u.Ext,
+	}
+	if user.BuyerUID == "" {
+		user.BuyerUID = u.BuyerID
+	}
+
+	var err error
+	if len(u.EIDs) != 0 {
+		user.Ext, err = c.extPut(user.Ext, "eids", u.EIDs, path)
+	}
+	return user, err
+}
+
+func (c *converter) fromRegs(r *openrtb.Regulations, path string) (*adcom1.Regs, error) {
+	regs := &adcom1.Regs{COPPA: r.Coppa, GDPR: r.GDPR, Ext: r.Ext}
+
+	var err error
+	if r.USPrivacy != "" {
+		if regs.Ext, err = c.extPut(regs.Ext, "us_privacy", r.USPrivacy, path); err != nil {
+			return nil, err
+		}
+	}
+	if r.GPP != "" {
+		if regs.Ext, err = c.extPut(regs.Ext, "gpp", r.GPP, path); err != nil {
+			return nil, err
+		}
+	}
+	if len(r.GPPSID) != 0 {
+		if regs.Ext, err = c.extPut(regs.Ext, "gpp_sid", r.GPPSID, path); err != nil {
+			return nil, err
+		}
+	}
+	return regs, nil
+}
+
+func (c *converter) fromSource(s *openrtb.Source, path string) (*Source, error) {
+	src := &Source{TID: s.TransactionID, PChain: s.PaymentChain, Ext: s.Ext}
+	c.dropIf(s.FinalSaleDecision != 0, openrtb.PathKey(path, "fd"))
+
+	var err error
+	if s.SChain != nil {
+		src.Ext, err = c.extPut(src.Ext, "schain", s.SChain, path)
+	}
+	return src, err
+}
+
+// --------------------------------------------------------------------
+
+func (c *converter) toBidRequest(r *Request) (*openrtb.BidRequest, error) {
+	req := &openrtb.BidRequest{
+		ID:          r.ID,
+		Test:        r.Test,
+		TMax:        r.TMax,
+		AuctionType: r.AT,
+		Cur:         r.Cur,
+		Ext:         r.Ext,
+	}
+	if len(r.Seat) != 0 {
+		if r.GetWSeat() == 1 {
+			req.WSeat = r.Seat
+		} else {
+			req.BSeat = r.Seat
+		}
+	}
+	c.dropIf(r.Package != 0, "package")
+
+	var restr *adcom1.Restrictions
+	if r.Context != nil {
+		restr = r.Context.Restrictions
+	}
+	for i := range r.Item {
+		imp, err := c.toImpression(req, &r.Item[i], restr, openrtb.PathIndex("item", i))
+		if err != nil {
+			return nil, err
+		}
+		req.Imp = append(req.Imp, *imp)
+	}
+
+	if ctx := r.Context; ctx != nil {
+		if s := ctx.Site; s != nil {
+			req.Site = &openrtb.Site{
+				Inventory: c.toInventory(&s.DistributionChannel, "context.site"),
+				Page:      s.Page,
+				Ref:       s.Ref,
+				Search:    s.Search,
+				Mobile:    s.Mobile,
+			}
+			c.dropIf(s.AMP != 0, "context.site.amp")
+		}
+		if a := ctx.App; a != nil {
+			req.App = &openrtb.App{
+				Inventory: c.toInventory(&a.DistributionChannel, "context.app"),
+				Bundle:    a.Bundle,
+				StoreURL:  a.StoreURL,
+				Ver:       a.Ver,
+				Paid:      a.Paid,
+			}
+			c.dropIf(a.StoreID != "", "context.app.storeid")
+		}
+		if ctx.Device != nil {
+			dev, err := c.toDevice(ctx.Device, "context.device")
+			if err != nil {
+				return nil, err
+			}
+			req.Device = dev
+		}
+		if ctx.User != nil {
+			user, err := c.toUser(ctx.User, "context.user")
+			if err != nil {
+				return nil, err
+			}
+			req.User = user
+		}
+		if ctx.Regs != nil {
+			regs, err := c.toRegs(ctx.Regs, "context.regs")
+			if err != nil {
+				return nil, err
+			}
+			req.Regs = regs
+		}
+		if restr != nil {
+			req.Bcat = restr.BCat
+			req.CatTax = restr.CatTax
+			req.BAdv = restr.BAdv
+			req.BApp = restr.BApp
+			c.dropIf(len(restr.Ext) != 0, "context.restrictions.ext")
+		}
+	}
+	if r.CData != "" {
+		if req.User == nil {
+			req.User = new(openrtb.User)
+		}
+		req.User.CustomData = r.CData
+	}
+
+	if r.Source != nil {
+		src, err := c.toSource(r.Source, "source")
+		if err != nil {
+			return nil, err
+		}
+		req.Source = src
+	}
+	return req, nil
+}
+
+func (c *converter) toImpression(req *openrtb.BidRequest, item *Item, restr *adcom1.Restrictions, path string) (*openrtb.Impression, error) {
+	imp := &openrtb.Impression{
+		ID:               item.ID,
+		BidFloor:         item.Flr,
+		BidFloorCurrency: item.FlrCur,
+		Exp:              item.Exp,
+		DT:               item.DT,
+		Ext:              item.Ext,
+	}
+	if item.Qty != 0 {
+		imp.Qty = &openrtb.Qty{Multiplier: float64(item.Qty)}
+	}
+	c.dropIf(item.Seq != 0, openrtb.PathKey(path, "seq"))
+	c.dropIf(item.Dlvy != 0, openrtb.PathKey(path, "dlvy"))
+	c.dropIf(len(item.Metric) != 0, openrtb.PathKey(path, "metric"))
+
+	if item.Private != 0 || len(item.Deal) != 0 {
+		imp.Pmp = &openrtb.Pmp{Private: item.Private}
+		for i, d := range item.Deal {
+			imp.Pmp.Deals = append(imp.Pmp.Deals, openrtb.Deal{
+				ID:               d.ID,
+				BidFloor:         d.Flr,
+				BidFloorCurrency: d.FlrCur,
+				AuctionType:      d.AT,
+				WSeat:            d.WSeat,
+				WAdvDomain:       d.WADomain,
+				Ext:              d.Ext,
+			})
+			c.dropIf(d.Qty != 0, openrtb.PathKey(openrtb.PathIndex(openrtb.PathKey(path, "deal"), i), "qty"))
+		}
+	}
+
+	var battr []openrtb.CreativeAttribute
+	if restr != nil {
+		battr = restr.BAttr
+	}
+
+	if item.Spec == nil || item.Spec.Placement == nil {
+		return imp, nil
+	}
+	plc := item.Spec.Placement
+	path = openrtb.PathKey(openrtb.PathKey(path, "spec"), "placement")
+
+	imp.TagID = plc.TagID
+	imp.SSAI = plc.SSAI
+	imp.DisplayManager = plc.SDK
+	imp.DisplayManagerVer = plc.SDKVer
+	imp.Rwdd = plc.Reward
+	imp.Secure = openrtb.NumberOrString(plc.Secure)
+	if len(req.WLang) == 0 {
+		req.WLang = plc.WLang
+	}
+	if len(req.WLangB) == 0 {
+		req.WLangB = plc.WLangB
+	}
+	c.dropIf(plc.AdmX != 0, openrtb.PathKey(path, "admx"))
+	c.dropIf(plc.CURLX != 0, openrtb.PathKey(path, "curlx"))
+	c.dropIf(len(plc.Ext) != 0, openrtb.PathKey(path, "ext"))
+
+	if d := plc.Display; d != nil {
+		dpath := openrtb.PathKey(path, "display")
+		imp.Instl = d.Instl
+		imp.IFrameBuster = d.IfrBust
+		if d.NativeFmt != nil {
+			n, err := c.toNative(d, dpath)
+			if err != nil {
+				return nil, err
+			}
+			n.BAttr = battr
+			imp.Native = n
+		}
+		if d.NativeFmt == nil || len(d.DisplayFmt) != 0 || d.W != 0 || d.H != 0 {
+			imp.Banner = c.toBanner(d, dpath)
+			imp.Banner.BAttr = battr
+		} else {
+			c.dropIf(d.PType != 0 && d.PType != adcom1.DisplayPlacementInFeed, openrtb.PathKey(dpath, "ptype"))
+		}
+	}
+	if plc.Video != nil {
+		imp.Video = c.toVideo(plc.Video, openrtb.PathKey(path, "video"))
+		imp.Video.BAttr = battr
+	}
+	if plc.Audio != nil {
+		imp.Audio = c.toAudio(plc.Audio, openrtb.PathKey(path, "audio"))
+		imp.Audio.BAttr = battr
+	}
+	return imp, nil
+}
+
+func (c *converter) toBanner(d *adcom1.DisplayPlacement, path string) *openrtb.Banner {
+	b := &openrtb.Banner{
+		Pos:      d.Pos,
+		TopFrame: d.TopFrame,
+		Mimes:    d.MIME,
+		Api:      d.API,
+		W:        d.W,
+		H:        d.H,
+		Ext:      d.Ext,
+	}
+	for i, f := range d.DisplayFmt {
+		if len(b.ExpDir) == 0 {
+			b.ExpDir = f.ExpDir
+		}
+		if f.W == 0 && f.H == 0 {
+			continue
+		}
+		b.Format = append(b.Format, openrtb.Format{W: f.W, H: f.H, Ext: f.Ext})
+		c.dropIf(f.WRatio != 0 || f.HRatio != 0, openrtb.PathIndex(openrtb.PathKey(path, "displayfmt"), i))
+	}
+	if len(b.Format) == 1 && b.Format[0].W == b.W && b.Format[0].H == b.H && len(b.Format[0].Ext) == 0 {
+		b.Format = nil
+	}
+
+	c.dropIf(d.ClkType != 0, openrtb.PathKey(path, "clktype"))
+	c.dropIf(d.AmpRen != 0, openrtb.PathKey(path, "ampren"))
+	c.dropIf(d.PType != 0 && d.NativeFmt == nil, openrtb.PathKey(path, "ptype"))
+	c.dropIf(len(d.CType) != 0, openrtb.PathKey(path, "ctype"))
+	c.dropIf(d.Unit != 0, openrtb.PathKey(path, "unit"))
+	c.dropIf(d.Priv != 0, openrtb.PathKey(path, "priv"))
+	c.dropIf(len(d.Event) != 0, openrtb.PathKey(path, "event"))
+	return b
+}
+
+func (c *converter) toNative(d *adcom1.DisplayPlacement, path string) (*openrtb.Native, error) {
+	nf := d.NativeFmt
+	nreq := &request.Request{
+		Ver:              "1.1",
+		ContextSubTypeID: request.ContextSubTypeID(d.Context),
+		Ext:              nf.Ext,
+	}
+	if d.Context < 10 {
+		nreq.ContextTypeID = request.ContextTypeID(d.Context)
+		nreq.ContextSubTypeID = 0
+	} else {
+		nreq.ContextTypeID = request.ContextTypeID(d.Context / 10)
+	}
+	if d.PType == adcom1.DisplayPlacementInFeed {
+		nreq.PlacementTypeID = request.PlacementTypeInFeed
+	}
+	for i, af := range nf.Asset {
+		a := request.Asset{ID: af.ID, Required: af.Req, Ext: af.Ext}
+		switch {
+		case af.Title != nil:
+			a.Title = &request.Title{Length: af.Title.Len, Ext: af.Title.Ext}
+		case af.Img != nil:
+			a.Image = &request.Image{
+				TypeID:    request.ImageTypeID(af.Img.Type),
+				Mimes:     af.Img.MIME,
+				Width:     af.Img.W,
+				Height:    af.Img.H,
+				WidthMin:  af.Img.WMin,
+				HeightMin: af.Img.HMin,
+				Ext:       af.Img.Ext,
+			}
+			c.dropIf(af.Img.WRatio != 0 || af.Img.HRatio != 0, openrtb.PathKey(openrtb.PathIndex(openrtb.PathKey(openrtb.PathKey(path, "nativefmt"), "asset"), i), "img"))
+		case af.Video != nil:
+			vpath := openrtb.PathKey(openrtb.PathIndex(openrtb.PathKey(openrtb.PathKey(path, "nativefmt"), "asset"), i), "video")
+			a.Video = request.FromVideo(c.toVideo(af.Video, vpath))
+			c.dropIf(af.Video.MaxExt != 0, openrtb.PathKey(vpath, "maxext"))
+			c.dropIf(af.Video.MaxSeq != 0, openrtb.PathKey(vpath, "maxseq"))
+			c.dropIf(len(af.Video.CompType) != 0, openrtb.PathKey(vpath, "comptype"))
+			c.dropIf(len(af.Video.Comp) != 0, openrtb.PathKey(vpath, "comp"))
+		case af.Data != nil:
+			a.Data = &request.Data{TypeID: request.DataTypeID(af.Data.Type), Length: af.Data.Len, Ext: af.Data.Ext}
+		}
+		nreq.Assets = append(nreq.Assets, a)
+	}
+
+	n := &openrtb.Native{Ver: nreq.Ver, API: d.API}
+	if err := n.EncodeRequest(nreq); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (c *converter) toVideo(vp *adcom1.VideoPlacement, path string) *openrtb.Video {
+	v := &openrtb.Video{
+		Placement:      vp.PType,
+		Pos:            vp.Pos,
+		StartDelay:     vp.Delay,
+		Skip:           vp.Skip,
+		SkipMin:        vp.SkipMin,
+		SkipAfter:      vp.SkipAfter,
+		PlaybackMethod: vp.PlayMethod,
+		Mimes:          vp.MIME,
+		Api:            vp.API,
+		Protocols:      vp.CType,
+		W:              vp.W,
+		H:              vp.H,
+		MinDuration:    vp.MinDur,
+		MaxDuration:    vp.MaxDur,
+		MaxExtended:    vp.MaxExt,
+		MinBitrate:     vp.MinBitR,
+		MaxBitrate:     vp.MaxBitR,
+		Delivery:       vp.Delivery,
+		MaxSeq:         vp.MaxSeq,
+		Linearity:      vp.Linear,
+		BoxingAllowed:  vp.Boxing,
+		CompanionType:  vp.CompType,
+		Ext:            vp.Ext,
+	}
+	v.CompanionAd = c.toCompanions(vp.Comp, openrtb.PathKey(path, "comp"))
+
+	c.dropIf(vp.PlayEnd != 0, openrtb.PathKey(path, "playend"))
+	c.dropIf(vp.ClkType != 0, openrtb.PathKey(path, "clktype"))
+	c.dropIf(vp.Unit != 0, openrtb.PathKey(path, "unit"))
+	return v
+}
+
+func (c *converter) toAudio(ap *adcom1.AudioPlacement, path string) *openrtb.Audio {
+	a := &openrtb.Audio{
+		StartDelay:    ap.Delay,
+		Feed:          ap.Feed,
+		NVol:          ap.NVol,
+		Mimes:         ap.MIME,
+		API:           ap.API,
+		Protocols:     ap.CType,
+		MinDuration:   ap.MinDur,
+		MaxDuration:   ap.MaxDur,
+		MaxExtended:   ap.MaxExt,
+		MinBitrate:    ap.MinBitR,
+		MaxBitrate:    ap.MaxBitR,
+		Delivery:      ap.Delivery,
+		MaxSequence:   ap.MaxSeq,
+		CompanionType: ap.CompType,
+		Ext:           ap.Ext,
+	}
+	a.CompanionAd = c.toCompanions(ap.Comp, openrtb.PathKey(path, "comp"))
+
+	c.dropIf(ap.Skip != 0, openrtb.PathKey(path, "skip"))
+	c.dropIf(ap.SkipMin != 0, openrtb.PathKey(path, "skipmin"))
+	c.dropIf(ap.SkipAfter != 0, openrtb.PathKey(path, "skipafter"))
+	c.dropIf(len(ap.PlayMethod) != 0, openrtb.PathKey(path, "playmethod"))
+	c.dropIf(ap.PlayEnd != 0, openrtb.PathKey(path, "playend"))
+	return a
+}
+
+func (c *converter) toCompanions(comps []adcom1.Companion, path string) []openrtb.Banner {
+	var banners []openrtb.Banner
+	for i, comp := range comps {
+		cpath := openrtb.PathIndex(path, i)
+		b := &openrtb.Banner{}
+		if comp.Display != nil {
+			b = c.toBanner(comp.Display, openrtb.PathKey(cpath, "display"))
+		}
+		b.ID = comp.ID
+		c.dropIf(comp.VCM != 0, openrtb.PathKey(cpath, "vcm"))
+		banners = append(banners, *b)
+	}
+	return banners
+}
+
+func (c *converter) toInventory(dc *adcom1.DistributionChannel, path string) openrtb.Inventory {
+	inv := openrtb.Inventory{
+		ID:            dc.ID,
+		Name:          dc.Name,
+		Domain:        dc.Domain,
+		Cat:           dc.Cat,
+		SectionCat:    dc.SectCat,
+		PageCat:       dc.PageCat,
+		PrivacyPolicy: dc.PrivPolicy,
+		Keywords:      dc.Keywords,
+		Ext:           dc.Ext,
+	}
+	if p := dc.Pub; p != nil {
+		inv.Publisher = &openrtb.Publisher{ID: p.ID, Name: p.Name, Domain: p.Domain, Cat: p.Cat, Ext: p.Ext}
+		c.dropIf(p.CatTax != 0, openrtb.PathKey(openrtb.PathKey(path, "pub"), "cattax"))
+	}
+	if dc.Content != nil {
+		inv.Content = c.toContent(dc.Content, openrtb.PathKey(path, "content"))
+	}
+	c.dropIf(dc.CatTax != 0, openrtb.PathKey(path, "cattax"))
+	return inv
+}
+
+func (c *converter) toContent(ct *adcom1.Content, path string) *openrtb.Content {
+	res := &openrtb.Content{
+		ID:                 ct.ID,
+		Episode:            ct.Episode,
+		Title:              ct.Title,
+		Series:             ct.Series,
+		Season:             ct.Season,
+		Artist:             ct.Artist,
+		Genre:              ct.Genre,
+		Album:              ct.Album,
+		ISRC:               ct.ISRC,
+		URL:                ct.URL,
+		Cat:                ct.Cat,
+		ProdQuality:        ct.ProdQ,
+		Context:            ct.Context,
+		ContentRating:      ct.Rating,
+		UserRating:         ct.URating,
+		QAGMediaRating:     ct.MRating,
+		Keywords:           ct.Keywords,
+		LiveStream:         ct.Live,
+		SourceRelationship: ct.SrcRel,
+		Len:                ct.Len,
+		Language:           ct.Lang,
+		Embeddable:         ct.Embed,
+		Data:               toData(ct.Data),
+		Ext:                ct.Ext,
+	}
+	if p := ct.Producer; p != nil {
+		res.Producer = &openrtb.Producer{ID: p.ID, Name: p.Name, Domain: p.Domain, Cat: p.Cat, Ext: p.Ext}
+		c.dropIf(p.CatTax != 0, openrtb.PathKey(openrtb.PathKey(path, "producer"), "cattax"))
+	}
+	c.dropIf(ct.CatTax != 0, openrtb.PathKey(path, "cattax"))
+	return res
+}
+
+func toData(data []adcom1.Data) []openrtb.Data {
+	var res []openrtb.Data
+	for _, d := range data {
+		od := openrtb.Data{ID: d.ID, Name: d.Name, Ext: d.Ext}
+		for _, s := range d.Segment {
+			od.Segment = append(od.Segment, openrtb.Segment{ID: s.ID, Name: s.Name, Value: s.Value, Ext: s.Ext})
+		}
+		res = append(res, od)
+	}
+	return res
+}
+
+func toGeo(g *adcom1.Geo) *openrtb.Geo {
+	if g == nil {
+		return nil
+	}
+	return &openrtb.Geo{
+		Type:      g.Type,
+		Lat:       g.Lat,
+		Lon:       g.Lon,
+		Accuracy:  g.Accur,
+		LastFix:   g.LastFix,
+		IPService: g.IPServ,
+		Country:   g.Country,
+		Region:    g.Region,
+		Metro:     g.Metro,
+		City:      g.City,
+		Zip:       g.Zip,
+		UTCOffset: g.UTCOffset,
+		Ext:       g.Ext,
+	}
+}
+
+func (c *converter) toDevice(dev *adcom1.Device, path string) (*openrtb.Device, error) {
+	d := &openrtb.Device{
+		DeviceType: dev.Type,
+		UA:         dev.UA,
+		IFA:        dev.IFA,
+		DNT:        dev.DNT,
+		LMT:        dev.LMT,
+		Make:       dev.Make,
+		Model:      dev.Model,
+		OS:         dev.OS.String(),
+		OSVer:      dev.OSV,
+		HwVer:      dev.HWV,
+		H:          dev.H,
+		W:          dev.W,
+		PPI:        dev.PPI,
+		PxRatio:    dev.PxRatio,
+		JS:         dev.JS,
+		Language:   dev.Lang,
+		IP:         dev.IP,
+		IPv6:       dev.IPv6,
+		Carrier:    dev.Carrier,
+		MCCMNC:     dev.MCCMNC,
+		ConnType:   dev.ConType,
+		GeoFetch:   dev.GeoFetch,
+		Geo:        toGeo(dev.Geo),
+	}
+	c.dropIf(dev.OS != 0 && d.OS == "", openrtb.PathKey(path, "os"))
+	c.dropIf(dev.XFF != "", openrtb.PathKey(path, "xff"))
+	c.dropIf(dev.IPTr != 0, openrtb.PathKey(path, "iptr"))
+	c.dropIf(dev.MCCMNCSim != "", openrtb.PathKey(path, "mccmncsim"))
+
+	var sua *openrtb.UserAgent
+	ext, err := c.extTake(dev.Ext, "sua", &sua, path)
+	d.Ext, d.SUA = ext, sua
+	return d, err
+}
+
+func (c *converter) toUser(user *adcom1.User, path string) (*openrtb.User, error) {
+	u := &openrtb.User{
+		ID:       user.ID,
+		BuyerUID: user.BuyerUID,
+		YOB:      user.YOB,
+		Gender:   user.Gender,
+		Keywords: user.Keywords,
+		Consent:  user.Consent,
+		Geo:      toGeo(user.Geo),
+		Data:     toData(user.Data),
+	}
+
+	var err error
+	u.Ext, err = c.extTake(user.Ext, "eids", &u.EIDs, path)
+	return u, err
+}
+
+func (c *converter) toRegs(regs *adcom1.Regs, path string) (*openrtb.Regulations, error) {
+	r := &openrtb.Regulations{Coppa: regs.COPPA, GDPR: regs.GDPR, Ext: regs.Ext}
+
+	var err error
+	if r.Ext, err = c.extTake(r.Ext, "us_privacy", &r.USPrivacy, path); err != nil {
+		return nil, err
+	}
+	if r.Ext, err = c.extTake(r.Ext, "gpp", &r.GPP, path); err != nil {
+		return nil, err
+	}
+	if r.Ext, err = c.extTake(r.Ext, "gpp_sid", &r.GPPSID, path); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *converter) toSource(src *Source, path string) (*openrtb.Source, error) {
+	s := &openrtb.Source{TransactionID: src.TID, PaymentChain: src.PChain}
+	c.dropIf(src.TS != 0, openrtb.PathKey(path, "ts"))
+	c.dropIf(src.DS != "", openrtb.PathKey(path, "ds"))
+	c.dropIf(src.DSMap != "", openrtb.PathKey(path, "dsmap"))
+	c.dropIf(src.Cert != "", openrtb.PathKey(path, "cert"))
+
+	var err error
+	s.Ext, err = c.extTake(src.Ext, "schain", &s.SChain, path)
+	return s, err
+}
+
+// --------------------------------------------------------------------
+
+func (c *converter) fromBidResponse(res *openrtb.BidResponse) *Response {
+	r := &Response{
+		ID:    res.ID,
+		BidID: res.BidID,
+		NBR:   res.NBR,
+		Cur:   res.Currency,
+		CData: res.CustomData,
+		Ext:   res.Ext,
+	}
+	for i, sb := range res.SeatBid {
+		path := openrtb.PathIndex("seatbid", i)
+		seat := Seatbid{Seat: sb.Seat, Package: sb.Group, Ext: sb.Ext}
+		for j := range sb.Bid {
+			seat.Bid = append(seat.Bid, *c.fromBid(&sb.Bid[j], openrtb.PathIndex(openrtb.PathKey(path, "bid"), j)))
+		}
+		r.Seatbid = append(r.Seatbid, seat)
+	}
+	return r
+}
+
+func (c *converter) fromBid(bid *openrtb.Bid, path string) *Bid {
+	b := &Bid{
+		ID:     bid.ID,
+		Item:   bid.ImpID,
+		Deal:   bid.DealID,
+		Price:  bid.Price,
+		Tactic: bid.Tactic,
+		PURL:   bid.NURL,
+		BURL:   bid.BURL,
+		LURL:   bid.LURL,
+		Exp:    bid.Exp,
+		MID:    bid.AdID,
+		CID:    string(bid.CampaignID),
+		Ext:    bid.Ext,
+	}
+
+	ad := &adcom1.Ad{
+		ID:      bid.CreativeID,
+		ADomain: bid.AdvDomain,
+		IURL:    bid.IURL,
+		Cat:     bid.Cat,
+		Lang:    bid.Language,
+		Attr:    bid.Attr,
+		MRating: bid.QAGMediaRating,
+	}
+	if bid.Bundle != "" {
+		ad.Bundle = []string{bid.Bundle}
+	}
+	apis := bid.APIs
+	if len(apis) == 0 && bid.API != 0 {
+		apis = []openrtb.APIFramework{bid.API}
+	}
+
+	mtype := bidMarkupType(bid)
+	switch mtype {
+	case openrtb.MarkupVideo:
+		ad.Video = &adcom1.Video{API: apis, CType: bid.Protocol, Dur: bid.Dur, AdM: bid.AdMarkup}
+	case openrtb.MarkupAudio:
+		ad.Audio = &adcom1.Audio{API: apis, CType: bid.Protocol, Dur: bid.Dur, AdM: bid.AdMarkup}
+	default:
+		ad.Display = &adcom1.Display{
+			API:    apis,
+			W:      bid.W,
+			H:      bid.H,
+			WRatio: bid.WRatio,
+			HRatio: bid.HRatio,
+			AdM:    bid.AdMarkup,
+		}
+		if mtype == openrtb.MarkupNative {
+			ad.Display.CType = adcom1.DisplayCreativeNative
+		}
+		c.dropIf(bid.Protocol != 0, openrtb.PathKey(path, "protocol"))
+		c.dropIf(bid.Dur != 0, openrtb.PathKey(path, "dur"))
+	}
+	b.Media = &Media{Ad: ad}
+
+	c.dropIf(bid.SlotInPod != 0, openrtb.PathKey(path, "slotinpod"))
+	return b
+}
+
+// bidMarkupType returns the markup type of a 2.x bid. Without an explicit
+// mtype, it is inferred from the markup, then from protocol and duration.
+func bidMarkupType(bid *openrtb.Bid) openrtb.MarkupType {
+	if bid.MType != 0 {
+		return bid.MType
+	}
+
+	adm := strings.TrimSpace(bid.AdMarkup)
+	switch {
+	case strings.Contains(adm, "<DAAST"):
+		return openrtb.MarkupAudio
+	case strings.Contains(adm, "<VAST"):
+		return openrtb.MarkupVideo
+	case strings.HasPrefix(adm, "{") || strings.HasPrefix(adm, `"`):
+		return openrtb.MarkupNative
+	}
+
+	switch {
+	case bid.Protocol == openrtb.AudioProtocolDAAST1 || bid.Protocol == openrtb.AudioProtocolDAAST1Wrapper:
+		return openrtb.MarkupAudio
+	case bid.Protocol != 0 || bid.Dur != 0:
+		return openrtb.MarkupVideo
+	}
+	return openrtb.MarkupBanner
+}
+
+func (c *converter) toBidResponse(r *Response) *openrtb.BidResponse {
+	res := &openrtb.BidResponse{
+		ID:         r.ID,
+		BidID:      r.BidID,
+		NBR:        r.NBR,
+		Currency:   r.Cur,
+		CustomData: r.CData,
+		Ext:        r.Ext,
+	}
+	for i, sb := range r.Seatbid {
+		path := openrtb.PathIndex("seatbid", i)
+		seat := openrtb.SeatBid{Seat: sb.Seat, Group: sb.Package, Ext: sb.Ext}
+		for j := range sb.Bid {
+			seat.Bid = append(seat.Bid, *c.toBid(&sb.Bid[j], openrtb.PathIndex(openrtb.PathKey(path, "bid"), j)))
+		}
+		res.SeatBid = append(res.SeatBid, seat)
+	}
+	return res
+}
+
+func (c *converter) toBid(b *Bid, path string) *openrtb.Bid {
+	bid := &openrtb.Bid{
+		ID:         b.ID,
+		ImpID:      b.Item,
+		DealID:     b.Deal,
+		Price:      b.Price,
+		Tactic:     b.Tactic,
+		NURL:       b.PURL,
+		BURL:       b.BURL,
+		LURL:       b.LURL,
+		Exp:        b.Exp,
+		AdID:       b.MID,
+		CampaignID: openrtb.StringOrNumber(b.CID),
+		Ext:        b.Ext,
+	}
+	c.dropIf(len(b.Macro) != 0, openrtb.PathKey(path, "macro"))
+
+	if b.Media == nil || b.Media.Ad == nil {
+		return bid
+	}
+	ad := b.Media.Ad
+	path = openrtb.PathKey(openrtb.PathKey(path, "media"), "ad")
+
+	bid.CreativeID = ad.ID
+	bid.AdvDomain = ad.ADomain
+	bid.IURL = ad.IURL
+	bid.Cat = ad.Cat
+	bid.Language = ad.Lang
+	bid.Attr = ad.Attr
+	bid.QAGMediaRating = ad.MRating
+	if len(ad.Bundle) != 0 {
+		bid.Bundle = ad.Bundle[0]
+		c.dropIf(len(ad.Bundle) > 1, openrtb.PathIndex(openrtb.PathKey(path, "bundle"), 1))
+	}
+	c.dropIf(ad.CatTax != 0, openrtb.PathKey(path, "cattax"))
+	c.dropIf(ad.Secure != 0, openrtb.PathKey(path, "secure"))
+	c.dropIf(ad.Init != 0, openrtb.PathKey(path, "init"))
+	c.dropIf(ad.LastMod != 0, openrtb.PathKey(path, "lastmod"))
+	c.dropIf(ad.Audit != nil, openrtb.PathKey(path, "audit"))
+	c.dropIf(len(ad.Ext) != 0, openrtb.PathKey(path, "ext"))
+
+	switch {
+	case ad.Display != nil:
+		d := ad.Display
+		dpath := openrtb.PathKey(path, "display")
+		bid.MType = openrtb.MarkupBanner
+		if d.CType == adcom1.DisplayCreativeNative {
+			bid.MType = openrtb.MarkupNative
+		}
+		bid.APIs = d.API
+		bid.W, bid.H = d.W, d.H
+		bid.WRatio, bid.HRatio = d.WRatio, d.HRatio
+		bid.AdMarkup = d.AdM
+		c.dropIf(d.MIME != "", openrtb.PathKey(dpath, "mime"))
+		c.dropIf(d.Priv != "", openrtb.PathKey(dpath, "priv"))
+		c.dropIf(d.CURL != "", openrtb.PathKey(dpath, "curl"))
+		c.dropIf(d.Banner != nil, openrtb.PathKey(dpath, "banner"))
+		c.dropIf(d.Native != nil, openrtb.PathKey(dpath, "native"))
+		c.dropIf(len(d.Event) != 0, openrtb.PathKey(dpath, "event"))
+		c.dropIf(len(d.Ext) != 0, openrtb.PathKey(dpath, "ext"))
+	case ad.Video != nil:
+		v := ad.Video
+		bid.MType = openrtb.MarkupVideo
+		bid.APIs, bid.Protocol, bid.Dur, bid.AdMarkup = v.API, v.CType, v.Dur, v.AdM
+		c.dropIf(len(v.MIME) != 0, openrtb.PathKey(openrtb.PathKey(path, "video"), "mime"))
+		c.dropIf(v.CURL != "", openrtb.PathKey(openrtb.PathKey(path, "video"), "curl"))
+		c.dropIf(len(v.Ext) != 0, openrtb.PathKey(openrtb.PathKey(path, "video"), "ext"))
+	case ad.Audio != nil:
+		a := ad.Audio
+		bid.MType = openrtb.MarkupAudio
+		bid.APIs, bid.Protocol, bid.Dur, bid.AdMarkup = a.API, a.CType, a.Dur, a.AdM
+		c.dropIf(len(a.MIME) != 0, openrtb.PathKey(openrtb.PathKey(path, "audio"), "mime"))
+		c.dropIf(a.CURL != "", openrtb.PathKey(openrtb.PathKey(path, "audio"), "curl"))
+		c.dropIf(len(a.Ext) != 0, openrtb.PathKey(openrtb.PathKey(path, "audio"), "ext"))
+	}
+	return bid
+}
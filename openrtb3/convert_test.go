@@ -0,0 +1,283 @@
+package openrtb3_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/bsm/openrtb"
+	"github.com/bsm/openrtb/adcom1"
+	"github.com/bsm/openrtb/openrtb3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FromBidRequest", func() {
+	var req *openrtb.BidRequest
+
+	BeforeEach(func() {
+		one := 1
+		battr := []openrtb.CreativeAttribute{openrtb.CreativeAttributeUserInitiated}
+		req = &openrtb.BidRequest{
+			ID:          "R",
+			AuctionType: 1,
+			TMax:        120,
+			Cur:         []string{"USD"},
+			WSeat:       []string{"S1"},
+			WLang:       []string{"en"},
+			Imp: []openrtb.Impression{
+				{
+					ID: "1", TagID: "T1", BidFloor: 1.5, BidFloorCurrency: "USD", Secure: 1, Instl: 1,
+					Banner: &openrtb.Banner{W: 300, H: 250, Format: []openrtb.Format{{W: 300, H: 250}, {W: 728, H: 90}}, Pos: openrtb.AdPosAboveFold, BAttr: battr},
+					Pmp:    &openrtb.Pmp{Private: 1, Deals: []openrtb.Deal{{ID: "D1", BidFloor: 2, WSeat: []string{"S1"}}}},
+				},
+				{
+					ID: "2",
+					Video: &openrtb.Video{
+						Mimes:       []string{"video/mp4"},
+						Protocols:   []openrtb.Protocol{openrtb.VideoProtoVAST3},
+						MinDuration: 5,
+						MaxDuration: 30,
+						Linearity:   openrtb.VideoLinearityLinear,
+						BAttr:       battr,
+						CompanionAd: []openrtb.Banner{{ID: "C1", W: 300, H: 250}},
+					},
+				},
+				{
+					ID: "3",
+					Native: &openrtb.Native{
+						Request: openrtb.Extension(`"{\"ver\":\"1.1\",\"context\":1,\"contextsubtype\":11,\"plcmttype\":1,\"assets\":[{\"id\":1,\"required\":1,\"title\":{\"len\":90}}]}"`),
+						Ver:     "1.1",
+						BAttr:   battr,
+					},
+				},
+			},
+			Site: &openrtb.Site{
+				Inventory: openrtb.Inventory{ID: "S", Domain: "example.com", Publisher: &openrtb.Publisher{ID: "P"}, Content: &openrtb.Content{Title: "T", Language: "en"}},
+				Page:      "http://example.com/page.html",
+			},
+			Device: &openrtb.Device{
+				UA:         "Mozilla/5.0",
+				IP:         "1.2.3.4",
+				OS:         "iOS",
+				DeviceType: openrtb.DeviceTypePhone,
+				Geo:        &openrtb.Geo{Country: "USA"},
+				SUA:        &openrtb.UserAgent{Browsers: []openrtb.BrandVersion{{Brand: "Chrome", Version: []string{"117"}}}, Source: openrtb.UASourceLowEntropy},
+			},
+			User: &openrtb.User{
+				ID:         "U",
+				BuyerUID:   "B",
+				CustomData: "cd",
+				EIDs:       []openrtb.EID{{Source: "x.com", UIDs: []openrtb.UID{{ID: "X", AType: openrtb.AgentTypeDevice}}}},
+			},
+			Regs:   &openrtb.Regulations{Coppa: 1, GDPR: &one, USPrivacy: "1YNN"},
+			BAdv:   []string{"blocked.com"},
+			Bcat:   []string{"IAB25"},
+			Source: &openrtb.Source{TransactionID: "T", SChain: &openrtb.SupplyChain{Complete: 1, Ver: "1.0", Nodes: []openrtb.SupplyChainNode{{ASI: "exchange.com", SID: "1", HP: 1}}}},
+		}
+	})
+
+	It("should convert requests", func() {
+		r, changes, err := openrtb3.FromBidRequest(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]openrtb.Change{
+			{Path: "device.sua", Kind: openrtb.ChangeMigrate},
+			{Path: "user.eids", Kind: openrtb.ChangeMigrate},
+			{Path: "regs.us_privacy", Kind: openrtb.ChangeMigrate},
+			{Path: "source.schain", Kind: openrtb.ChangeMigrate},
+		}))
+
+		Expect(r.AT).To(Equal(1))
+		Expect(r.Seat).To(Equal([]string{"S1"}))
+		Expect(r.GetWSeat()).To(Equal(1))
+		Expect(r.CData).To(Equal("cd"))
+		Expect(r.Item).To(HaveLen(3))
+		Expect(r.Item[0].Flr).To(Equal(1.5))
+		Expect(r.Item[0].Private).To(Equal(1))
+		Expect(r.Item[0].Deal).To(Equal([]openrtb3.Deal{{ID: "D1", Flr: 2, WSeat: []string{"S1"}}}))
+		Expect(r.Item[0].Spec.Placement.WLang).To(Equal([]string{"en"}))
+		Expect(r.Item[0].Spec.Placement.Display.Instl).To(Equal(1))
+		Expect(r.Item[0].Spec.Placement.Display.DisplayFmt).To(Equal([]adcom1.DisplayFormat{{W: 300, H: 250}, {W: 728, H: 90}}))
+		Expect(r.Item[1].Spec.Placement.Video.CType).To(Equal([]openrtb.Protocol{openrtb.VideoProtoVAST3}))
+		Expect(r.Item[1].Spec.Placement.Video.Comp).To(Equal([]adcom1.Companion{{ID: "C1", Display: &adcom1.DisplayPlacement{W: 300, H: 250}}}))
+		Expect(r.Item[2].Spec.Placement.Display).To(Equal(&adcom1.DisplayPlacement{
+			PType:     adcom1.DisplayPlacementInFeed,
+			Context:   adcom1.DisplayContextArticle,
+			NativeFmt: &adcom1.NativeFormat{Asset: []adcom1.AssetFormat{{ID: 1, Req: 1, Title: &adcom1.TitleAssetFormat{Len: 90}}}},
+		}))
+		Expect(r.Context.Site.Pub).To(Equal(&adcom1.Publisher{ID: "P"}))
+		Expect(r.Context.Device.OS).To(Equal(adcom1.OSiOS))
+		Expect(r.Context.Device.Ext).To(Equal(openrtb.Extension(`{"sua":{"browsers":[{"brand":"Chrome","version":["117"]}],"source":1}}`)))
+		Expect(r.Context.Regs.Ext).To(Equal(openrtb.Extension(`{"us_privacy":"1YNN"}`)))
+		Expect(r.Context.Restrictions).To(Equal(&adcom1.Restrictions{
+			BCat:  []string{"IAB25"},
+			BAdv:  []string{"blocked.com"},
+			BAttr: []openrtb.CreativeAttribute{openrtb.CreativeAttributeUserInitiated},
+		}))
+
+		back, changes, err := openrtb3.ToBidRequest(r)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]openrtb.Change{
+			{Path: "context.device.ext.sua", Kind: openrtb.ChangeMigrate},
+			{Path: "context.user.ext.eids", Kind: openrtb.ChangeMigrate},
+			{Path: "context.regs.ext.us_privacy", Kind: openrtb.ChangeMigrate},
+			{Path: "source.ext.schain", Kind: openrtb.ChangeMigrate},
+		}))
+		Expect(back).To(Equal(req))
+	})
+
+	It("should report dropped fields", func() {
+		req.AllImps = 1
+		req.Imp[0].Banner.BType = []openrtb.BannerType{openrtb.BannerTypeFrame}
+		req.Imp[1].Video.Sequence = 1
+		req.Imp[1].Video.PodID = "pod-1"
+		req.Device.OS = "Unknown OS"
+		req.Source.FinalSaleDecision = 1
+
+		_, changes, err := openrtb3.FromBidRequest(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]openrtb.Change{
+			{Path: "allimps", Kind: openrtb.ChangeDrop},
+			{Path: "imp[0].banner.btype", Kind: openrtb.ChangeDrop},
+			{Path: "imp[1].video.sequence", Kind: openrtb.ChangeDrop},
+			{Path: "imp[1].video.podid", Kind: openrtb.ChangeDrop},
+			{Path: "device.os", Kind: openrtb.ChangeDrop},
+			{Path: "device.sua", Kind: openrtb.ChangeMigrate},
+			{Path: "user.eids", Kind: openrtb.ChangeMigrate},
+			{Path: "regs.us_privacy", Kind: openrtb.ChangeMigrate},
+			{Path: "source.fd", Kind: openrtb.ChangeDrop},
+			{Path: "source.schain", Kind: openrtb.ChangeMigrate},
+		}))
+	})
+
+	It("should convert 3.0 requests", func() {
+		var body *openrtb3.Body
+		Expect(fixture("request", &body)).To(Succeed())
+
+		req, changes, err := openrtb3.ToBidRequest(body.OpenRTB.Request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]openrtb.Change{
+			{Path: "source.ts", Kind: openrtb.ChangeDrop},
+			{Path: "source.ds", Kind: openrtb.ChangeDrop},
+			{Path: "source.dsmap", Kind: openrtb.ChangeDrop},
+			{Path: "source.cert", Kind: openrtb.ChangeDrop},
+		}))
+		Expect(req.Imp).To(HaveLen(1))
+		Expect(req.Imp[0].Qty).To(Equal(&openrtb.Qty{Multiplier: 1}))
+		Expect(req.Imp[0].Banner).To(Equal(&openrtb.Banner{W: 300, H: 250}))
+		Expect(req.Device.OS).To(Equal("iOS"))
+		Expect(req.BAdv).To(Equal([]string{"blocked.com"}))
+		Expect(req.Validate()).To(Succeed())
+	})
+
+	It("should convert fixtures", func() {
+		for _, kind := range []string{"banner", "exp", "video", "native", "ctv"} {
+			var src *openrtb.BidRequest
+			Expect(parentFixture("breq."+kind, &src)).To(Succeed(), "for %s", kind)
+
+			r, _, err := openrtb3.FromBidRequest(src)
+			Expect(err).NotTo(HaveOccurred(), "for %s", kind)
+			Expect(r.Item).To(HaveLen(len(src.Imp)), "for %s", kind)
+
+			back, _, err := openrtb3.ToBidRequest(r)
+			Expect(err).NotTo(HaveOccurred(), "for %s", kind)
+			Expect(back.Imp).To(HaveLen(len(src.Imp)), "for %s", kind)
+		}
+	})
+})
+
+var _ = Describe("FromBidResponse", func() {
+	var res *openrtb.BidResponse
+
+	BeforeEach(func() {
+		res = &openrtb.BidResponse{
+			ID:         "R",
+			BidID:      "B",
+			Currency:   "USD",
+			CustomData: "cd",
+			SeatBid: []openrtb.SeatBid{{
+				Seat:  "S1",
+				Group: 1,
+				Bid: []openrtb.Bid{
+					{
+						ID: "1", ImpID: "1", Price: 1.5, AdID: "A1", NURL: "http://n", BURL: "http://b", DealID: "D1", CampaignID: "CMP",
+						AdMarkup: "<html/>", AdvDomain: []string{"ford.com"}, Bundle: "com.ford", CreativeID: "C1",
+						Attr: []openrtb.CreativeAttribute{openrtb.CreativeAttributeUserInitiated},
+						APIs: []openrtb.APIFramework{openrtb.APIFrameworkMRAID2}, W: 300, H: 250, MType: openrtb.MarkupBanner,
+					},
+					{ID: "2", ImpID: "2", Price: 2.5, CreativeID: "C2", AdMarkup: "<VAST/>", Protocol: openrtb.VideoProtoVAST3, Dur: 30, MType: openrtb.MarkupVideo},
+					{ID: "3", ImpID: "3", Price: 0.5, CreativeID: "C3", AdMarkup: `{"native":{}}`, MType: openrtb.MarkupNative},
+				},
+			}},
+		}
+	})
+
+	It("should convert responses", func() {
+		r, changes := openrtb3.FromBidResponse(res)
+		Expect(changes).To(BeEmpty())
+		Expect(r.CData).To(Equal("cd"))
+		Expect(r.Seatbid[0].Package).To(Equal(1))
+		Expect(r.Seatbid[0].Bid[0]).To(Equal(openrtb3.Bid{
+			ID: "1", Item: "1", Deal: "D1", Price: 1.5, PURL: "http://n", BURL: "http://b", MID: "A1", CID: "CMP",
+			Media: &openrtb3.Media{Ad: &adcom1.Ad{
+				ID:      "C1",
+				ADomain: []string{"ford.com"},
+				Bundle:  []string{"com.ford"},
+				Attr:    []openrtb.CreativeAttribute{openrtb.CreativeAttributeUserInitiated},
+				Display: &adcom1.Display{API: []openrtb.APIFramework{openrtb.APIFrameworkMRAID2}, W: 300, H: 250, AdM: "<html/>"},
+			}},
+		}))
+		Expect(r.Seatbid[0].Bid[1].Media.Ad.Video).To(Equal(&adcom1.Video{CType: openrtb.VideoProtoVAST3, Dur: 30, AdM: "<VAST/>"}))
+		Expect(r.Seatbid[0].Bid[2].Media.Ad.Display.CType).To(Equal(adcom1.DisplayCreativeNative))
+
+		back, changes := openrtb3.ToBidResponse(r)
+		Expect(changes).To(BeEmpty())
+		Expect(back).To(Equal(res))
+	})
+
+	It("should infer media types of bids without mtype", func() {
+		res.SeatBid[0].Bid = []openrtb.Bid{
+			{ID: "1", AdMarkup: "<?xml version=\"1.0\"?><VAST version=\"3.0\"/>"},
+			{ID: "2", AdMarkup: "<DAAST/>"},
+			{ID: "3", AdMarkup: `{"native":{}}`},
+			{ID: "4", NURL: "http://n", Protocol: openrtb.VideoProtoVAST3},
+			{ID: "5", NURL: "http://n", Dur: 15},
+			{ID: "6", AdMarkup: "<html/>", W: 300, H: 250},
+		}
+		r, changes := openrtb3.FromBidResponse(res)
+		Expect(changes).To(BeEmpty())
+		bids := r.Seatbid[0].Bid
+		Expect(bids[0].Media.Ad.Video).NotTo(BeNil())
+		Expect(bids[1].Media.Ad.Audio).NotTo(BeNil())
+		Expect(bids[2].Media.Ad.Display.CType).To(Equal(adcom1.DisplayCreativeNative))
+		Expect(bids[3].Media.Ad.Video).To(Equal(&adcom1.Video{CType: openrtb.VideoProtoVAST3}))
+		Expect(bids[4].Media.Ad.Video).To(Equal(&adcom1.Video{Dur: 15}))
+		Expect(bids[5].Media.Ad.Display).To(Equal(&adcom1.Display{W: 300, H: 250, AdM: "<html/>"}))
+	})
+
+	It("should report dropped fields", func() {
+		res.SeatBid[0].Bid[0].SlotInPod = openrtb.SlotInPodFirst
+		_, changes := openrtb3.FromBidResponse(res)
+		Expect(changes).To(Equal([]openrtb.Change{
+			{Path: "seatbid[0].bid[0].slotinpod", Kind: openrtb.ChangeDrop},
+		}))
+
+		var body *openrtb3.Body
+		Expect(fixture("response", &body)).To(Succeed())
+		_, changes = openrtb3.ToBidResponse(body.OpenRTB.Response)
+		Expect(changes).To(Equal([]openrtb.Change{
+			{Path: "seatbid[0].bid[0].macro", Kind: openrtb.ChangeDrop},
+			{Path: "seatbid[0].bid[0].media.ad.secure", Kind: openrtb.ChangeDrop},
+		}))
+	})
+})
+
+func parentFixture(fname string, v interface{}) error {
+	f, err := os.Open(filepath.Join("..", "testdata", fname+".json"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return json.NewDecoder(f).Decode(v)
+}
@@ -0,0 +1,57 @@
+/*
+Package openrtb3 implements the OpenRTB 3.0 transaction layer. Domain objects
+such as placements, contexts and ads are provided by the adcom1 package.
+
+Requests and responses can be converted from and to their OpenRTB 2.x
+counterparts using FromBidRequest, ToBidRequest, FromBidResponse and
+ToBidResponse.
+*/
+package openrtb3
+
+//go:generate ffjson $GOFILE
+
+import "github.com/bsm/openrtb"
+
+// Version of the OpenRTB transaction layer implemented by this package
+const Version = "3.0"
+
+// Domain specification implemented by the adcom1 package
+const (
+	DomainSpecAdCOM    = "adcom"
+	DomainSpecAdCOMVer = "1.0"
+)
+
+// Body is the top-level envelope of every OpenRTB 3.0 payload.
+type Body struct {
+	OpenRTB OpenRTB `json:"openrtb"`
+}
+
+func (b *Body) Reset() {
+	b.OpenRTB.Reset()
+}
+
+// OpenRTB is the root object of every OpenRTB 3.0 payload. It carries either
+// a request or a response.
+type OpenRTB struct {
+	Ver        string            `json:"ver,omitempty"`        // Version of the layer-1 specification (e.g. "3.0")
+	DomainSpec string            `json:"domainspec,omitempty"` // Identifier of the layer-4 domain model (e.g. "adcom")
+	DomainVer  string            `json:"domainver,omitempty"`  // Specification version of the layer-4 domain model (e.g. "1.0")
+	Request    *Request          `json:"request,omitempty"`    // Bid request container
+	Response   *Response         `json:"response,omitempty"`   // Bid response container
+	Ext        openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (o *OpenRTB) Reset() {
+	o.Ver = ""
+	o.DomainSpec = ""
+	o.DomainVer = ""
+	if o.Request != nil {
+		FreeRequest(o.Request)
+		o.Request = nil
+	}
+	if o.Response != nil {
+		FreeResponse(o.Response)
+		o.Response = nil
+	}
+	o.Ext = nil
+}
@@ -0,0 +1,118 @@
+package openrtb3_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bsm/openrtb"
+	"github.com/bsm/openrtb/adcom1"
+	"github.com/bsm/openrtb/openrtb3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request", func() {
+	var subject *openrtb3.Body
+
+	BeforeEach(func() {
+		Expect(fixture("request", &subject)).To(Succeed())
+	})
+
+	It("should parse correctly", func() {
+		Expect(subject.OpenRTB.Ver).To(Equal(openrtb3.Version))
+		Expect(subject.OpenRTB.DomainSpec).To(Equal(openrtb3.DomainSpecAdCOM))
+		Expect(subject.OpenRTB.Response).To(BeNil())
+
+		req := subject.OpenRTB.Request
+		Expect(req.ID).To(Equal("0123456789ABCDEF"))
+		Expect(req.GetWSeat()).To(Equal(1))
+		Expect(req.Source.Cert).To(Equal("ads-cert.1.txt"))
+		Expect(req.Item).To(Equal([]openrtb3.Item{{
+			ID:   "1",
+			Qty:  1,
+			Deal: []openrtb3.Deal{{ID: "1234", Flr: 1.5}},
+			Spec: &openrtb3.Spec{Placement: &adcom1.Placement{TagID: "abc", Display: &adcom1.DisplayPlacement{W: 300, H: 250}}},
+		}}))
+		Expect(req.Context.Site.Domain).To(Equal("example.com"))
+		Expect(req.Context.Device.Type).To(Equal(openrtb.DeviceTypePhone))
+		Expect(req.Context.Device.OS).To(Equal(adcom1.OSiOS))
+		Expect(req.Context.Restrictions.BAdv).To(Equal([]string{"blocked.com"}))
+	})
+
+	It("should reset and reuse", func() {
+		req := openrtb3.NewRequest()
+		*req = *subject.OpenRTB.Request
+		openrtb3.FreeRequest(req)
+		Expect(req.ID).To(BeEmpty())
+		Expect(req.Item).To(BeEmpty())
+		Expect(req.Source).To(BeNil())
+		Expect(req.Context).To(BeNil())
+		Expect(json.Marshal(req)).To(MatchJSON(`{"id":"","item":[]}`))
+	})
+})
+
+var _ = Describe("Response", func() {
+	var subject *openrtb3.Body
+
+	BeforeEach(func() {
+		Expect(fixture("response", &subject)).To(Succeed())
+	})
+
+	It("should parse correctly", func() {
+		res := subject.OpenRTB.Response
+		Expect(res.ID).To(Equal("0123456789ABCDEF"))
+		Expect(res.Seatbid).To(HaveLen(1))
+		Expect(res.Seatbid[0].Bid).To(Equal([]openrtb3.Bid{{
+			ID:     "yaddayadda",
+			Item:   "1",
+			Deal:   "1234",
+			Price:  1.5,
+			Tactic: "...",
+			PURL:   "...",
+			BURL:   "...",
+			LURL:   "...",
+			MID:    "...",
+			CID:    "...",
+			Macro:  []openrtb3.Macro{{Key: "TIMESTAMP", Value: "1127987134"}},
+			Media: &openrtb3.Media{Ad: &adcom1.Ad{
+				ID:      "557391",
+				ADomain: []string{"ford.com"},
+				Secure:  1,
+				Display: &adcom1.Display{W: 300, H: 250, AdM: "<html/>"},
+			}},
+		}}))
+
+		bin, err := json.Marshal(res)
+		Expect(err).NotTo(HaveOccurred())
+		var out *openrtb3.Response
+		Expect(json.Unmarshal(bin, &out)).To(Succeed())
+		Expect(out).To(Equal(res))
+	})
+
+	It("should reset and reuse", func() {
+		res := openrtb3.NewResponse()
+		*res = *subject.OpenRTB.Response
+		openrtb3.FreeResponse(res)
+		Expect(res.ID).To(BeEmpty())
+		Expect(res.Seatbid).To(BeEmpty())
+	})
+})
+
+// --------------------------------------------------------------------
+
+func TestSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "openrtb/openrtb3")
+}
+
+func fixture(fname string, v interface{}) error {
+	f, err := os.Open(filepath.Join("testdata", fname+".json"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return json.NewDecoder(f).Decode(v)
+}
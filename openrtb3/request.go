@@ -0,0 +1,225 @@
+package openrtb3
+
+//go:generate ffjson $GOFILE
+
+import (
+	"sync"
+
+	"github.com/bsm/openrtb"
+	"github.com/bsm/openrtb/adcom1"
+)
+
+// The request object contains a globally unique bid request ID, the item
+// array which describes the goods offered and the context of the transaction.
+type Request struct {
+	ID      string            `json:"id"`                // Unique ID of the bid request
+	Test    int               `json:"test,omitempty"`    // Indicator of test mode, where 0 = live, 1 = test
+	TMax    int               `json:"tmax,omitempty"`    // Maximum time in milliseconds to submit a bid
+	AT      int               `json:"at,omitempty"`      // Auction type, where 1 = First Price, 2 = Second Price Plus (default)
+	Cur     []string          `json:"cur,omitempty"`     // Array of accepted currencies using ISO-4217 alpha codes
+	Seat    []string          `json:"seat,omitempty"`    // Restriction list of buyer seats
+	WSeat   *int              `json:"wseat,omitempty"`   // Flag that determines the restriction interpretation of seat, where 0 = block list, 1 = whitelist (default)
+	CData   string            `json:"cdata,omitempty"`   // Data previously stored by the bidder in the exchange's cookie
+	Source  *Source           `json:"source,omitempty"`  // Data about the inventory source
+	Item    []Item            `json:"item"`              // Array of items offered for sale
+	Package int               `json:"package,omitempty"` // Indicates if the items must be won as a package, where 0 = no, 1 = yes
+	Context *Context          `json:"context,omitempty"` // Layer-4 domain objects describing the context
+	Ext     openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (r *Request) Reset() {
+	r.ID = ""
+	r.Test = 0
+	r.TMax = 0
+	r.AT = 0
+	if r.Cur != nil {
+		r.Cur = r.Cur[:0]
+	}
+	if r.Seat != nil {
+		r.Seat = r.Seat[:0]
+	}
+	r.WSeat = nil
+	r.CData = ""
+	r.Source = nil
+	if r.Item != nil {
+		for i := 0; i < len(r.Item); i++ {
+			(&r.Item[i]).Reset()
+		}
+		r.Item = r.Item[:0]
+	}
+	r.Package = 0
+	r.Context = nil
+	r.Ext = nil
+}
+
+// GetWSeat returns the seat restriction interpretation, defaults to 1 (whitelist)
+func (r *Request) GetWSeat() int {
+	if r.WSeat != nil {
+		return *r.WSeat
+	}
+	return 1
+}
+
+var requestPool = sync.Pool{
+	New: func() interface{} {
+		return new(Request)
+	},
+}
+
+func NewRequest() *Request {
+	return requestPool.Get().(*Request)
+}
+
+func FreeRequest(r *Request) {
+	if r == nil {
+		return
+	}
+	r.Reset()
+	requestPool.Put(r)
+}
+
+// This object carries data about the source of the transaction including the
+// unique ID of the transaction itself.
+type Source struct {
+	TID    string            `json:"tid,omitempty"`    // Transaction ID common across all participants
+	TS     int64             `json:"ts,omitempty"`     // Timestamp when the request originated in Unix milliseconds
+	DS     string            `json:"ds,omitempty"`     // Digital signature used to authenticate the origin of this request
+	DSMap  string            `json:"dsmap,omitempty"`  // Ordered list of fields included in the digital signature
+	Cert   string            `json:"cert,omitempty"`   // File name of the certificate used to sign the request
+	PChain string            `json:"pchain,omitempty"` // Payment ID chain string
+	Ext    openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (s *Source) Reset() {
+	s.TID = ""
+	s.TS = 0
+	s.DS = ""
+	s.DSMap = ""
+	s.Cert = ""
+	s.PChain = ""
+	s.Ext = nil
+}
+
+// This object is a container for the layer-4 domain objects describing the
+// context of the transaction.
+type Context struct {
+	Site         *adcom1.Site         `json:"site,omitempty"`
+	App          *adcom1.App          `json:"app,omitempty"`
+	User         *adcom1.User         `json:"user,omitempty"`
+	Device       *adcom1.Device       `json:"device,omitempty"`
+	Regs         *adcom1.Regs         `json:"regs,omitempty"`
+	Restrictions *adcom1.Restrictions `json:"restrictions,omitempty"`
+}
+
+func (c *Context) Reset() {
+	c.Site = nil
+	c.App = nil
+	c.User = nil
+	c.Device = nil
+	c.Regs = nil
+	c.Restrictions = nil
+}
+
+// This object represents a unit of goods being offered for sale either on the
+// open market or in relation to a private marketplace deal.
+type Item struct {
+	ID      string            `json:"id"`                // Unique ID of the item within the request
+	Qty     int               `json:"qty,omitempty"`     // Number of instances of this item being offered, defaults to 1
+	Seq     int               `json:"seq,omitempty"`     // Sequence number of the item within a package
+	Flr     float64           `json:"flr,omitempty"`     // Minimum bid price for this item
+	FlrCur  string            `json:"flrcur,omitempty"`  // Currency of the floor using ISO-4217 alpha codes
+	Exp     int               `json:"exp,omitempty"`     // Advisory as to the number of seconds before the item may be fulfilled
+	DT      float64           `json:"dt,omitempty"`      // Timestamp when the item is expected to be fulfilled in Unix milliseconds
+	Dlvy    int               `json:"dlvy,omitempty"`    // Item delivery method, where 0 = either, 1 = client-side only, 2 = server-side only
+	Metric  []Metric          `json:"metric,omitempty"`  // Metrics associated with this item
+	Deal    []Deal            `json:"deal,omitempty"`    // Deals eligible for this item
+	Private int               `json:"private,omitempty"` // Indicator of auction eligibility, where 0 = open auction, 1 = deals only
+	Spec    *Spec             `json:"spec,omitempty"`    // Layer-4 domain object describing the item
+	Ext     openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (it *Item) Reset() {
+	it.ID = ""
+	it.Qty = 0
+	it.Seq = 0
+	it.Flr = 0
+	it.FlrCur = ""
+	it.Exp = 0
+	it.DT = 0
+	it.Dlvy = 0
+	if it.Metric != nil {
+		for i := 0; i < len(it.Metric); i++ {
+			(&it.Metric[i]).Reset()
+		}
+		it.Metric = it.Metric[:0]
+	}
+	if it.Deal != nil {
+		for i := 0; i < len(it.Deal); i++ {
+			(&it.Deal[i]).Reset()
+		}
+		it.Deal = it.Deal[:0]
+	}
+	it.Private = 0
+	it.Spec = nil
+	it.Ext = nil
+}
+
+// GetQty returns the quantity of the item, defaults to 1
+func (it *Item) GetQty() int {
+	if it.Qty > 0 {
+		return it.Qty
+	}
+	return 1
+}
+
+// This object is a container for the layer-4 domain object describing the item.
+type Spec struct {
+	Placement *adcom1.Placement `json:"placement,omitempty"`
+}
+
+func (s *Spec) Reset() {
+	s.Placement = nil
+}
+
+// This object constitutes a specific deal that was struck a priori between a
+// seller and a buyer.
+type Deal struct {
+	ID       string            `json:"id"`                 // Unique ID of the deal
+	Qty      int               `json:"qty,omitempty"`      // Number of instances of the item offered under this deal
+	Flr      float64           `json:"flr,omitempty"`      // Minimum deal price for this item
+	FlrCur   string            `json:"flrcur,omitempty"`   // Currency of the floor using ISO-4217 alpha codes
+	AT       int               `json:"at,omitempty"`       // Optional override of the overall auction type
+	WSeat    []string          `json:"wseat,omitempty"`    // Whitelist of buyer seats allowed to bid on this deal
+	WADomain []string          `json:"wadomain,omitempty"` // Whitelist of advertiser domains allowed to bid on this deal
+	Ext      openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (d *Deal) Reset() {
+	d.ID = ""
+	d.Qty = 0
+	d.Flr = 0
+	d.FlrCur = ""
+	d.AT = 0
+	if d.WSeat != nil {
+		d.WSeat = d.WSeat[:0]
+	}
+	if d.WADomain != nil {
+		d.WADomain = d.WADomain[:0]
+	}
+	d.Ext = nil
+}
+
+// This object is associated with an item as an array of metrics.
+type Metric struct {
+	Type   string            `json:"type"`             // Type of metric being presented
+	Value  float64           `json:"value"`            // Number representing the value of the metric
+	Vendor string            `json:"vendor,omitempty"` // Source of the value
+	Ext    openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (m *Metric) Reset() {
+	m.Type = ""
+	m.Value = 0
+	m.Vendor = ""
+	m.Ext = nil
+}
@@ -0,0 +1,139 @@
+package openrtb3
+
+//go:generate ffjson $GOFILE
+
+import (
+	"sync"
+
+	"github.com/bsm/openrtb"
+	"github.com/bsm/openrtb/adcom1"
+)
+
+// This object is the bid response object under the OpenRTB root.
+type Response struct {
+	ID      string              `json:"id"`                // ID of the bid request to which this is a response
+	BidID   string              `json:"bidid,omitempty"`   // Bidder generated response ID
+	NBR     openrtb.NoBidReason `json:"nbr,omitempty"`     // Reason for not bidding if applicable
+	Cur     string              `json:"cur,omitempty"`     // Bid currency using ISO-4217 alpha codes
+	CData   string              `json:"cdata,omitempty"`   // Data to be stored in the exchange's cookie
+	Seatbid []Seatbid           `json:"seatbid,omitempty"` // Array of seatbid objects
+	Ext     openrtb.Extension   `json:"ext,omitempty"`
+}
+
+func (r *Response) Reset() {
+	r.ID = ""
+	r.BidID = ""
+	r.NBR = 0
+	r.Cur = ""
+	r.CData = ""
+	if r.Seatbid != nil {
+		for i := 0; i < len(r.Seatbid); i++ {
+			(&r.Seatbid[i]).Reset()
+		}
+		r.Seatbid = r.Seatbid[:0]
+	}
+	r.Ext = nil
+}
+
+var responsePool = sync.Pool{
+	New: func() interface{} {
+		return new(Response)
+	},
+}
+
+func NewResponse() *Response {
+	return responsePool.Get().(*Response)
+}
+
+func FreeResponse(r *Response) {
+	if r == nil {
+		return
+	}
+	r.Reset()
+	responsePool.Put(r)
+}
+
+// A bid response can contain multiple seatbid objects, each on behalf of a
+// different buyer seat.
+type Seatbid struct {
+	Seat    string            `json:"seat,omitempty"`    // ID of the buyer seat on whose behalf this bid is made
+	Package int               `json:"package,omitempty"` // Indicates if the bids must be won as a package, where 0 = no, 1 = yes
+	Bid     []Bid             `json:"bid"`               // Array of bids
+	Ext     openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (s *Seatbid) Reset() {
+	s.Seat = ""
+	s.Package = 0
+	if s.Bid != nil {
+		for i := 0; i < len(s.Bid); i++ {
+			(&s.Bid[i]).Reset()
+		}
+		s.Bid = s.Bid[:0]
+	}
+	s.Ext = nil
+}
+
+// A seatbid object contains one or more bid objects, each of which relates to
+// a specific item in the bid request.
+type Bid struct {
+	ID     string            `json:"id,omitempty"`     // Bidder generated bid ID
+	Item   string            `json:"item"`             // ID of the item object in the related bid request
+	Deal   string            `json:"deal,omitempty"`   // Reference to a deal from the bid request
+	Price  float64           `json:"price"`            // Bid price expressed as CPM
+	Tactic string            `json:"tactic,omitempty"` // Tactic ID to enable buyers to label bids for reporting
+	PURL   string            `json:"purl,omitempty"`   // Pending notice URL
+	BURL   string            `json:"burl,omitempty"`   // Billing notice URL
+	LURL   string            `json:"lurl,omitempty"`   // Loss notice URL
+	Exp    int               `json:"exp,omitempty"`    // Advisory as to the number of seconds the bidder is willing to wait between auction and fulfilment
+	MID    string            `json:"mid,omitempty"`    // ID to enable media to be specified by reference
+	CID    string            `json:"cid,omitempty"`    // Campaign ID to assist with ad quality checking
+	Macro  []Macro           `json:"macro,omitempty"`  // Array of bidder-specific macros
+	Media  *Media            `json:"media,omitempty"`  // Layer-4 domain object describing the media
+	Ext    openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (b *Bid) Reset() {
+	b.ID = ""
+	b.Item = ""
+	b.Deal = ""
+	b.Price = 0
+	b.Tactic = ""
+	b.PURL = ""
+	b.BURL = ""
+	b.LURL = ""
+	b.Exp = 0
+	b.MID = ""
+	b.CID = ""
+	if b.Macro != nil {
+		for i := 0; i < len(b.Macro); i++ {
+			(&b.Macro[i]).Reset()
+		}
+		b.Macro = b.Macro[:0]
+	}
+	b.Media = nil
+	b.Ext = nil
+}
+
+// This object constitutes a buyer defined key/value pair used to inject
+// dynamic values into media markup.
+type Macro struct {
+	Key   string            `json:"key"`             // Name of a buyer specific macro
+	Value string            `json:"value,omitempty"` // Value to substitute for each instance of the macro
+	Ext   openrtb.Extension `json:"ext,omitempty"`
+}
+
+func (m *Macro) Reset() {
+	m.Key = ""
+	m.Value = ""
+	m.Ext = nil
+}
+
+// This object is a container for the layer-4 domain object describing the media.
+type Media struct {
+	Ad *adcom1.Ad `json:"ad,omitempty"`
+}
+
+func (m *Media) Reset() {
+	m.Ad = nil
+}
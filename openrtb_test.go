@@ -15,6 +15,9 @@ func TestSuite(t *testing.T) {
 	RunSpecs(t, "openrtb")
 }
 
+// raceEnabled is set when tests run under the race detector.
+var raceEnabled bool
+
 func iptr(n int) *int       { return &n }
 func sptr(s string) *string { return &s }
 
@@ -26,3 +29,99 @@ func fixture(fname string, v interface{}) error {
 	defer f.Close()
 	return json.NewDecoder(f).Decode(v)
 }
+
+var _ = Describe("Geo", func() {
+
+	It("should validate", func() {
+		Expect((&Geo{Lat: 35.01, Lon: -115.12, Country: "USA"}).Validate()).NotTo(HaveOccurred())
+		Expect((&Geo{Lat: -90.5}).Validate()).To(Equal(ErrInvalidGeoLat))
+		Expect((&Geo{Lon: 180.5}).Validate()).To(Equal(ErrInvalidGeoLon))
+		Expect((&Geo{Country: "usa"}).Validate()).To(Equal(ErrInvalidGeoCountry))
+		Expect((&Geo{Country: "US"}).Validate()).To(Equal(ErrInvalidGeoCountry))
+	})
+
+})
+
+var _ = Describe("User", func() {
+
+	It("should validate", func() {
+		Expect((&User{YOB: 1985, Gender: "F"}).Validate()).NotTo(HaveOccurred())
+		Expect((&User{YOB: 85}).Validate()).To(Equal(ErrInvalidUserYOB))
+		Expect((&User{YOB: 3000}).Validate()).To(Equal(ErrInvalidUserYOB))
+		Expect((&User{Gender: "male"}).Validate()).To(Equal(ErrInvalidUserGender))
+		Expect((&User{Geo: &Geo{Lat: 100}}).ValidateAll()[0].Path).To(Equal("geo.lat"))
+	})
+
+	It("should leave legacy extension locations untouched", func() {
+		var u *User
+		data := []byte(`{"id":"U","ext":{"consent":"CO-TCF","eids":[{"source":"uidapi.com","uids":[{"id":"U2","atype":3}]}]}}`)
+		Expect(json.Unmarshal(data, &u)).To(Succeed())
+		Expect(u.Consent).To(BeEmpty())
+		Expect(u.EIDs).To(BeEmpty())
+		Expect(json.Marshal(u)).To(MatchJSON(data))
+
+		Expect(json.Unmarshal([]byte(`{"consent":"NEW","ext":{"consent":"OLD"}}`), &u)).To(Succeed())
+		Expect(u.Consent).To(Equal("NEW"))
+	})
+
+	It("should look up extended identifiers", func() {
+		var u *User
+		Expect(json.Unmarshal([]byte(`{"eids":[{"source":"id5-sync.com","uids":[{"id":"ID5*X","atype":1}]},{"source":"uidapi.com","uids":[{"id":"U2","atype":3}]}]}`), &u)).To(Succeed())
+		Expect(u.EID("id5-sync.com")).To(Equal(&EID{Source: "id5-sync.com", UIDs: []UID{{ID: "ID5*X", AType: AgentTypeDevice}}}))
+		Expect(u.UIDFor("uidapi.com")).To(Equal("U2"))
+		Expect(u.UIDFor("liveramp.com")).To(BeEmpty())
+		Expect(u.EID("liveramp.com")).To(BeNil())
+	})
+
+	It("should validate extended identifiers", func() {
+		errs := (&User{EIDs: []EID{{Source: "a.com", UIDs: []UID{{ID: "1"}}}, {UIDs: []UID{{ID: "2"}}}, {Source: "c.com"}}}).ValidateAll()
+		Expect(errs).To(HaveLen(2))
+		Expect(errs[0].Path).To(Equal("eids[1].source"))
+		Expect(errs[0].Err).To(Equal(ErrInvalidUserEIDSource))
+		Expect(errs[1].Path).To(Equal("eids[2].uids"))
+		Expect(errs[1].Err).To(Equal(ErrInvalidUserEIDUID))
+	})
+
+})
+
+var _ = Describe("Regulations", func() {
+
+	It("should parse correctly", func() {
+		var r *Regulations
+		Expect(json.Unmarshal([]byte(`{"coppa":1,"gdpr":0,"us_privacy":"1YNN","gpp":"DBABMA~CPXxRfAPXxRfAAfKABENB-CgAAAAAAAAAAYgAAAAAAAA","gpp_sid":[2,6]}`), &r)).To(Succeed())
+		Expect(r).To(Equal(&Regulations{
+			Coppa:     1,
+			GDPR:      iptr(0),
+			USPrivacy: "1YNN",
+			GPP:       "DBABMA~CPXxRfAPXxRfAAfKABENB-CgAAAAAAAAAAYgAAAAAAAA",
+			GPPSID:    []int{2, 6},
+		}))
+	})
+
+	It("should leave legacy extension locations untouched", func() {
+		var r *Regulations
+		Expect(json.Unmarshal([]byte(`{"ext":{"gdpr":"1","us_privacy":"1---","other":true}}`), &r)).To(Succeed())
+		Expect(r.GDPR).To(BeNil())
+		Expect(r.USPrivacy).To(BeEmpty())
+		Expect(json.Marshal(r)).To(MatchJSON(`{"ext":{"gdpr":"1","us_privacy":"1---","other":true}}`))
+	})
+
+	It("should generate correctly", func() {
+		bin, err := json.Marshal(&Regulations{GDPR: iptr(1), USPrivacy: "1YNN"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(bin)).To(Equal(`{"gdpr":1,"us_privacy":"1YNN"}`))
+	})
+
+	It("should validate", func() {
+		Expect((&Regulations{GDPR: iptr(1), USPrivacy: "1YNN", GPP: "X", GPPSID: []int{2}}).Validate()).NotTo(HaveOccurred())
+		Expect((&Regulations{GDPR: iptr(2)}).Validate()).To(Equal(ErrInvalidRegsGDPR))
+		Expect((&Regulations{USPrivacy: "YNN"}).Validate()).To(Equal(ErrInvalidRegsUSPrivacy))
+		Expect((&Regulations{USPrivacy: "2YNN"}).Validate()).To(Equal(ErrInvalidRegsUSPrivacy))
+
+		errs := (&Regulations{GPP: "X"}).ValidateAll()
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Severity).To(Equal(SeverityWarning))
+		Expect(errs[0].Err).To(Equal(ErrInvalidRegsGPPSID))
+	})
+
+})
@@ -0,0 +1,69 @@
+package openrtb
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+// ErrOptionsInUse is returned by SetOptions once options have been applied.
+var ErrOptionsInUse = errors.New("openrtb: options are already in use")
+
+// JSONOptions control optional JSON handling, shared by all objects of the package.
+type JSONOptions struct {
+	// KeepUnknown retains object members which are not modelled by this
+	// package, e.g. newer spec fields or exchange-specific keys outside of
+	// "ext", on decode. Retained members are written back unchanged on encode.
+	KeepUnknown bool
+
+	// CompactExtensions removes insignificant whitespace from extensions on
+	// decode.
+	CompactExtensions bool
+	// MaxExtensionSize limits the size of decoded extensions in bytes, both as
+	// received and after compaction. Zero means unlimited.
+	MaxExtensionSize int
+	// MaxExtensionDepth limits the nesting depth of objects and arrays within
+	// decoded extensions. Zero means unlimited.
+	MaxExtensionDepth int
+	// ValidateExtensions makes encoding fail with ErrExtensionInvalid when an
+	// extension is not valid JSON.
+	ValidateExtensions bool
+}
+
+var (
+	options     JSONOptions
+	optionsOnce sync.Once
+)
+
+// SetOptions configures JSON handling process-wide. Options can be set only
+// once, before the first object is decoded or encoded, typically during
+// program initialization. Returns ErrOptionsInUse afterwards.
+func SetOptions(o JSONOptions) error {
+	set := false
+	optionsOnce.Do(func() {
+		options = o
+		set = true
+	})
+	if !set {
+		return ErrOptionsInUse
+	}
+	return nil
+}
+
+// jsonOptions returns the options in effect, sealing them against changes.
+func jsonOptions() JSONOptions {
+	optionsOnce.Do(func() {})
+	return options
+}
+
+// appendMember appends a "key":value member to a comma separated list of
+// raw object members.
+func appendMember(members, key, value []byte) []byte {
+	if len(members) != 0 {
+		members = append(members, ',')
+	}
+	qkey, _ := json.Marshal(string(key))
+	members = append(members, qkey...)
+	members = append(members, ':')
+	return append(members, value...)
+}
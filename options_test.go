@@ -0,0 +1,89 @@
+package openrtb
+
+import (
+	"encoding/json"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Options", func() {
+	const data = `{
+		"id": "A",
+		"x-exchange": {"dc": "eu-1"},
+		"imp": [{
+			"id": "1",
+			"rwdd": 1,
+			"banner": {"w": 300, "h": 250, "x-size": [1, 2]},
+			"video": {"mimes": ["video/mp4"], "sequence": 1, "linearity": 1, "x-ssai": true}
+		}],
+		"app": {"id": "B", "x-store": "play", "publisher": {"id": "C", "x": null}},
+		"device": {"ua": "Mozilla", "x-üid": "\"quoted\""},
+		"regs": {"coppa": 1, "x-law": "ccpa"},
+		"at": 1
+	}`
+
+	AfterEach(func() {
+		setOptions(JSONOptions{})
+	})
+
+	It("should be set only once", func() {
+		optionsOnce = sync.Once{}
+		Expect(SetOptions(JSONOptions{KeepUnknown: true})).To(Succeed())
+		Expect(SetOptions(JSONOptions{})).To(Equal(ErrOptionsInUse))
+		Expect(jsonOptions().KeepUnknown).To(BeTrue())
+
+		optionsOnce = sync.Once{}
+		Expect(jsonOptions().KeepUnknown).To(BeTrue())
+		Expect(SetOptions(JSONOptions{})).To(Equal(ErrOptionsInUse))
+	})
+
+	It("should drop unknown members by default", func() {
+		var req *BidRequest
+		Expect(json.Unmarshal([]byte(data), &req)).To(Succeed())
+		Expect(json.Marshal(req)).To(MatchJSON(`{
+			"id": "A",
+			"imp": [{
+				"id": "1",
+				"rwdd": 1,
+				"banner": {"w": 300, "h": 250},
+				"video": {"mimes": ["video/mp4"], "sequence": 1, "linearity": 1}
+			}],
+			"app": {"id": "B", "publisher": {"id": "C"}},
+			"device": {"ua": "Mozilla"},
+			"regs": {"coppa": 1},
+			"at": 1
+		}`))
+	})
+
+	It("should keep unknown members", func() {
+		setOptions(JSONOptions{KeepUnknown: true})
+
+		var req *BidRequest
+		Expect(json.Unmarshal([]byte(data), &req)).To(Succeed())
+		Expect(json.Marshal(req)).To(MatchJSON(data))
+		Expect(req.Imp[0].Banner.W).To(Equal(300))
+
+		req.Reset()
+		Expect(json.Marshal(req)).To(MatchJSON(`{"id":"","at":0}`))
+	})
+
+	It("should keep unknown members of empty objects", func() {
+		setOptions(JSONOptions{KeepUnknown: true})
+
+		var seg Segment
+		Expect(json.Unmarshal([]byte(`{"x":1}`), &seg)).To(Succeed())
+		Expect(json.Marshal(&seg)).To(MatchJSON(`{"x":1}`))
+
+		var uid UID
+		Expect(json.Unmarshal([]byte(`{"id":"A","x":[]}`), &uid)).To(Succeed())
+		Expect(json.Marshal(&uid)).To(MatchJSON(`{"id":"A","x":[]}`))
+	})
+})
+
+// setOptions replaces the options in effect, bypassing the set-once guard.
+func setOptions(o JSONOptions) {
+	optionsOnce = sync.Once{}
+	Expect(SetOptions(o)).To(Succeed())
+}
@@ -1,12 +1,26 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
+
+import (
+	"errors"
+	"sync"
+)
+
+// Validation errors
+var (
+	ErrInvalidPmpDuplicateDeal = errors.New("openrtb: pmp deal ID not unique")
+	ErrInvalidDealNoID         = errors.New("openrtb: deal ID missing")
+	ErrInvalidDealBidFloor     = errors.New("openrtb: deal bid floor negative")
+)
 
 // Private Marketplace Object
 type Pmp struct {
 	Private int       `json:"private_auction,omitempty"`
 	Deals   []Deal    `json:"deals,omitempty"`
 	Ext     Extension `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 // PMP Deal
@@ -21,9 +35,12 @@ type Deal struct {
 
 	Seats []string `json:"seats,omitempty"` // DEPRECATED: kept for backwards compatibility
 	Type  int      `json:"type,omitempty"`  // DEPRECATED: kept for backwards compatibility
+
+	unknown []byte
 }
 
 func (d *Deal) Reset() {
+	d.unknown = nil
 	d.ID = ""
 	d.BidFloor = 0.0
 	d.BidFloorCurrency = ""
@@ -43,6 +60,51 @@ func (d *Deal) Reset() {
 	}
 }
 
+// Validates the object
+func (d *Deal) Validate() error {
+	return d.ValidateAll().First()
+}
+
+// ValidateAll validates the object and returns all issues found.
+func (d *Deal) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	d.validate(v, "")
+	return v.errs
+}
+
+func (d *Deal) validate(v *Validator, path string) {
+	if d.ID == "" {
+		v.AddError(PathKey(path, "id"), ErrInvalidDealNoID)
+	}
+	if d.BidFloor < 0 {
+		v.AddError(PathKey(path, "bidfloor"), ErrInvalidDealBidFloor)
+	}
+	v.deprecated(Version2_3, len(d.Seats) != 0, PathKey(path, "seats"))
+	v.deprecated(Version2_3, d.Type != 0, PathKey(path, "type"))
+}
+
+func (d *Deal) normalizeAll(n *normalizer, path string) {
+	if d.BidFloorCurrency == "" {
+		d.BidFloorCurrency = defaultCurrency
+		n.setDefault(PathKey(path, "bidfloorcur"))
+	}
+	d.migrateSeats(n, path)
+}
+
+// migrateSeats moves deprecated seats into wseat.
+func (d *Deal) migrateSeats(n *normalizer, path string) {
+	if len(d.Seats) == 0 {
+		return
+	}
+	for _, s := range d.Seats {
+		if !containsString(d.WSeat, s) {
+			d.WSeat = append(d.WSeat, s)
+		}
+	}
+	d.Seats = d.Seats[:0]
+	n.migrate(PathKey(path, "wseat"))
+}
+
 //var dealPool = sync.Pool{
 //	New: func() interface{} {
 //		return new(Deal)
@@ -59,6 +121,7 @@ func (d *Deal) Reset() {
 //}
 
 func (p *Pmp) Reset() {
+	p.unknown = nil
 	p.Private = 0
 	if p.Deals != nil {
 		for i := 0; i < len(p.Deals); i++ {
@@ -71,20 +134,71 @@ func (p *Pmp) Reset() {
 	}
 }
 
-//var pmpPool = sync.Pool{
-//	New: func() interface{} {
-//		return new(Pmp)
-//	},
-//}
-//
-//func NewPmp() *Pmp{
-//	return pmpPool.Get().(*Pmp)
-//}
-//
-//func FreePmp(p *Pmp) {
-//	p.Reset()
-//	pmpPool.Put(p)
-//}
+var pmpPool = sync.Pool{
+	New: func() interface{} {
+		return new(Pmp)
+	},
+}
+
+func NewPmp() *Pmp {
+	return pmpPool.Get().(*Pmp)
+}
+
+func FreePmp(p *Pmp) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	pmpPool.Put(p)
+}
+
+// Validates the object
+func (p *Pmp) Validate() error {
+	return p.ValidateAll().First()
+}
+
+// ValidateAll validates the object and returns all issues found.
+func (p *Pmp) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	p.validate(v, "")
+	return v.errs
+}
+
+func (p *Pmp) validate(v *Validator, path string) {
+	seen := make(map[string]struct{}, len(p.Deals))
+	for i := range p.Deals {
+		d := &p.Deals[i]
+		dpath := PathIndex(PathKey(path, "deals"), i)
+		d.validate(v, dpath)
+
+		if d.ID == "" {
+			continue
+		}
+		if _, ok := seen[d.ID]; ok {
+			v.AddError(PathKey(dpath, "id"), ErrInvalidPmpDuplicateDeal)
+		}
+		seen[d.ID] = struct{}{}
+	}
+}
+
+func (p *Pmp) normalizeAll(n *normalizer, path string) {
+	for i := range p.Deals {
+		p.Deals[i].normalizeAll(n, PathIndex(PathKey(path, "deals"), i))
+	}
+}
+
+// deal returns the deal with the given ID or nil if there is none.
+func (p *Pmp) deal(id string) *Deal {
+	if p == nil {
+		return nil
+	}
+	for i := range p.Deals {
+		if p.Deals[i].ID == id {
+			return &p.Deals[i]
+		}
+	}
+	return nil
+}
 
 //type jsonDeal Deal
 
@@ -31,4 +31,14 @@ var _ = Describe("Pmp", func() {
 		Expect(string(bin)).To(Equal(`{"deals":[{"at":2}]}`))
 	})
 
+	It("should validate", func() {
+		Expect(subject.Validate()).NotTo(HaveOccurred())
+		Expect((&Deal{}).Validate()).To(Equal(ErrInvalidDealNoID))
+		Expect((&Deal{ID: "A", BidFloor: -1}).Validate()).To(Equal(ErrInvalidDealBidFloor))
+
+		errs := (&Pmp{Deals: []Deal{{ID: "A"}, {ID: "B"}, {ID: "A"}}}).ValidateAll()
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Path).To(Equal("deals[2].id"))
+		Expect(errs[0].Err).To(Equal(ErrInvalidPmpDuplicateDeal))
+	})
 })
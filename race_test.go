@@ -0,0 +1,9 @@
+//go:build race
+// +build race
+
+package openrtb
+
+func init() {
+	// sync.Pool randomly drops objects under the race detector
+	raceEnabled = true
+}
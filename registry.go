@@ -0,0 +1,89 @@
+package openrtb
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrExtensionNotRegistered is returned when no extension type was registered
+// for an object.
+var ErrExtensionNotRegistered = errors.New("openrtb: extension type not registered")
+
+// ExtensionKind identifies objects which support registered extension types.
+type ExtensionKind int
+
+// ExtensionKind options
+const (
+	ExtensionKindRequest    ExtensionKind = 1 // BidRequest.Ext
+	ExtensionKindImpression ExtensionKind = 2 // Impression.Ext
+	ExtensionKindBid        ExtensionKind = 3 // Bid.Ext
+)
+
+var extensionTypes = struct {
+	sync.RWMutex
+	m map[ExtensionKind]reflect.Type
+}{m: make(map[ExtensionKind]reflect.Type)}
+
+// RegisterExtension registers the type of v as the schema of the extensions
+// of kind, e.g.
+//
+//	openrtb.RegisterExtension(openrtb.ExtensionKindImpression, ImpExt{})
+//
+// Registered extensions are decoded into a new *ImpExt on first access and
+// cached afterwards, see Impression.ParsedExt. Passing a nil v removes the
+// registration. Registrations are usually made from init functions.
+func RegisterExtension(kind ExtensionKind, v interface{}) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	extensionTypes.Lock()
+	defer extensionTypes.Unlock()
+
+	if t == nil {
+		delete(extensionTypes.m, kind)
+	} else {
+		extensionTypes.m[kind] = t
+	}
+}
+
+func registeredExtension(kind ExtensionKind) reflect.Type {
+	extensionTypes.RLock()
+	t := extensionTypes.m[kind]
+	extensionTypes.RUnlock()
+	return t
+}
+
+// extCache holds the decoded form of an extension. It is safe for concurrent
+// use, concurrent first accesses may decode the extension more than once.
+type extCache struct {
+	entry atomic.Value // *extCacheEntry
+}
+
+// extCacheEntry is an immutable decoded extension, along with the raw bytes
+// it was decoded from.
+type extCacheEntry struct {
+	raw []byte
+	val interface{}
+}
+
+func (c *extCache) decode(kind ExtensionKind, ext Extension) (interface{}, error) {
+	t := registeredExtension(kind)
+	if t == nil {
+		return nil, ErrExtensionNotRegistered
+	}
+	if e, _ := c.entry.Load().(*extCacheEntry); e != nil && reflect.TypeOf(e.val).Elem() == t && bytes.Equal(e.raw, ext) {
+		return e.val, nil
+	}
+
+	v := reflect.New(t).Interface()
+	if err := ext.Decode(v); err != nil {
+		return nil, err
+	}
+	c.entry.Store(&extCacheEntry{raw: append([]byte(nil), ext...), val: v})
+	return v, nil
+}
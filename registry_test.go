@@ -0,0 +1,77 @@
+package openrtb
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RegisterExtension", func() {
+	type impExt struct {
+		Prebid struct {
+			Bidder map[string]struct {
+				PlacementID int `json:"placement_id"`
+			} `json:"bidder"`
+		} `json:"prebid"`
+	}
+
+	BeforeEach(func() {
+		RegisterExtension(ExtensionKindImpression, &impExt{})
+	})
+
+	AfterEach(func() {
+		RegisterExtension(ExtensionKindImpression, nil)
+	})
+
+	It("should decode registered extensions", func() {
+		imp := &Impression{Ext: Extension(`{"prebid":{"bidder":{"appnexus":{"placement_id":123}}}}`)}
+		v, err := imp.ParsedExt()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(BeAssignableToTypeOf(&impExt{}))
+		Expect(v.(*impExt).Prebid.Bidder["appnexus"].PlacementID).To(Equal(123))
+
+		_, err = (&Bid{}).ParsedExt()
+		Expect(err).To(Equal(ErrExtensionNotRegistered))
+		_, err = (&Impression{Ext: Extension(`[`)}).ParsedExt()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should cache decoded extensions", func() {
+		imp := &Impression{Ext: Extension(`{"prebid":{"bidder":{"appnexus":{"placement_id":1}}}}`)}
+		v1, err := imp.ParsedExt()
+		Expect(err).NotTo(HaveOccurred())
+		v2, err := imp.ParsedExt()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v2).To(BeIdenticalTo(v1))
+
+		Expect(imp.Ext.Set("prebid.bidder.appnexus.placement_id", 2)).To(Succeed())
+		v3, err := imp.ParsedExt()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v3).NotTo(BeIdenticalTo(v1))
+		Expect(v3.(*impExt).Prebid.Bidder["appnexus"].PlacementID).To(Equal(2))
+
+		imp.Reset()
+		v4, err := imp.ParsedExt()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v4).To(Equal(&impExt{}))
+	})
+
+	It("should decode concurrently", func() {
+		imp := &Impression{Ext: Extension(`{"prebid":{"bidder":{"appnexus":{"placement_id":1}}}}`)}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				v, err := imp.ParsedExt()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(v.(*impExt).Prebid.Bidder["appnexus"].PlacementID).To(Equal(1))
+			}()
+		}
+		wg.Wait()
+	})
+})
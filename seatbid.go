@@ -1,6 +1,6 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
 
 import "errors"
 
@@ -15,9 +15,12 @@ type SeatBid struct {
 	Seat  string    `json:"seat,omitempty"`  // ID of the bidder seat optional string ID of the bidder seat on whose behalf this bid is made.
 	Group int       `json:"group,omitempty"` // '1' means impression must be won-lost as a group; default is '0'.
 	Ext   Extension `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 func (s *SeatBid) Reset() {
+	s.unknown = nil
 	s.Seat = ""
 	s.Group = 0
 	if s.Ext != nil {
@@ -33,20 +36,35 @@ func (s *SeatBid) Reset() {
 
 // Validation errors
 var (
-	ErrInvalidSeatBidBid = errors.New("openrtb: seatbid is missing bids")
+	ErrInvalidSeatBidBid  = errors.New("openrtb: seatbid is missing bids")
+	ErrInvalidSeatBidSeat = errors.New("openrtb: seat is not allowed to bid")
 )
 
 // Validate required attributes
 func (sb *SeatBid) Validate() error {
+	return sb.ValidateAll().First()
+}
+
+// ValidateAll validates the seatbid and returns all issues found.
+func (sb *SeatBid) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	sb.validate(v, "")
+	return v.errs
+}
+
+func (sb *SeatBid) validate(v *Validator, path string) {
 	if len(sb.Bid) == 0 {
-		return ErrInvalidSeatBidBid
+		v.AddError(PathKey(path, "bid"), ErrInvalidSeatBidBid)
 	}
 
-	for _, bid := range sb.Bid {
-		if err := bid.Validate(); err != nil {
-			return err
-		}
+	for i := range sb.Bid {
+		sb.Bid[i].validate(v, PathIndex(PathKey(path, "bid"), i))
 	}
+}
 
-	return nil
+// validateSeat checks the seat against the allowed and blocked seats of the request.
+func (sb *SeatBid) validateSeat(v *Validator, path string, req *BidRequest) {
+	if (len(req.WSeat) != 0 && !containsString(req.WSeat, sb.Seat)) || containsString(req.BSeat, sb.Seat) {
+		v.AddError(PathKey(path, "seat"), ErrInvalidSeatBidSeat)
+	}
 }
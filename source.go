@@ -1,20 +1,336 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	fflib "github.com/pquerna/ffjson/fflib/v1"
+)
+
+// Validation errors
+var (
+	ErrInvalidSChainVer      = errors.New("openrtb: schain version missing")
+	ErrInvalidSChainComplete = errors.New("openrtb: schain complete flag invalid")
+	ErrInvalidSChainNoNodes  = errors.New("openrtb: schain has no nodes")
+	ErrInvalidSChainNodeASI  = errors.New("openrtb: schain node ASI missing")
+	ErrInvalidSChainNodeSID  = errors.New("openrtb: schain node SID missing")
+	ErrInvalidSChainNodeHP   = errors.New("openrtb: schain node HP flag invalid")
+)
+
+// ErrInvalidSChainString is returned when a compact supply chain string cannot be parsed
+var ErrInvalidSChainString = errors.New("openrtb: schain string invalid")
 
 // Source object describes the nature and behavior of the entity that is the source of the bid request upstream from the exchange.
 type Source struct {
-	FinalSaleDecision int       `json:"fd,omitempty"`     // Entity responsible for the final impression sale decision, where 0 = exchange, 1 = upstream source.
-	TransactionID     string    `json:"tid,omitempty"`    // Transaction ID that must be common across all participants in this bid request (e.g., potentially multiple exchanges).
-	PaymentChain      string    `json:"pchain,omitempty"` // Payment ID chain string containing embedded syntax described in the TAG Payment ID Protocol v1.0.
-	Ext               Extension `json:"ext,omitempty"`    // Placeholder for exchange-specific extensions to OpenRTB.
+	FinalSaleDecision int          `json:"fd,omitempty"`     // Entity responsible for the final impression sale decision, where 0 = exchange, 1 = upstream source.
+	TransactionID     string       `json:"tid,omitempty"`    // Transaction ID that must be common across all participants in this bid request (e.g., potentially multiple exchanges).
+	PaymentChain      string       `json:"pchain,omitempty"` // Payment ID chain string containing embedded syntax described in the TAG Payment ID Protocol v1.0.
+	SChain            *SupplyChain `json:"schain,omitempty"` // Supply chain of all parties involved in the transaction; moved from source.ext.schain by ConvertTo (Spec 2.6)
+	Ext               Extension    `json:"ext,omitempty"`    // Placeholder for exchange-specific extensions to OpenRTB.
+
+	unknown []byte
 }
 
 func (s *Source) Reset() {
+	s.unknown = nil
 	s.FinalSaleDecision = 0
 	s.PaymentChain = ""
 	s.TransactionID = ""
+	if s.SChain != nil {
+		FreeSupplyChain(s.SChain)
+		s.SChain = nil
+	}
 	if s.Ext != nil {
 		s.Ext = s.Ext[:0]
 	}
 }
+
+var sourcePool = sync.Pool{
+	New: func() interface{} {
+		return new(Source)
+	},
+}
+
+func NewSource() *Source {
+	return sourcePool.Get().(*Source)
+}
+
+func FreeSource(s *Source) {
+	if s == nil {
+		return
+	}
+	s.Reset()
+	sourcePool.Put(s)
+}
+
+type jsonSource Source
+
+// MarshalJSON custom marshalling
+func (s *Source) MarshalJSON() ([]byte, error) {
+	return (*jsonSource)(s).MarshalJSON()
+}
+
+// MarshalJSONBuf custom marshalling
+func (s *Source) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	return (*jsonSource)(s).MarshalJSONBuf(buf)
+}
+
+// UnmarshalJSON custom unmarshalling
+func (s *Source) UnmarshalJSON(data []byte) error {
+	return s.UnmarshalJSONFFLexer(fflib.NewFFLexer(data), fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer custom unmarshalling
+func (s *Source) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	// decode in place, reusing the allocations of recycled objects
+	s.Reset()
+	return (*jsonSource)(s).UnmarshalJSONFFLexer(fs, state)
+}
+
+// readExt fills an unset supply chain from its legacy extension location.
+func (s *Source) readExt() error {
+	if len(s.Ext) == 0 || s.SChain != nil {
+		return nil
+	}
+
+	var ext struct {
+		SChain *SupplyChain `json:"schain"`
+	}
+	if err := json.Unmarshal(s.Ext, &ext); err != nil {
+		return err
+	}
+	s.SChain = ext.SChain
+	return nil
+}
+
+// AppendSupplyChainNode appends a node to the supply chain, typically the
+// exchange's own node before forwarding the request. A chain in the legacy
+// source.ext.schain location is extended in place. If the request carries
+// no supply chain yet, the exchange is its origin and a new complete chain
+// is started.
+func (s *Source) AppendSupplyChainNode(node SupplyChainNode) error {
+	if s.SChain == nil && s.Ext.has("schain") {
+		var sc SupplyChain
+		if err := s.Ext.Get("schain", &sc); err != nil {
+			return err
+		}
+		sc.Nodes = append(sc.Nodes, node)
+		return s.Ext.Set("schain", &sc)
+	}
+
+	if s.SChain == nil {
+		s.SChain = &SupplyChain{Complete: 1, Ver: "1.0"}
+	}
+	s.SChain.Nodes = append(s.SChain.Nodes, node)
+	return nil
+}
+
+func (s *Source) validate(v *Validator, path string) {
+	if s.SChain != nil {
+		s.SChain.validate(v, PathKey(path, "schain"))
+	}
+
+	v.since(Version2_6, s.SChain != nil && !s.Ext.has("schain"), PathKey(path, "schain"))
+}
+
+// SupplyChain is composed primarily of a set of nodes where each node represents a specific entity
+// that participates in the transacting of inventory. The entire chain of nodes from beginning to end
+// represents all entities who are involved in the direct flow of payment for inventory.
+type SupplyChain struct {
+	Complete int               `json:"complete"`      // Flag indicating whether the chain contains all nodes involved in the transaction leading back to the owner of the site, app or other medium of the inventory, where 0 = no, 1 = yes.
+	Nodes    []SupplyChainNode `json:"nodes"`         // Array of SupplyChainNode objects in the order of the chain. In a complete supply chain, the first node represents the initial advertising system and seller ID involved in the transaction.
+	Ver      string            `json:"ver"`           // Version of the supply chain specification in use, in the format of "major.minor".
+	Ext      Extension         `json:"ext,omitempty"` // Placeholder for advertising-system specific extensions to this object.
+
+	unknown []byte
+}
+
+func (sc *SupplyChain) Reset() {
+	sc.unknown = nil
+	sc.Complete = 0
+	sc.Ver = ""
+	if sc.Nodes != nil {
+		for i := 0; i < len(sc.Nodes); i++ {
+			(&sc.Nodes[i]).Reset()
+		}
+		sc.Nodes = sc.Nodes[:0]
+	}
+	if sc.Ext != nil {
+		sc.Ext = sc.Ext[:0]
+	}
+}
+
+var supplyChainPool = sync.Pool{
+	New: func() interface{} {
+		return new(SupplyChain)
+	},
+}
+
+func NewSupplyChain() *SupplyChain {
+	return supplyChainPool.Get().(*SupplyChain)
+}
+
+func FreeSupplyChain(sc *SupplyChain) {
+	if sc == nil {
+		return
+	}
+	sc.Reset()
+	supplyChainPool.Put(sc)
+}
+
+// IsComplete returns true if the chain claims to be complete and every
+// node identifies its advertising system and seller.
+func (sc *SupplyChain) IsComplete() bool {
+	if sc.Complete != 1 || len(sc.Nodes) == 0 {
+		return false
+	}
+	for _, node := range sc.Nodes {
+		if node.ASI == "" || node.SID == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Validates the object
+func (sc *SupplyChain) Validate() error {
+	return sc.ValidateAll().First()
+}
+
+// ValidateAll validates the object and returns all issues found.
+func (sc *SupplyChain) ValidateAll() ValidationErrors {
+	v := new(Validator)
+	sc.validate(v, "")
+	return v.errs
+}
+
+func (sc *SupplyChain) validate(v *Validator, path string) {
+	if sc.Ver == "" {
+		v.AddError(PathKey(path, "ver"), ErrInvalidSChainVer)
+	}
+	if sc.Complete != 0 && sc.Complete != 1 {
+		v.AddError(PathKey(path, "complete"), ErrInvalidSChainComplete)
+	}
+	if len(sc.Nodes) == 0 {
+		v.AddError(PathKey(path, "nodes"), ErrInvalidSChainNoNodes)
+	}
+	for i := range sc.Nodes {
+		sc.Nodes[i].validate(v, PathIndex(PathKey(path, "nodes"), i))
+	}
+}
+
+// String returns the compact form of the supply chain as used in
+// VAST macros and HTTP headers, e.g. "1.0,1!exchange1.com,1234,1,,,".
+// Node extensions are not included.
+func (sc *SupplyChain) String() string {
+	var b strings.Builder
+	b.WriteString(url.PathEscape(sc.Ver))
+	b.WriteByte(',')
+	b.WriteString(strconv.Itoa(sc.Complete))
+	for _, node := range sc.Nodes {
+		b.WriteByte('!')
+		b.WriteString(url.PathEscape(node.ASI))
+		b.WriteByte(',')
+		b.WriteString(url.PathEscape(node.SID))
+		b.WriteByte(',')
+		b.WriteString(strconv.Itoa(node.HP))
+		b.WriteByte(',')
+		b.WriteString(url.PathEscape(node.RID))
+		b.WriteByte(',')
+		b.WriteString(url.PathEscape(node.Name))
+		b.WriteByte(',')
+		b.WriteString(url.PathEscape(node.Domain))
+	}
+	return b.String()
+}
+
+// ParseSupplyChain parses the compact form of a supply chain, e.g.
+// "1.0,1!exchange1.com,1234,1,,,". Node fields are in the order asi, sid,
+// hp, rid, name, domain and ext, all URL-encoded; trailing fields may be omitted.
+func ParseSupplyChain(s string) (*SupplyChain, error) {
+	parts := strings.Split(s, "!")
+	head := strings.Split(parts[0], ",")
+	if len(head) != 2 {
+		return nil, ErrInvalidSChainString
+	}
+
+	ver, err := url.PathUnescape(head[0])
+	if err != nil || ver == "" {
+		return nil, ErrInvalidSChainString
+	}
+	complete, err := strconv.Atoi(head[1])
+	if err != nil {
+		return nil, ErrInvalidSChainString
+	}
+
+	sc := &SupplyChain{Complete: complete, Ver: ver, Nodes: make([]SupplyChainNode, 0, len(parts)-1)}
+	for _, part := range parts[1:] {
+		fields := strings.Split(part, ",")
+		if len(fields) < 2 || len(fields) > 7 {
+			return nil, ErrInvalidSChainString
+		}
+
+		var vals [7]string
+		for i, field := range fields {
+			if vals[i], err = url.PathUnescape(field); err != nil {
+				return nil, ErrInvalidSChainString
+			}
+		}
+
+		node := SupplyChainNode{ASI: vals[0], SID: vals[1], RID: vals[3], Name: vals[4], Domain: vals[5]}
+		if vals[2] != "" {
+			if node.HP, err = strconv.Atoi(vals[2]); err != nil {
+				return nil, ErrInvalidSChainString
+			}
+		}
+		if vals[6] != "" && json.Valid([]byte(vals[6])) {
+			node.Ext = Extension(vals[6])
+		}
+		sc.Nodes = append(sc.Nodes, node)
+	}
+	return sc, nil
+}
+
+// SupplyChainNode defines the identity of an entity participating in the supply chain of a bid request.
+type SupplyChainNode struct {
+	ASI    string    `json:"asi"`              // The canonical domain name of the SSP, Exchange, Header Wrapper, etc system that bidders connect to.
+	SID    string    `json:"sid"`              // The identifier associated with the seller or reseller account within the advertising system.
+	RID    string    `json:"rid,omitempty"`    // The OpenRTB RequestId of the request as issued by this seller.
+	Name   string    `json:"name,omitempty"`   // The name of the company (the legal entity) that is paid for inventory transacted under the given seller ID.
+	Domain string    `json:"domain,omitempty"` // The business domain name of the entity represented by this node.
+	HP     int       `json:"hp"`               // Indicates whether this node will be involved in the flow of payment for the inventory, where 0 = no, 1 = yes.
+	Ext    Extension `json:"ext,omitempty"`    // Placeholder for advertising-system specific extensions to this object.
+
+	unknown []byte
+}
+
+func (n *SupplyChainNode) Reset() {
+	n.unknown = nil
+	n.ASI = ""
+	n.SID = ""
+	n.RID = ""
+	n.Name = ""
+	n.Domain = ""
+	n.HP = 0
+	if n.Ext != nil {
+		n.Ext = n.Ext[:0]
+	}
+}
+
+func (n *SupplyChainNode) validate(v *Validator, path string) {
+	if n.ASI == "" {
+		v.AddError(PathKey(path, "asi"), ErrInvalidSChainNodeASI)
+	}
+	if n.SID == "" {
+		v.AddError(PathKey(path, "sid"), ErrInvalidSChainNodeSID)
+	}
+	if n.HP != 0 && n.HP != 1 {
+		v.AddError(PathKey(path, "hp"), ErrInvalidSChainNodeHP)
+	}
+}
@@ -1,6 +1,8 @@
 package openrtb
 
 import (
+	"encoding/json"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -21,4 +23,72 @@ var _ = Describe("Source", func() {
 			Ext:               Extension("{}"),
 		}))
 	})
+
+	It("should leave the legacy supply chain location untouched", func() {
+		var s *Source
+		Expect(json.Unmarshal([]byte(`{"schain":{"complete":1,"ver":"1.0","nodes":[{"asi":"a.com","sid":"1","hp":1}]}}`), &s)).To(Succeed())
+		Expect(s.SChain).To(Equal(&SupplyChain{Complete: 1, Ver: "1.0", Nodes: []SupplyChainNode{{ASI: "a.com", SID: "1", HP: 1}}}))
+
+		data := []byte(`{"ext":{"schain":{"complete":0,"ver":"1.0","nodes":[{"asi":"b.com","sid":"2","hp":1}]}}}`)
+		Expect(json.Unmarshal(data, &s)).To(Succeed())
+		Expect(s.SChain).To(BeNil())
+		Expect(json.Marshal(s)).To(MatchJSON(data))
+	})
+
+	It("should append nodes", func() {
+		s := new(Source)
+		Expect(s.AppendSupplyChainNode(SupplyChainNode{ASI: "a.com", SID: "1", HP: 1})).To(Succeed())
+		Expect(s.AppendSupplyChainNode(SupplyChainNode{ASI: "b.com", SID: "2", HP: 1})).To(Succeed())
+		Expect(s.SChain.Ver).To(Equal("1.0"))
+		Expect(s.SChain.Nodes).To(HaveLen(2))
+		Expect(s.SChain.IsComplete()).To(BeTrue())
+
+		s = &Source{Ext: Extension(`{"other":1,"schain":{"complete":0,"ver":"1.0","nodes":[{"asi":"a.com","sid":"1","hp":1}]}}`)}
+		Expect(s.AppendSupplyChainNode(SupplyChainNode{ASI: "b.com", SID: "2", HP: 1})).To(Succeed())
+		Expect(s.SChain).To(BeNil())
+		Expect(string(s.Ext)).To(MatchJSON(`{"other":1,"schain":{"complete":0,"ver":"1.0","nodes":[{"asi":"a.com","sid":"1","hp":1},{"asi":"b.com","sid":"2","hp":1}]}}`))
+	})
+
+})
+
+var _ = Describe("SupplyChain", func() {
+
+	It("should encode compact strings", func() {
+		sc := &SupplyChain{Complete: 1, Ver: "1.0", Nodes: []SupplyChainNode{
+			{ASI: "exchange1.com", SID: "1234", HP: 1},
+			{ASI: "exchange2.com", SID: "abcd!1", HP: 1, RID: "bid-request-2", Name: "publisher, Inc.", Domain: "publisher.com"},
+		}}
+		Expect(sc.String()).To(Equal("1.0,1!exchange1.com,1234,1,,,!exchange2.com,abcd%211,1,bid-request-2,publisher%2C%20Inc.,publisher.com"))
+	})
+
+	It("should parse compact strings", func() {
+		sc, err := ParseSupplyChain("1.0,1!exchange1.com,1234,1,,,!exchange2.com,abcd%211,1,bid-request-2,publisher%2c%20Inc.,publisher.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sc).To(Equal(&SupplyChain{Complete: 1, Ver: "1.0", Nodes: []SupplyChainNode{
+			{ASI: "exchange1.com", SID: "1234", HP: 1},
+			{ASI: "exchange2.com", SID: "abcd!1", HP: 1, RID: "bid-request-2", Name: "publisher, Inc.", Domain: "publisher.com"},
+		}}))
+		Expect(ParseSupplyChain(sc.String())).To(Equal(sc))
+
+		for _, s := range []string{"", "1.0", "1.0,x", "1.0,1!a.com", "1.0,1!a.com,1,x", "1.0,1!a,b,1,,,,,"} {
+			_, err := ParseSupplyChain(s)
+			Expect(err).To(Equal(ErrInvalidSChainString), "for %q", s)
+		}
+	})
+
+	It("should validate", func() {
+		sc := &SupplyChain{Complete: 1, Ver: "1.0", Nodes: []SupplyChainNode{{ASI: "a.com", SID: "1", HP: 1}}}
+		Expect(sc.Validate()).NotTo(HaveOccurred())
+		Expect(sc.IsComplete()).To(BeTrue())
+
+		Expect((&SupplyChain{Complete: 1, Nodes: sc.Nodes}).Validate()).To(Equal(ErrInvalidSChainVer))
+		Expect((&SupplyChain{Complete: 2, Ver: "1.0", Nodes: sc.Nodes}).Validate()).To(Equal(ErrInvalidSChainComplete))
+		Expect((&SupplyChain{Complete: 1, Ver: "1.0"}).Validate()).To(Equal(ErrInvalidSChainNoNodes))
+
+		errs := (&SupplyChain{Complete: 1, Ver: "1.0", Nodes: []SupplyChainNode{{HP: 2}}}).ValidateAll()
+		Expect(errs.Errors()).To(HaveLen(3))
+		Expect(errs[0].Path).To(Equal("nodes[0].asi"))
+		Expect((&SupplyChain{Complete: 0, Ver: "1.0", Nodes: sc.Nodes}).IsComplete()).To(BeFalse())
+	})
+
 })
@@ -0,0 +1,237 @@
+package openrtb
+
+//go:generate go run gen_ffjson.go
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Structured user agent information, as derived from User-Agent Client Hints (Spec 2.6).
+// If both device.ua and device.sua are present, bidders should treat sua as the more accurate source.
+type UserAgent struct {
+	Browsers     []BrandVersion `json:"browsers,omitempty"`     // Each BrandVersion object identifies a browser or similar software component, in the order of the Sec-CH-UA-Full-Version-List header.
+	Platform     *BrandVersion  `json:"platform,omitempty"`     // Identifies the user agent's execution platform / OS.
+	Mobile       *int           `json:"mobile,omitempty"`       // 1 if the agent prefers a "mobile" version of the content, 0 otherwise, nil if unknown.
+	Architecture string         `json:"architecture,omitempty"` // Device's major binary architecture, e.g. "x86" or "arm".
+	Bitness      string         `json:"bitness,omitempty"`      // Device's bitness, e.g. "64" for 64-bit architecture.
+	Model        string         `json:"model,omitempty"`        // Device model.
+	Source       UASource       `json:"source,omitempty"`       // The source of data used to create this object, see UASource*. Default: 0 = unknown
+	Ext          Extension      `json:"ext,omitempty"`
+
+	unknown []byte
+}
+
+func (ua *UserAgent) Reset() {
+	ua.unknown = nil
+	if ua.Browsers != nil {
+		for i := 0; i < len(ua.Browsers); i++ {
+			(&ua.Browsers[i]).Reset()
+		}
+		ua.Browsers = ua.Browsers[:0]
+	}
+	if ua.Platform != nil {
+		FreeBrandVersion(ua.Platform)
+		ua.Platform = nil
+	}
+	ua.Mobile = nil
+	ua.Architecture = ""
+	ua.Bitness = ""
+	ua.Model = ""
+	ua.Source = 0
+	if ua.Ext != nil {
+		ua.Ext = ua.Ext[:0]
+	}
+}
+
+var userAgentPool = sync.Pool{
+	New: func() interface{} {
+		return new(UserAgent)
+	},
+}
+
+func NewUserAgent() *UserAgent {
+	return userAgentPool.Get().(*UserAgent)
+}
+
+func FreeUserAgent(ua *UserAgent) {
+	if ua == nil {
+		return
+	}
+	ua.Reset()
+	userAgentPool.Put(ua)
+}
+
+func (ua *UserAgent) validate(v *Validator, path string) {
+	if !ua.Source.IsValid() {
+		v.AddError(PathKey(path, "source"), ErrInvalidDeviceSUASource)
+	}
+	if ua.Mobile != nil && *ua.Mobile != 0 && *ua.Mobile != 1 {
+		v.AddError(PathKey(path, "mobile"), ErrInvalidDeviceSUAMobile)
+	}
+	for i, b := range ua.Browsers {
+		if b.Brand == "" {
+			v.AddError(PathKey(PathIndex(PathKey(path, "browsers"), i), "brand"), ErrInvalidDeviceSUABrand)
+		}
+	}
+	if ua.Platform != nil && ua.Platform.Brand == "" {
+		v.AddError(PathKey(PathKey(path, "platform"), "brand"), ErrInvalidDeviceSUABrand)
+	}
+}
+
+// BrandVersion identifies a browser, platform or other software component together with its version.
+type BrandVersion struct {
+	Brand   string    `json:"brand"`             // A brand identifier, e.g. "Chrome" or "Windows".
+	Version []string  `json:"version,omitempty"` // A sequence of version components, in descending hierarchical order (major, minor, micro, ...).
+	Ext     Extension `json:"ext,omitempty"`
+
+	unknown []byte
+}
+
+func (bv *BrandVersion) Reset() {
+	bv.unknown = nil
+	bv.Brand = ""
+	if bv.Version != nil {
+		bv.Version = bv.Version[:0]
+	}
+	if bv.Ext != nil {
+		bv.Ext = bv.Ext[:0]
+	}
+}
+
+var brandVersionPool = sync.Pool{
+	New: func() interface{} {
+		return new(BrandVersion)
+	},
+}
+
+func NewBrandVersion() *BrandVersion {
+	return brandVersionPool.Get().(*BrandVersion)
+}
+
+func FreeBrandVersion(bv *BrandVersion) {
+	if bv == nil {
+		return
+	}
+	bv.Reset()
+	brandVersionPool.Put(bv)
+}
+
+// UserAgentFromHeaders builds a structured user agent from the User-Agent
+// Client Hints request headers (Sec-CH-UA, Sec-CH-UA-Full-Version-List,
+// Sec-CH-UA-Platform, Sec-CH-UA-Platform-Version, Sec-CH-UA-Mobile,
+// Sec-CH-UA-Arch, Sec-CH-UA-Bitness and Sec-CH-UA-Model). Returns nil if
+// the request carries no client hints.
+func UserAgentFromHeaders(h http.Header) *UserAgent {
+	ua := new(UserAgent)
+	if s := h.Get("Sec-CH-UA-Full-Version-List"); s != "" {
+		ua.Browsers = parseBrandList(s)
+		ua.Source = UASourceHighEntropy
+	} else if s := h.Get("Sec-CH-UA"); s != "" {
+		ua.Browsers = parseBrandList(s)
+		ua.Source = UASourceLowEntropy
+	}
+
+	if s := h.Get("Sec-CH-UA-Platform"); s != "" {
+		ua.Platform = &BrandVersion{Brand: parseSFString(s)}
+		if s := parseSFString(h.Get("Sec-CH-UA-Platform-Version")); s != "" {
+			ua.Platform.Version = strings.Split(s, ".")
+			ua.Source = UASourceHighEntropy
+		}
+		if ua.Source == UASourceUnknown {
+			ua.Source = UASourceLowEntropy
+		}
+	}
+
+	switch strings.TrimSpace(h.Get("Sec-CH-UA-Mobile")) {
+	case "?1":
+		mobile := 1
+		ua.Mobile = &mobile
+	case "?0":
+		mobile := 0
+		ua.Mobile = &mobile
+	}
+	if ua.Mobile != nil && ua.Source == UASourceUnknown {
+		ua.Source = UASourceLowEntropy
+	}
+
+	ua.Architecture = parseSFString(h.Get("Sec-CH-UA-Arch"))
+	ua.Bitness = parseSFString(h.Get("Sec-CH-UA-Bitness"))
+	ua.Model = parseSFString(h.Get("Sec-CH-UA-Model"))
+	if ua.Architecture != "" || ua.Bitness != "" || ua.Model != "" {
+		ua.Source = UASourceHighEntropy
+	}
+
+	if ua.Source == UASourceUnknown {
+		return nil
+	}
+	return ua
+}
+
+// parseBrandList parses a brand list header in structured field syntax,
+// e.g. `"Chromium";v="110", "Google Chrome";v="110.0.5481.77"`.
+func parseBrandList(s string) []BrandVersion {
+	var list []BrandVersion
+	for _, item := range splitSF(s, ',') {
+		params := splitSF(item, ';')
+		brand := parseSFString(params[0])
+		if brand == "" {
+			continue
+		}
+
+		bv := BrandVersion{Brand: brand}
+		for _, param := range params[1:] {
+			if kv := strings.SplitN(param, "=", 2); len(kv) == 2 && strings.TrimSpace(kv[0]) == "v" {
+				if ver := parseSFString(kv[1]); ver != "" {
+					bv.Version = strings.Split(ver, ".")
+				}
+			}
+		}
+		list = append(list, bv)
+	}
+	return list
+}
+
+// splitSF splits s at sep, ignoring separators inside quoted strings.
+func splitSF(s string, sep byte) []string {
+	var parts []string
+	quoted, escaped, start := false, false, 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case escaped:
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// parseSFString unquotes a structured field string, e.g. `"Windows"`.
+// Unquoted tokens are returned as is.
+func parseSFString(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return s
+	}
+
+	s = s[1 : len(s)-1]
+	if strings.IndexByte(s, '\\') < 0 {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,80 @@
+package openrtb
+
+import (
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UserAgent", func() {
+
+	It("should parse correctly", func() {
+		var ua *UserAgent
+		Expect(json.Unmarshal([]byte(`{
+			"browsers": [{"brand":"Chromium","version":["110","0","5481","77"]},{"brand":"Google Chrome","version":["110"]}],
+			"platform": {"brand":"macOS","version":["13","2"]},
+			"mobile": 0,
+			"architecture": "arm",
+			"source": 2
+		}`), &ua)).To(Succeed())
+		Expect(ua).To(Equal(&UserAgent{
+			Browsers: []BrandVersion{
+				{Brand: "Chromium", Version: []string{"110", "0", "5481", "77"}},
+				{Brand: "Google Chrome", Version: []string{"110"}},
+			},
+			Platform:     &BrandVersion{Brand: "macOS", Version: []string{"13", "2"}},
+			Mobile:       iptr(0),
+			Architecture: "arm",
+			Source:       UASourceHighEntropy,
+		}))
+	})
+
+	It("should build from low-entropy client hints", func() {
+		h := http.Header{}
+		h.Set("Sec-CH-UA", `"Chromium";v="110", "Not A(Brand";v="24", "Google Chrome";v="110"`)
+		h.Set("Sec-CH-UA-Mobile", "?1")
+		h.Set("Sec-CH-UA-Platform", `"Android"`)
+		Expect(UserAgentFromHeaders(h)).To(Equal(&UserAgent{
+			Browsers: []BrandVersion{
+				{Brand: "Chromium", Version: []string{"110"}},
+				{Brand: "Not A(Brand", Version: []string{"24"}},
+				{Brand: "Google Chrome", Version: []string{"110"}},
+			},
+			Platform: &BrandVersion{Brand: "Android"},
+			Mobile:   iptr(1),
+			Source:   UASourceLowEntropy,
+		}))
+	})
+
+	It("should build from high-entropy client hints", func() {
+		h := http.Header{}
+		h.Set("Sec-CH-UA", `"Chromium";v="110"`)
+		h.Set("Sec-CH-UA-Full-Version-List", `"Chromium";v="110.0.5481.77", "Not\"A;Brand";v="99.0.0.0"`)
+		h.Set("Sec-CH-UA-Platform", `"Windows"`)
+		h.Set("Sec-CH-UA-Platform-Version", `"15.0.0"`)
+		h.Set("Sec-CH-UA-Mobile", "?0")
+		h.Set("Sec-CH-UA-Arch", `"x86"`)
+		h.Set("Sec-CH-UA-Bitness", `"64"`)
+		h.Set("Sec-CH-UA-Model", `""`)
+		Expect(UserAgentFromHeaders(h)).To(Equal(&UserAgent{
+			Browsers: []BrandVersion{
+				{Brand: "Chromium", Version: []string{"110", "0", "5481", "77"}},
+				{Brand: "Not\"A;Brand", Version: []string{"99", "0", "0", "0"}},
+			},
+			Platform:     &BrandVersion{Brand: "Windows", Version: []string{"15", "0", "0"}},
+			Mobile:       iptr(0),
+			Architecture: "x86",
+			Bitness:      "64",
+			Source:       UASourceHighEntropy,
+		}))
+	})
+
+	It("should return nil without client hints", func() {
+		h := http.Header{}
+		h.Set("User-Agent", "Mozilla/5.0")
+		Expect(UserAgentFromHeaders(h)).To(BeNil())
+	})
+
+})
@@ -0,0 +1,230 @@
+package openrtb
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Validation errors
+var (
+	ErrDeprecated   = errors.New("openrtb: field is deprecated")
+	ErrNotInVersion = errors.New("openrtb: field is not defined in this version")
+)
+
+// Severity indicates how serious a validation issue is.
+type Severity int
+
+const (
+	SeverityError   Severity = iota // The object violates the specification and must be rejected
+	SeverityWarning                 // The object is acceptable but should be fixed by the sender
+)
+
+// String returns the name of the severity
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return "severity(" + strconv.Itoa(int(s)) + ")"
+}
+
+// ValidationError describes a single validation issue and the location at which it was found.
+type ValidationError struct {
+	Path     string   // JSON path to the offending field, e.g. "imp[2].video.protocols"
+	Severity Severity // Severity of the issue
+	Err      error    // Underlying sentinel error, e.g. ErrInvalidVideoNoProtocols
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e *ValidationError) Unwrap() error { return e.Err }
+
+// ValidationErrors is a list of validation issues, as returned by ValidateAll.
+// It supports errors.Is against any of the contained sentinel errors.
+type ValidationErrors []*ValidationError
+
+// Error implements the error interface.
+func (errs ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		msgs = append(msgs, e.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Is reports whether any of the issues matches target.
+func (errs ValidationErrors) Is(target error) bool {
+	for _, e := range errs {
+		if e.Err == target {
+			return true
+		}
+	}
+	return false
+}
+
+// Errors returns only the issues with SeverityError.
+func (errs ValidationErrors) Errors() ValidationErrors {
+	return errs.filter(SeverityError)
+}
+
+// Warnings returns only the issues with SeverityWarning.
+func (errs ValidationErrors) Warnings() ValidationErrors {
+	return errs.filter(SeverityWarning)
+}
+
+// First returns the sentinel error of the first issue with SeverityError or nil.
+func (errs ValidationErrors) First() error {
+	for _, e := range errs {
+		if e.Severity == SeverityError {
+			return e.Err
+		}
+	}
+	return nil
+}
+
+func (errs ValidationErrors) filter(sev Severity) ValidationErrors {
+	var res ValidationErrors
+	for _, e := range errs {
+		if e.Severity == sev {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+// BidRejection lists the reasons why a bid must not be accepted for the request it answers.
+type BidRejection struct {
+	SeatBid int              // Index of the seatbid within the response
+	Bid     int              // Index of the bid within the seatbid
+	BidID   string           // ID of the rejected bid
+	Reasons ValidationErrors // Reasons for the rejection, with paths relative to the response
+}
+
+// defaultCurrency is assumed when no currency is specified.
+const defaultCurrency = "USD"
+
+// Validator collects issues while walking an object tree. It is exported for
+// packages which validate objects of their own, such as the native request
+// and response packages, so that they report issues just like this package.
+type Validator struct {
+	ver  Version // specification version to validate against, if any
+	errs ValidationErrors
+}
+
+// AddError records an issue with SeverityError at path.
+func (v *Validator) AddError(path string, err error) {
+	v.errs = append(v.errs, &ValidationError{Path: path, Severity: SeverityError, Err: err})
+}
+
+// AddWarning records an issue with SeverityWarning at path.
+func (v *Validator) AddWarning(path string, err error) {
+	v.errs = append(v.errs, &ValidationError{Path: path, Severity: SeverityWarning, Err: err})
+}
+
+// Issues returns all issues recorded so far, nil if there are none.
+func (v *Validator) Issues() ValidationErrors {
+	return v.errs
+}
+
+// since warns about a set field which was introduced after the validated version.
+func (v *Validator) since(ver Version, set bool, path string) {
+	if set && v.ver != VersionUnspecified && v.ver < ver {
+		v.AddWarning(path, ErrNotInVersion)
+	}
+}
+
+// deprecated warns about a set field which is deprecated in the validated version.
+func (v *Validator) deprecated(ver Version, set bool, path string) {
+	if set && v.ver != VersionUnspecified && v.ver >= ver {
+		v.AddWarning(path, ErrDeprecated)
+	}
+}
+
+// PathKey appends an object member to a JSON path, e.g. "imp[0]" and "banner"
+// to "imp[0].banner".
+func PathKey(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// PathIndex appends an array index to a JSON path, e.g. "imp" and 2 to "imp[2]".
+func PathIndex(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+func containsString(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(list []int, n int) bool {
+	for _, x := range list {
+		if x == n {
+			return true
+		}
+	}
+	return false
+}
+
+func containsCreativeAttribute(list []CreativeAttribute, n CreativeAttribute) bool {
+	for _, x := range list {
+		if x == n {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAPIFramework(list []APIFramework, n APIFramework) bool {
+	for _, x := range list {
+		if x == n {
+			return true
+		}
+	}
+	return false
+}
+
+func containsProtocol(list []Protocol, n Protocol) bool {
+	for _, x := range list {
+		if x == n {
+			return true
+		}
+	}
+	return false
+}
+
+// domainBlocked reports whether domain or any of its parent domains is on the block list.
+func domainBlocked(blocked []string, domain string) bool {
+	for _, b := range blocked {
+		if strings.EqualFold(domain, b) || (len(domain) > len(b) && domain[len(domain)-len(b)-1] == '.' && strings.EqualFold(domain[len(domain)-len(b):], b)) {
+			return true
+		}
+	}
+	return false
+}
+
+// categoryBlocked reports whether the IAB category or its parent tier is on the block list.
+func categoryBlocked(blocked []string, cat string) bool {
+	for _, b := range blocked {
+		if cat == b || strings.HasPrefix(cat, b+"-") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package openrtb
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidationErrors", func() {
+	var subject ValidationErrors
+
+	BeforeEach(func() {
+		subject = ValidationErrors{
+			{Path: "imp[0].id", Severity: SeverityWarning, Err: ErrInvalidImpNoID},
+			{Path: "id", Severity: SeverityError, Err: ErrInvalidReqNoID},
+		}
+	})
+
+	It("should format", func() {
+		Expect(subject.Error()).To(Equal("imp[0].id: openrtb: impression ID missing; id: openrtb: request ID missing"))
+		Expect(SeverityError.String()).To(Equal("error"))
+		Expect(SeverityWarning.String()).To(Equal("warning"))
+	})
+
+	It("should support errors.Is", func() {
+		Expect(errors.Is(subject, ErrInvalidReqNoID)).To(BeTrue())
+		Expect(errors.Is(subject, ErrInvalidImpNoID)).To(BeTrue())
+		Expect(errors.Is(subject, ErrInvalidReqNoImps)).To(BeFalse())
+		Expect(errors.Is(subject[1], ErrInvalidReqNoID)).To(BeTrue())
+	})
+
+	It("should filter by severity", func() {
+		Expect(subject.Errors()).To(Equal(subject[1:]))
+		Expect(subject.Warnings()).To(Equal(subject[:1]))
+		Expect(subject.First()).To(Equal(ErrInvalidReqNoID))
+		Expect(subject.Warnings().First()).To(BeNil())
+	})
+
+})
+
+var _ = Describe("Validator", func() {
+
+	It("should collect issues", func() {
+		v := new(Validator)
+		Expect(v.Issues()).To(BeNil())
+
+		v.AddWarning(PathKey(PathIndex("imp", 0), "id"), ErrInvalidImpNoID)
+		v.AddError(PathKey("", "id"), ErrInvalidReqNoID)
+		Expect(v.Issues()).To(Equal(ValidationErrors{
+			{Path: "imp[0].id", Severity: SeverityWarning, Err: ErrInvalidImpNoID},
+			{Path: "id", Severity: SeverityError, Err: ErrInvalidReqNoID},
+		}))
+	})
+
+})
@@ -0,0 +1,60 @@
+package openrtb
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrUnsupportedVersion is returned when a version string cannot be parsed
+var ErrUnsupportedVersion = errors.New("openrtb: unsupported version")
+
+// Version identifies an OpenRTB 2.x specification version.
+type Version int
+
+// Supported specification versions, ordered by release. The zero value
+// VersionUnspecified applies the package's default validation rules.
+const (
+	VersionUnspecified Version = iota
+	Version2_3
+	Version2_4
+	Version2_5
+	Version2_6
+)
+
+// ParseVersion parses a version string as sent in the x-openrtb-version
+// header, e.g. "2.5". Patch levels such as "2.5.1" are accepted.
+func ParseVersion(s string) (Version, error) {
+	s = strings.TrimSpace(s)
+	if n := strings.IndexByte(s, '.'); n > -1 {
+		if m := strings.IndexByte(s[n+1:], '.'); m > -1 {
+			s = s[:n+1+m]
+		}
+	}
+
+	switch s {
+	case "2.3":
+		return Version2_3, nil
+	case "2.4":
+		return Version2_4, nil
+	case "2.5":
+		return Version2_5, nil
+	case "2.6":
+		return Version2_6, nil
+	}
+	return VersionUnspecified, ErrUnsupportedVersion
+}
+
+// String returns the version string, e.g. "2.5"
+func (ver Version) String() string {
+	switch ver {
+	case Version2_3:
+		return "2.3"
+	case Version2_4:
+		return "2.4"
+	case Version2_5:
+		return "2.5"
+	case Version2_6:
+		return "2.6"
+	}
+	return ""
+}
@@ -0,0 +1,27 @@
+package openrtb
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Version", func() {
+
+	It("should parse", func() {
+		Expect(ParseVersion("2.3")).To(Equal(Version2_3))
+		Expect(ParseVersion("2.5")).To(Equal(Version2_5))
+		Expect(ParseVersion(" 2.5.1 ")).To(Equal(Version2_5))
+		Expect(ParseVersion("2.6")).To(Equal(Version2_6))
+
+		_, err := ParseVersion("3.0")
+		Expect(err).To(Equal(ErrUnsupportedVersion))
+		_, err = ParseVersion("")
+		Expect(err).To(Equal(ErrUnsupportedVersion))
+	})
+
+	It("should format", func() {
+		Expect(Version2_4.String()).To(Equal("2.4"))
+		Expect(VersionUnspecified.String()).To(Equal(""))
+	})
+
+})
@@ -1,10 +1,12 @@
 package openrtb
 
-//go:generate ffjson $GOFILE
+//go:generate go run gen_ffjson.go
 
 import (
-	"encoding/json"
 	"errors"
+	"sync"
+
+	fflib "github.com/pquerna/ffjson/fflib/v1"
 )
 
 // Validation errors
@@ -14,40 +16,56 @@ var (
 	ErrInvalidVideoNoMinDuration = errors.New("openrtb: video min-duration missing")
 	ErrInvalidVideoNoMaxDuration = errors.New("openrtb: video max-duration missing")
 	ErrInvalidVideoNoProtocols   = errors.New("openrtb: video protocols missing")
+	ErrInvalidVideoRqdDurs       = errors.New("openrtb: video required durations and min/max-duration are mutually exclusive")
+	ErrInvalidVideoPlcmt         = errors.New("openrtb: video plcmt invalid")
+	ErrInvalidVideoPodSeq        = errors.New("openrtb: video pod sequence invalid")
+	ErrInvalidVideoSlotInPod     = errors.New("openrtb: video slot in pod invalid")
+	ErrInvalidVideoMinCPMPerSec  = errors.New("openrtb: video min CPM per second negative")
 )
 
 // The "video" object must be included directly in the impression object if the impression offered
 // for auction is an in-stream video ad opportunity.
 type Video struct {
-	Mimes          []string  `json:"mimes,omitempty"`          // Content MIME types supported.
-	MinDuration    int       `json:"minduration,omitempty"`    // Minimum video ad duration in seconds
-	MaxDuration    int       `json:"maxduration,omitempty"`    // Maximum video ad duration in seconds
-	Protocols      []int     `json:"protocols,omitempty"`      // Video bid response protocols
-	Protocol       int       `json:"protocol,omitempty"`       // Video bid response protocols DEPRECATED
-	W              int       `json:"w,omitempty"`              // Width of the player in pixels
-	H              int       `json:"h,omitempty"`              // Height of the player in pixels
-	StartDelay     int       `json:"startdelay,omitempty"`     // Indicates the start delay in seconds
-	Linearity      int       `json:"linearity,omitempty"`      // Indicates whether the ad impression is linear or non-linear
-	Skip           int       `json:"skip,omitempty"`           // Indicates if the player will allow the video to be skipped, where 0 = no, 1 = yes.
-	SkipMin        int       `json:"skipmin,omitempty"`        // Videos of total duration greater than this number of seconds can be skippable
-	SkipAfter      int       `json:"skipafter,omitempty"`      // Number of seconds a video must play before skipping is enabled
-	Sequence       int       `json:"sequence,omitempty"`       // Default: 1
-	BAttr          []int     `json:"battr,omitempty"`          // Blocked creative attributes
-	MaxExtended    int       `json:"maxextended,omitempty"`    // Maximum extended video ad duration
-	MinBitrate     int       `json:"minbitrate,omitempty"`     // Minimum bit rate in Kbps
-	MaxBitrate     int       `json:"maxbitrate,omitempty"`     // Maximum bit rate in Kbps
-	BoxingAllowed  *int      `json:"boxingallowed,omitempty"`  // If exchange publisher has rules preventing letter boxing
-	PlaybackMethod []int     `json:"playbackmethod,omitempty"` // List of allowed playback methods
-	Delivery       []int     `json:"delivery,omitempty"`       // List of supported delivery methods
-	Pos            int       `json:"pos,omitempty"`            // Ad Position
-	CompanionAd    []Banner  `json:"companionad,omitempty"`
-	Api            []int     `json:"api,omitempty"` // List of supported API frameworks
-	CompanionType  []int     `json:"companiontype,omitempty"`
-	Placement      int       `json:"placement,omitempty"` // Video placement type
-	Ext            Extension `json:"ext,omitempty"`
+	Mimes          []string            `json:"mimes,omitempty"`          // Content MIME types supported.
+	MinDuration    int                 `json:"minduration,omitempty"`    // Minimum video ad duration in seconds
+	MaxDuration    int                 `json:"maxduration,omitempty"`    // Maximum video ad duration in seconds
+	Protocols      []Protocol          `json:"protocols,omitempty"`      // Video bid response protocols
+	Protocol       Protocol            `json:"protocol,omitempty"`       // Video bid response protocols DEPRECATED
+	W              int                 `json:"w,omitempty"`              // Width of the player in pixels
+	H              int                 `json:"h,omitempty"`              // Height of the player in pixels
+	StartDelay     int                 `json:"startdelay,omitempty"`     // Indicates the start delay in seconds
+	Linearity      VideoLinearity      `json:"linearity,omitempty"`      // Indicates whether the ad impression is linear or non-linear
+	Skip           int                 `json:"skip,omitempty"`           // Indicates if the player will allow the video to be skipped, where 0 = no, 1 = yes.
+	SkipMin        int                 `json:"skipmin,omitempty"`        // Videos of total duration greater than this number of seconds can be skippable
+	SkipAfter      int                 `json:"skipafter,omitempty"`      // Number of seconds a video must play before skipping is enabled
+	Sequence       int                 `json:"sequence,omitempty"`       // Default: 1
+	BAttr          []CreativeAttribute `json:"battr,omitempty"`          // Blocked creative attributes
+	MaxExtended    int                 `json:"maxextended,omitempty"`    // Maximum extended video ad duration
+	MinBitrate     int                 `json:"minbitrate,omitempty"`     // Minimum bit rate in Kbps
+	MaxBitrate     int                 `json:"maxbitrate,omitempty"`     // Maximum bit rate in Kbps
+	BoxingAllowed  *int                `json:"boxingallowed,omitempty"`  // If exchange publisher has rules preventing letter boxing
+	PlaybackMethod []PlaybackMethod    `json:"playbackmethod,omitempty"` // List of allowed playback methods
+	Delivery       []ContentDelivery   `json:"delivery,omitempty"`       // List of supported delivery methods
+	Pos            AdPosition          `json:"pos,omitempty"`            // Ad Position
+	CompanionAd    []Banner            `json:"companionad,omitempty"`
+	Api            []APIFramework      `json:"api,omitempty"` // List of supported API frameworks
+	CompanionType  []CompanionType     `json:"companiontype,omitempty"`
+	Placement      VideoPlacement      `json:"placement,omitempty"`    // Video placement type
+	Plcmt          VideoPlcmt          `json:"plcmt,omitempty"`        // Video placement type per the updated IAB Tech Lab definitions (Spec 2.6)
+	PodID          string              `json:"podid,omitempty"`        // Unique identifier of the pod this impression belongs to (Spec 2.6)
+	PodDur         int                 `json:"poddur,omitempty"`       // Total amount of time in seconds that advertisers may fill for a dynamic pod (Spec 2.6)
+	RqdDurs        []int               `json:"rqddurs,omitempty"`      // Exact acceptable durations in seconds for video creatives; mutually exclusive with minduration and maxduration (Spec 2.6)
+	MaxSeq         int                 `json:"maxseq,omitempty"`       // Maximum number of ads that may be served into a dynamic pod (Spec 2.6)
+	PodSeq         PodSequence         `json:"podseq,omitempty"`       // Sequence (position) of the pod within a content stream (Spec 2.6)
+	SlotInPod      SlotPosition        `json:"slotinpod,omitempty"`    // Seller's guaranteed position of this impression within the pod (Spec 2.6)
+	MinCPMPerSec   float64             `json:"mincpmpersec,omitempty"` // Minimum CPM per second; a price floor for dynamic pods (Spec 2.6)
+	Ext            Extension           `json:"ext,omitempty"`
+
+	unknown []byte
 }
 
 func (vid *Video) Reset() {
+	vid.unknown = nil
 	vid.BoxingAllowed = nil
 	if vid.CompanionType != nil {
 		vid.CompanionType = vid.CompanionType[:0]
@@ -96,39 +114,135 @@ func (vid *Video) Reset() {
 	if vid.Mimes != nil {
 		vid.Mimes = vid.Mimes[:0]
 	}
+	vid.Plcmt = 0
+	vid.PodID = ""
+	vid.PodDur = 0
+	if vid.RqdDurs != nil {
+		vid.RqdDurs = vid.RqdDurs[:0]
+	}
+	vid.MaxSeq = 0
+	vid.PodSeq = 0
+	vid.SlotInPod = 0
+	vid.MinCPMPerSec = 0.0
+}
+
+var videoPool = sync.Pool{
+	New: func() interface{} {
+		return new(Video)
+	},
+}
+
+func NewVideo() *Video {
+	return videoPool.Get().(*Video)
 }
 
-//var videoPool = sync.Pool{
-//	New: func() interface{} {
-//		return new(Video)
-//	},
-//}
-//
-//func NewVideo() *Video{
-//	return videoPool.Get().(*Video)
-//}
-//
-//func FreeVideo(vid *Video) {
-//	vid.Reset()
-//	videoPool.Put(vid)
-//}
+func FreeVideo(vid *Video) {
+	if vid == nil {
+		return
+	}
+	vid.Reset()
+	videoPool.Put(vid)
+}
 
 type jsonVideo Video
 
 // Validates the object
 func (v *Video) Validate() error {
+	return v.ValidateAll().First()
+}
+
+// ValidateAll validates the object and returns all issues found.
+func (v *Video) ValidateAll() ValidationErrors {
+	vv := new(Validator)
+	v.validate(vv, "")
+	return vv.errs
+}
+
+func (v *Video) validate(vv *Validator, path string) {
 	if len(v.Mimes) == 0 {
-		return ErrInvalidVideoNoMimes
-	} else if v.Linearity == 0 {
-		return ErrInvalidVideoNoLinearity
-	} else if v.MinDuration == 0 {
-		return ErrInvalidVideoNoMinDuration
-	} else if v.MaxDuration == 0 {
-		return ErrInvalidVideoNoMaxDuration
-	} else if v.Protocol == 0 && len(v.Protocols) == 0 {
-		return ErrInvalidVideoNoProtocols
+		vv.AddError(PathKey(path, "mimes"), ErrInvalidVideoNoMimes)
 	}
-	return nil
+	if len(v.RqdDurs) != 0 && (v.MinDuration != 0 || v.MaxDuration != 0) {
+		vv.AddError(PathKey(path, "rqddurs"), ErrInvalidVideoRqdDurs)
+	}
+	if v.Plcmt != 0 && !v.Plcmt.IsValid() {
+		vv.AddError(PathKey(path, "plcmt"), ErrInvalidVideoPlcmt)
+	}
+	if !v.PodSeq.IsValid() {
+		vv.AddError(PathKey(path, "podseq"), ErrInvalidVideoPodSeq)
+	}
+	if !v.SlotInPod.IsValid() {
+		vv.AddError(PathKey(path, "slotinpod"), ErrInvalidVideoSlotInPod)
+	}
+	if v.MinCPMPerSec < 0 {
+		vv.AddError(PathKey(path, "mincpmpersec"), ErrInvalidVideoMinCPMPerSec)
+	}
+
+	if vv.ver != VersionUnspecified {
+		// the specification only recommends these
+		if v.MinDuration == 0 && len(v.RqdDurs) == 0 {
+			vv.AddWarning(PathKey(path, "minduration"), ErrInvalidVideoNoMinDuration)
+		}
+		if v.MaxDuration == 0 && len(v.RqdDurs) == 0 {
+			vv.AddWarning(PathKey(path, "maxduration"), ErrInvalidVideoNoMaxDuration)
+		}
+		if v.Protocol == 0 && len(v.Protocols) == 0 {
+			vv.AddWarning(PathKey(path, "protocols"), ErrInvalidVideoNoProtocols)
+		}
+		vv.deprecated(Version2_3, v.Protocol != 0, PathKey(path, "protocol"))
+		vv.since(Version2_5, v.Placement != 0, PathKey(path, "placement"))
+		vv.deprecated(Version2_6, v.Placement != 0, PathKey(path, "placement"))
+		vv.since(Version2_6, v.Plcmt != 0, PathKey(path, "plcmt"))
+		vv.since(Version2_6, v.PodID != "", PathKey(path, "podid"))
+		vv.since(Version2_6, v.PodDur != 0, PathKey(path, "poddur"))
+		vv.since(Version2_6, len(v.RqdDurs) != 0, PathKey(path, "rqddurs"))
+		vv.since(Version2_6, v.MaxSeq != 0, PathKey(path, "maxseq"))
+		vv.since(Version2_6, v.PodSeq != 0, PathKey(path, "podseq"))
+		vv.since(Version2_6, v.SlotInPod != 0, PathKey(path, "slotinpod"))
+		vv.since(Version2_6, v.MinCPMPerSec != 0, PathKey(path, "mincpmpersec"))
+		return
+	}
+
+	if v.Linearity == 0 {
+		vv.AddError(PathKey(path, "linearity"), ErrInvalidVideoNoLinearity)
+	}
+	if v.MinDuration == 0 && len(v.RqdDurs) == 0 {
+		vv.AddError(PathKey(path, "minduration"), ErrInvalidVideoNoMinDuration)
+	}
+	if v.MaxDuration == 0 && len(v.RqdDurs) == 0 {
+		vv.AddError(PathKey(path, "maxduration"), ErrInvalidVideoNoMaxDuration)
+	}
+	if v.Protocol == 0 && len(v.Protocols) == 0 {
+		vv.AddError(PathKey(path, "protocols"), ErrInvalidVideoNoProtocols)
+	}
+}
+
+// acceptsDuration reports whether a creative of the given duration in seconds is acceptable.
+func (v *Video) acceptsDuration(dur int) bool {
+	if len(v.RqdDurs) != 0 {
+		return containsInt(v.RqdDurs, dur)
+	}
+	return (v.MinDuration == 0 || dur >= v.MinDuration) && (v.MaxDuration == 0 || dur <= v.MaxDuration)
+}
+
+func (v *Video) normalizeAll(n *normalizer, path string) {
+	if v.Sequence == 0 {
+		v.Sequence = 1
+		n.setDefault(PathKey(path, "sequence"))
+	}
+	v.migrateProtocol(n, path)
+}
+
+// migrateProtocol moves the deprecated protocol into protocols.
+func (v *Video) migrateProtocol(n *normalizer, path string) {
+	if v.Protocol == 0 {
+		return
+	}
+	if !containsProtocol(v.Protocols, v.Protocol) {
+		v.Protocols = append(v.Protocols, v.Protocol)
+	}
+	v.Protocol = 0
+	n.migrate(PathKey(path, "protocols"))
 }
 
 // GetBoxingAllowed returns the boxing-allowed indicator
@@ -142,17 +256,27 @@ func (v *Video) GetBoxingAllowed() int {
 // MarshalJSON custom marshalling with normalization
 func (v *Video) MarshalJSON() ([]byte, error) {
 	v.normalize()
-	return json.Marshal((*jsonVideo)(v))
+	return (*jsonVideo)(v).MarshalJSON()
+}
+
+// MarshalJSONBuf custom marshalling with normalization
+func (v *Video) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	v.normalize()
+	return (*jsonVideo)(v).MarshalJSONBuf(buf)
 }
 
 // UnmarshalJSON custom unmarshalling with normalization
 func (v *Video) UnmarshalJSON(data []byte) error {
-	var h jsonVideo
-	if err := json.Unmarshal(data, &h); err != nil {
+	return v.UnmarshalJSONFFLexer(fflib.NewFFLexer(data), fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer custom unmarshalling with normalization
+func (v *Video) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	// decode in place, reusing the allocations of recycled objects
+	v.Reset()
+	if err := (*jsonVideo)(v).UnmarshalJSONFFLexer(fs, state); err != nil {
 		return err
 	}
-
-	*v = (Video)(h)
 	v.normalize()
 	return nil
 }
@@ -23,29 +23,53 @@ var _ = Describe("Video", func() {
 			},
 			MinDuration:    5,
 			MaxDuration:    30,
-			Protocols:      []int{VideoProtoVAST2, VideoProtoVAST3},
+			Protocols:      []Protocol{VideoProtoVAST2, VideoProtoVAST3},
 			W:              640,
 			H:              480,
 			Linearity:      VideoLinearityLinear,
 			Sequence:       1,
-			BAttr:          []int{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert},
+			BAttr:          []CreativeAttribute{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert},
 			MaxExtended:    30,
 			MinBitrate:     300,
 			MaxBitrate:     1500,
 			BoxingAllowed:  iptr(1),
-			PlaybackMethod: []int{VideoPlaybackAutoSoundOn, VideoPlaybackClickToPlay},
-			Delivery:       []int{ContentDeliveryProgressive},
+			PlaybackMethod: []PlaybackMethod{VideoPlaybackAutoSoundOn, VideoPlaybackClickToPlay},
+			Delivery:       []ContentDelivery{ContentDeliveryProgressive},
 			Pos:            AdPosAboveFold,
 			CompanionAd: []Banner{
-				{W: 300, H: 250, ID: "1234567893-1", Pos: AdPosAboveFold, BAttr: []int{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert}, ExpDir: []int{ExpDirRight, ExpDirDown}},
-				{W: 728, H: 90, ID: "1234567893-2", Pos: AdPosAboveFold, BAttr: []int{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert}},
+				{W: 300, H: 250, ID: "1234567893-1", Pos: AdPosAboveFold, BAttr: []CreativeAttribute{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert}, ExpDir: []ExpandableDirection{ExpDirRight, ExpDirDown}},
+				{W: 728, H: 90, ID: "1234567893-2", Pos: AdPosAboveFold, BAttr: []CreativeAttribute{CreativeAttributeUserInitiated, CreativeAttributeWindowsDialogOrAlert}},
 			},
 			Placement:     VideoPlacementInStream,
-			Api:           []int{APIFrameworkVPAID1, APIFrameworkVPAID2},
-			CompanionType: []int{VASTCompanionStatic, VASTCompanionHTML},
+			Api:           []APIFramework{APIFrameworkVPAID1, APIFrameworkVPAID2},
+			CompanionType: []CompanionType{VASTCompanionStatic, VASTCompanionHTML},
 		}))
 	})
 
+	It("should validate 2.6 ad-pod fields", func() {
+		pod := &Video{Mimes: []string{"video/mp4"}, Linearity: VideoLinearityLinear, Protocols: []Protocol{VideoProtoVAST4}, RqdDurs: []int{15, 30}}
+		Expect(pod.Validate()).NotTo(HaveOccurred())
+
+		pod.MaxDuration = 30
+		Expect(pod.Validate()).To(Equal(ErrInvalidVideoRqdDurs))
+		pod.MaxDuration = 0
+
+		pod.Plcmt = 5
+		Expect(pod.Validate()).To(Equal(ErrInvalidVideoPlcmt))
+		pod.Plcmt = VideoPlcmtInstream
+
+		pod.PodSeq = 2
+		Expect(pod.Validate()).To(Equal(ErrInvalidVideoPodSeq))
+		pod.PodSeq = PodSeqLast
+
+		pod.SlotInPod = 3
+		Expect(pod.Validate()).To(Equal(ErrInvalidVideoSlotInPod))
+		pod.SlotInPod = SlotInPodFirstOrLast
+
+		pod.MinCPMPerSec = -1
+		Expect(pod.Validate()).To(Equal(ErrInvalidVideoMinCPMPerSec))
+	})
+
 	It("should validate", func() {
 		Expect((&Video{}).Validate()).To(Equal(ErrInvalidVideoNoMimes))
 		Expect((&Video{